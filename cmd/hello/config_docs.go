@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/config"
+)
+
+// HelloConfig is a sample config documented by `hello config docs`
+type HelloConfig struct {
+	Greeting struct {
+		Name     string   `mapstructure:"name" default:"World" usage:"Who to greet"`
+		Guests   []string `mapstructure:"guests" usage:"Extra names for the welcome message"`
+		Farewell bool     `mapstructure:"farewell" default:"true" usage:"Say goodbye at the end"`
+	} `mapstructure:"greeting"`
+	Server struct {
+		Port    int           `mapstructure:"port" default:"8080" usage:"Port to listen on"`
+		Timeout time.Duration `mapstructure:"timeout" default:"5s" usage:"Request timeout"`
+	} `mapstructure:"server"`
+	APIKey string `mapstructure:"api_key" env:"HELLO_API_KEY" secret:"true" usage:"Key for the greeting API"`
+}
+
+// runConfigDocs implements `hello config docs [-format markdown|json] [-prefix HELLO]`
+func runConfigDocs(args []string) error {
+	fs := flag.NewFlagSet("config docs", flag.ContinueOnError)
+	format := fs.String("format", config.DocFormatMarkdown, "output format: markdown or json")
+	prefix := fs.String("prefix", "HELLO", "env var prefix")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	doc, err := config.Document[HelloConfig](config.DocOptions{EnvPrefix: *prefix, Format: *format})
+	if err != nil {
+		return err
+	}
+	_, err = os.Stdout.Write(doc)
+	return err
+}
+
+// runConfig dispatches `hello config <subcommand>`
+func runConfig(args []string) error {
+	if len(args) == 0 || args[0] != "docs" {
+		return fmt.Errorf("usage: hello config docs [-format markdown|json] [-prefix HELLO]")
+	}
+	return runConfigDocs(args[1:])
+}
@@ -2,12 +2,21 @@ package main
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/phongthien99/monorepo-lib/libs/greetings"
 	"github.com/phongthien99/monorepo-lib/libs/math"
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		if err := runConfig(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	// Using greetings library
 	fmt.Println("=== Greetings Library Demo ===")
 	fmt.Println(greetings.Hello("World"))
@@ -1,7 +1,10 @@
 package config
 
 import (
+	"context"
+
 	"github.com/phongthien99/monorepo-lib/libs/config/core"
+	"github.com/phongthien99/monorepo-lib/libs/config/loader"
 )
 
 // Config re-exports core.Config so users can use config.Config[T]
@@ -10,6 +13,15 @@ type Config[T any] = core.Config[T]
 // Loader re-exports core.Loader so users can use config.Loader[T]
 type Loader[T any] = core.Loader[T]
 
+// NamedLoader re-exports core.NamedLoader - loaders whose type name appears in Load errors
+type NamedLoader = core.NamedLoader
+
+// UnusedKeysReporter re-exports core.UnusedKeysReporter - loaders reporting keys that match no field
+type UnusedKeysReporter = core.UnusedKeysReporter
+
+// ContextLoader re-exports core.ContextLoader - loaders cancelled when a parallel Load fails
+type ContextLoader[T any] = core.ContextLoader[T]
+
 // MergeFunc re-exports core.MergeFunc so users can define custom merge functions
 type MergeFunc[T any] = core.MergeFunc[T]
 
@@ -19,22 +31,155 @@ type Validator[T any] = core.Validator[T]
 // ValidatorFunc re-exports core.ValidatorFunc - function adapter for Validator
 type ValidatorFunc[T any] = core.ValidatorFunc[T]
 
+// NamedValidator re-exports core.NamedValidator - validator with a name reported in errors
+type NamedValidator[T any] = core.NamedValidator[T]
+
+// ValidationError re-exports core.ValidationError - error returned by CompositeValidator
+type ValidationError = core.ValidationError
+
+// Sanitizer re-exports core.Sanitizer - normalizes config before validation
+type Sanitizer[T any] = core.Sanitizer[T]
+
+// SanitizerFunc re-exports core.SanitizerFunc - function adapter for Sanitizer
+type SanitizerFunc[T any] = core.SanitizerFunc[T]
+
+// Severity re-exports core.Severity - whether a validation issue blocks Load
+type Severity = core.Severity
+
+// Validation severities re-exported from core
+const (
+	SeverityError   = core.SeverityError
+	SeverityWarning = core.SeverityWarning
+)
+
+// MetricsSink re-exports core.MetricsSink - receives per-loader load durations (see WithMetrics)
+type MetricsSink = core.MetricsSink
+
+// WarnLogger re-exports core.WarnLogger - logger for slow-loader warnings (see WithSlowLoaderWarning)
+type WarnLogger = core.WarnLogger
+
+// ChangeEvent re-exports core.ChangeEvent - payload passed to OnChange callbacks
+type ChangeEvent[T any] = core.ChangeEvent[T]
+
+// FieldChange re-exports core.FieldChange - one field reported by PreviewMerge
+type FieldChange = core.FieldChange
+
+// DocOptions re-exports loader.DocOptions - env prefix and output format for Document
+type DocOptions = loader.DocOptions
+
+// DocEntry re-exports loader.DocEntry - one config key listed by Document
+type DocEntry = loader.DocEntry
+
+// Doc formats re-exported from loader
+const (
+	DocFormatMarkdown = loader.DocFormatMarkdown
+	DocFormatJSON     = loader.DocFormatJSON
+)
+
 // New re-exports core.New to create a new Config with default merge strategy
 func New[T any](loaders ...Loader[*T]) *Config[T] {
 	return core.New[T](loaders...)
 }
 
+// NewListConfig re-exports core.NewListConfig to create a Config with a list root and append merge
+func NewListConfig[E any](loaders ...Loader[*[]E]) *Config[[]E] {
+	return core.NewListConfig[E](loaders...)
+}
+
 // NewCompositeValidator re-exports core.NewCompositeValidator
 func NewCompositeValidator[T any](validators ...Validator[T]) *core.CompositeValidator[T] {
 	return core.NewCompositeValidator[T](validators...)
 }
 
+// NewCompositeSanitizer re-exports core.NewCompositeSanitizer
+func NewCompositeSanitizer[T any](sanitizers ...Sanitizer[T]) *core.CompositeSanitizer[T] {
+	return core.NewCompositeSanitizer[T](sanitizers...)
+}
+
+// ForEachMapEntry re-exports core.ForEachMapEntry - validates every entry of a map section
+func ForEachMapEntry[T any, V any](selector func(*T) map[string]V, validator Validator[V]) Validator[T] {
+	return core.ForEachMapEntry(selector, validator)
+}
+
+// Named re-exports core.Named - wraps a validator with a name
+func Named[T any](name string, validator Validator[T]) NamedValidator[T] {
+	return core.Named[T](name, validator)
+}
+
+// WarningValidator re-exports core.WarningValidator - reports issues as non-fatal warnings
+func WarningValidator[T any](validator Validator[T]) Validator[T] {
+	return core.WarningValidator(validator)
+}
+
+// IsWarning re-exports core.IsWarning - reports whether err is a validation warning
+func IsWarning(err error) bool {
+	return core.IsWarning(err)
+}
+
+// AppendMerge re-exports core.AppendMerge - appends list entries from every loader
+func AppendMerge[E any](dst, src *[]E) error {
+	return core.AppendMerge(dst, src)
+}
+
 // DefaultMerge re-exports core.DefaultMerge - deep merge strategy
 func DefaultMerge[T any](dst, src *T) error {
 	return core.DefaultMerge(dst, src)
 }
 
+// CachedMerge re-exports core.CachedMerge - deep merge with a per-type cached plan
+func CachedMerge[T any](dst, src *T) error {
+	return core.CachedMerge(dst, src)
+}
+
+// PreviewMerge re-exports core.PreviewMerge - dry-run merge that reports changed fields
+func PreviewMerge[T any](current T, src *T) (T, []FieldChange) {
+	return core.PreviewMerge(current, src)
+}
+
 // ShallowMerge re-exports core.ShallowMerge - shallow merge strategy
 func ShallowMerge[T any](dst, src *T) error {
 	return core.ShallowMerge(dst, src)
 }
+
+// Interpolate re-exports core.Interpolate - resolves ${path} references between fields
+func Interpolate[T any](cfg *T) error {
+	return core.Interpolate(cfg)
+}
+
+// WithOverrides re-exports core.WithOverrides - registers a config override in a context (see Config.GetCtx)
+func WithOverrides[T any](ctx context.Context, mutate func(*T)) context.Context {
+	return core.WithOverrides(ctx, mutate)
+}
+
+// ToEnv re-exports core.ToEnv - formats a config as KEY=VALUE env strings for the env loader
+func ToEnv[T any](cfg *T, prefix string) []string {
+	return core.ToEnv(cfg, prefix)
+}
+
+// Document re-exports loader.Document - renders every config key of T as a Markdown or JSON reference
+func Document[T any](opts DocOptions) ([]byte, error) {
+	return loader.Document[T](opts)
+}
+
+// ErrReferenceCycle re-exports core.ErrReferenceCycle - returned when ${path} references form a cycle
+var ErrReferenceCycle = core.ErrReferenceCycle
+
+// Ptr re-exports core.Ptr - returns a pointer to v, for explicit zero overrides
+func Ptr[T any](v T) *T {
+	return core.Ptr(v)
+}
+
+// Deref re-exports core.Deref - returns *p, or def if p is nil
+func Deref[T any](p *T, def T) T {
+	return core.Deref(p, def)
+}
+
+// IsSet re-exports core.IsSet - reports whether an optional field was set
+func IsSet[T any](p *T) bool {
+	return core.IsSet(p)
+}
+
+// RequiredPtr re-exports core.RequiredPtr - fails when a pointer field is nil
+func RequiredPtr[T any, P any](name string, selector func(*T) *P) Validator[T] {
+	return core.RequiredPtr(name, selector)
+}
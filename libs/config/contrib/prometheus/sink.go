@@ -0,0 +1,72 @@
+// Package prometheus exports config loader durations as Prometheus metrics.
+//
+// It lives in its own module so the config library stays free of the
+// Prometheus client dependency. Pass the Sink to Config.WithMetrics.
+package prometheus
+
+import (
+	"errors"
+	"time"
+
+	promclient "github.com/prometheus/client_golang/prometheus"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
+)
+
+// MetricName is the histogram registered by NewSink.
+const MetricName = "config_loader_duration_seconds"
+
+// Label values for the "result" label.
+const (
+	ResultOK    = "ok"
+	ResultError = "error"
+)
+
+// Sink is a core.MetricsSink backed by a histogram with "loader" and "result" labels.
+type Sink struct {
+	durations *promclient.HistogramVec
+}
+
+var _ core.MetricsSink = (*Sink)(nil)
+
+// NewSink registers the loader duration histogram with reg and returns a sink for it.
+// If an identical histogram is already registered (e.g. a second Config in the same process),
+// the existing one is reused.
+//
+// Example:
+//
+//	sink, err := configprom.NewSink(prometheus.DefaultRegisterer)
+//	if err != nil {
+//	    return err
+//	}
+//	cfg := config.New[AppConfig](fileLoader, vaultLoader).WithMetrics(sink)
+func NewSink(reg promclient.Registerer) (*Sink, error) {
+	durations := promclient.NewHistogramVec(promclient.HistogramOpts{
+		Name:    MetricName,
+		Help:    "Duration of config loader calls made by Config.Load.",
+		Buckets: []float64{.001, .005, .01, .05, .1, .5, 1, 2.5, 5, 10, 30},
+	}, []string{"loader", "result"})
+
+	if err := reg.Register(durations); err != nil {
+		var already promclient.AlreadyRegisteredError
+		if !errors.As(err, &already) {
+			return nil, err
+		}
+		existing, ok := already.ExistingCollector.(*promclient.HistogramVec)
+		if !ok {
+			return nil, err
+		}
+		durations = existing
+	}
+
+	return &Sink{durations: durations}, nil
+}
+
+// ObserveLoaderDuration implements core.MetricsSink.
+func (s *Sink) ObserveLoaderDuration(name string, d time.Duration, err bool) {
+	result := ResultOK
+	if err {
+		result = ResultError
+	}
+	s.durations.WithLabelValues(name, result).Observe(d.Seconds())
+}
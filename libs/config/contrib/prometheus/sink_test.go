@@ -0,0 +1,83 @@
+package prometheus
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	promclient "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
+)
+
+type AppConfig struct {
+	Host string
+}
+
+type slowLoader struct {
+	delay time.Duration
+	err   error
+}
+
+func (s *slowLoader) Load(dst *AppConfig) error {
+	time.Sleep(s.delay)
+	dst.Host = "localhost"
+	return s.err
+}
+
+func (s *slowLoader) String() string {
+	return "remote(vault)"
+}
+
+func TestSink_ObservesLoaderDurations(t *testing.T) {
+	reg := promclient.NewRegistry()
+	sink, err := NewSink(reg)
+	if err != nil {
+		t.Fatalf("NewSink failed: %v", err)
+	}
+
+	loader := &slowLoader{delay: 10 * time.Millisecond}
+	if err := core.New[AppConfig](loader).WithMetrics(sink).Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	loader.err = errors.New("timeout")
+	if err := core.New[AppConfig](loader).WithMetrics(sink).Load(); err == nil {
+		t.Fatal("Expected Load to fail")
+	}
+
+	// Bucket placement depends on timing, so check counts and sums only
+	if got := testutil.CollectAndCount(reg, MetricName); got != 2 {
+		t.Errorf("Expected 2 label sets, got %d", got)
+	}
+	metrics, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("Gather failed: %v", err)
+	}
+	for _, m := range metrics[0].GetMetric() {
+		h := m.GetHistogram()
+		if h.GetSampleCount() != 1 || h.GetSampleSum() < loader.delay.Seconds() {
+			t.Errorf("Expected one sample >= %v for %v, got count=%d sum=%v",
+				loader.delay, m.GetLabel(), h.GetSampleCount(), h.GetSampleSum())
+		}
+	}
+}
+
+func TestNewSink_ReusesRegisteredHistogram(t *testing.T) {
+	reg := promclient.NewRegistry()
+	first, err := NewSink(reg)
+	if err != nil {
+		t.Fatalf("NewSink failed: %v", err)
+	}
+	second, err := NewSink(reg)
+	if err != nil {
+		t.Fatalf("Expected second NewSink to reuse the histogram, got: %v", err)
+	}
+
+	first.ObserveLoaderDuration("file(config.yaml)", time.Millisecond, false)
+	second.ObserveLoaderDuration("file(config.yaml)", time.Millisecond, false)
+
+	if got := testutil.CollectAndCount(reg, MetricName); got != 1 {
+		t.Errorf("Expected both sinks to share one label set, got %d", got)
+	}
+}
@@ -0,0 +1,171 @@
+package core
+
+import (
+	"reflect"
+	"sync"
+)
+
+// mergePlans caches one *mergePlan per type, shared by all CachedMerge calls.
+var mergePlans sync.Map // reflect.Type -> *mergePlan
+
+// mergePlan is the precomputed merge shape of a type: which struct fields take part,
+// which carry `merge:"keep"`, and the plans of nested element types.
+type mergePlan struct {
+	kind   reflect.Kind // reflect.Invalid for values merged as a whole, e.g. time.Time
+	fields []fieldPlan
+	elem   *mergePlan // pointer or map value type
+}
+
+type fieldPlan struct {
+	index int
+	keep  bool
+	plan  *mergePlan
+}
+
+// CachedMerge produces the same result as DefaultMerge, but inspects T only once:
+// the field list, merge tags and nested types are cached and reused on every call.
+// Prefer it when the same config type is merged often (hot reload, many loaders).
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](loaders...).
+//	    WithMerge(core.CachedMerge[AppConfig])
+func CachedMerge[T any](dst, src *T) error {
+	plan := planFor(reflect.TypeOf(dst).Elem())
+	plan.merge(reflect.ValueOf(dst).Elem(), reflect.ValueOf(src).Elem())
+	return nil
+}
+
+// planFor returns the cached plan for t, building it on first use.
+func planFor(t reflect.Type) *mergePlan {
+	if plan, ok := mergePlans.Load(t); ok {
+		return plan.(*mergePlan)
+	}
+	plan, _ := mergePlans.LoadOrStore(t, buildPlan(t, make(map[reflect.Type]*mergePlan)))
+	return plan.(*mergePlan)
+}
+
+// buildPlan walks t once. seen breaks cycles in self-referencing types.
+func buildPlan(t reflect.Type, seen map[reflect.Type]*mergePlan) *mergePlan {
+	if plan, ok := seen[t]; ok {
+		return plan
+	}
+
+	plan := &mergePlan{kind: t.Kind()}
+	seen[t] = plan
+
+	switch t.Kind() {
+	case reflect.Struct:
+		if !hasExportedFields(t) {
+			plan.kind = reflect.Invalid
+			break
+		}
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			plan.fields = append(plan.fields, fieldPlan{
+				index: i,
+				keep:  field.Tag.Get(mergeTag) == mergeKeep,
+				plan:  buildPlan(field.Type, seen),
+			})
+		}
+
+	case reflect.Ptr, reflect.Map:
+		plan.elem = buildPlan(t.Elem(), seen)
+	}
+
+	return plan
+}
+
+// merge applies the DefaultMerge rules to dst and src, which share the plan's type.
+func (p *mergePlan) merge(dst, src reflect.Value) {
+	switch p.kind {
+	case reflect.Struct:
+		for _, field := range p.fields {
+			dstField := dst.Field(field.index)
+
+			// merge:"keep" - first non-zero value wins
+			if field.keep && !dstField.IsZero() {
+				continue
+			}
+
+			if srcField := src.Field(field.index); !srcField.IsZero() {
+				field.plan.merge(dstField, srcField)
+			}
+		}
+
+	case reflect.Slice:
+		if src.Len() > 0 {
+			dst.Set(src)
+		}
+
+	case reflect.Map:
+		if src.IsNil() {
+			return
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.MakeMap(src.Type()))
+		}
+
+		// reflect.Invalid: structs merged as a whole are still kept when src is zero
+		deep := p.elem.kind == reflect.Map || p.elem.kind == reflect.Struct || p.elem.kind == reflect.Ptr ||
+			p.elem.kind == reflect.Interface || p.elem.kind == reflect.Invalid
+		iter := src.MapRange()
+		for iter.Next() {
+			key, srcValue := iter.Key(), iter.Value()
+			dstValue := dst.MapIndex(key)
+
+			switch {
+			case dstValue.IsValid() && !dstValue.IsZero() && deep:
+				// Map values are not addressable, so merge a copy
+				merged := reflect.New(srcValue.Type()).Elem()
+				merged.Set(dstValue)
+				p.elem.merge(merged, srcValue)
+				dst.SetMapIndex(key, merged)
+			case dstValue.IsValid() && !dstValue.IsZero():
+				dst.SetMapIndex(key, srcValue)
+			default:
+				// Copy new entries so later merges never write into a loader's own maps
+				dst.SetMapIndex(key, deepCopy(srcValue))
+			}
+		}
+
+	case reflect.Ptr:
+		if src.IsNil() {
+			return
+		}
+		if isScalar(p.elem.kind) {
+			dst.Set(reflect.New(src.Type().Elem()))
+			dst.Elem().Set(src.Elem())
+			return
+		}
+		if dst.IsNil() {
+			dst.Set(reflect.New(src.Type().Elem()))
+		}
+		p.elem.merge(dst.Elem(), src.Elem())
+
+	case reflect.Interface:
+		// Dynamic values: the plan of the held type is looked up per value
+		if src.IsNil() {
+			return
+		}
+		srcElem := src.Elem()
+		kind := srcElem.Kind()
+		if dst.IsNil() || dst.Elem().Type() != srcElem.Type() ||
+			(kind != reflect.Map && kind != reflect.Struct && kind != reflect.Ptr) {
+			dst.Set(deepCopy(srcElem))
+			return
+		}
+		merged := reflect.New(srcElem.Type()).Elem()
+		merged.Set(deepCopy(dst.Elem()))
+		planFor(srcElem.Type()).merge(merged, srcElem)
+		dst.Set(merged)
+
+	default:
+		if !src.IsZero() {
+			dst.Set(src)
+		}
+	}
+}
@@ -1,6 +1,12 @@
 package core
 
-import "fmt"
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"time"
+)
 
 // MergeFunc defines the function signature for merge strategies.
 // dst: destination (current merge result)
@@ -9,10 +15,28 @@ type MergeFunc[T any] func(dst, src *T) error
 
 // Config manages configuration with type-safe generics and configurable merge strategy.
 type Config[T any] struct {
-	loaders   []Loader[*T]
-	mergeFunc MergeFunc[T]
-	validator Validator[T]
-	data      T
+	loaders     []Loader[*T]
+	required    map[int]bool
+	mergeFunc   MergeFunc[T]
+	sanitizers  []Sanitizer[T]
+	validator   Validator[T]
+	onChange    func(ChangeEvent[T])
+	hashSecrets bool
+	interpolate bool
+	hash        string
+	warnings    []error
+	unused      []string
+	data        T
+
+	metrics       MetricsSink
+	slowThreshold time.Duration
+	slowLogger    WarnLogger
+
+	parallel      bool
+	maxConcurrent int
+
+	snapshot       atomic.Pointer[T] // last loaded data, read by GetCtx
+	allowOverrides bool
 }
 
 // New creates a new Config with default merge strategy.
@@ -32,8 +56,9 @@ type Config[T any] struct {
 //	)
 func New[T any](loaders ...Loader[*T]) *Config[T] {
 	return &Config[T]{
-		loaders:   loaders,
-		mergeFunc: DefaultMerge[T],
+		loaders:     loaders,
+		mergeFunc:   DefaultMerge[T],
+		hashSecrets: true,
 	}
 }
 
@@ -75,45 +100,277 @@ func (c *Config[T]) WithValidator(validator Validator[T]) *Config[T] {
 	return c
 }
 
+// WithSanitizer adds sanitizers that normalize the config after merging (and interpolation)
+// and before validation, so validators always see sanitized values.
+// Sanitizers run in the order given; calling WithSanitizer again appends more.
+// Returns *Config[T] to support method chaining.
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](loaders...).
+//	    WithSanitizer(trimSanitizer, addrSanitizer).
+//	    WithValidator(addrValidator) // validates the Addr filled by addrSanitizer
+func (c *Config[T]) WithSanitizer(sanitizers ...Sanitizer[T]) *Config[T] {
+	c.sanitizers = append(c.sanitizers, sanitizers...)
+	return c
+}
+
+// WithRequireContribution marks loaders (by index) that must contribute at least one value.
+// Load fails if a required loader leaves its struct entirely zero,
+// e.g. because of a typo'd env prefix. Loaders not listed stay optional.
+// Returns *Config[T] to support method chaining.
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](fileLoader, envLoader).
+//	    WithRequireContribution(1) // envLoader must set something
+func (c *Config[T]) WithRequireContribution(indices ...int) *Config[T] {
+	if c.required == nil {
+		c.required = make(map[int]bool)
+	}
+	for _, i := range indices {
+		c.required[i] = true
+	}
+	return c
+}
+
+// WithHashSecrets controls whether fields tagged `secret:"true"` are included in Hash.
+// Secrets are included by default.
+// Returns *Config[T] to support method chaining.
+func (c *Config[T]) WithHashSecrets(include bool) *Config[T] {
+	c.hashSecrets = include
+	return c
+}
+
+// WithInterpolation enables resolving `${path}` references between config fields after merging,
+// e.g. `log.dir: "${app.home}/logs"`. See Interpolate for the path syntax.
+// Returns *Config[T] to support method chaining.
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](loaders...).
+//	    WithInterpolation()
+func (c *Config[T]) WithInterpolation() *Config[T] {
+	c.interpolate = true
+	return c
+}
+
+// OnChange registers a callback invoked after a successful Load
+// whose result hashes differently from the previous Load.
+// The first Load never triggers the callback.
+// Returns *Config[T] to support method chaining.
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](loaders...).
+//	    OnChange(func(e config.ChangeEvent[AppConfig]) {
+//	        templateCache.Purge(e.OldHash)
+//	    })
+func (c *Config[T]) OnChange(fn func(ChangeEvent[T])) *Config[T] {
+	c.onChange = fn
+	return c
+}
+
 // Load executes loading and merging of all config sources.
 //
 // Process:
 //  1. Initialize accumulated result (zero value)
-//  2. Loop through all loaders in order
+//  2. Loop through all loaders in order (fetched concurrently with WithParallelLoaders)
 //  3. Each loader fills data into temp struct
-//  4. Merge temp into accumulated using merge strategy
-//  5. Validate config if validator is set
-//  6. Store accumulated result
+//  4. Merge temp into accumulated using merge strategy, always in loader order
+//  5. Resolve `${path}` references if WithInterpolation is set
+//  6. Run sanitizers in order (see WithSanitizer)
+//  7. Validate config if validator is set; warnings are kept in Warnings
+//  8. Compute hash and store accumulated result, with the unused keys (see UnusedKeys)
+//  9. Call OnChange if the hash changed since the previous Load
 //
 // Returns error if:
 //   - Any loader fails during Load()
+//   - A required loader contributes no values (see WithRequireContribution)
 //   - Merge function fails
-//   - Validation fails
+//   - A reference cannot be resolved
+//   - A sanitizer fails
+//   - Validation fails with an error (warnings do not fail Load)
+//   - Hash computation fails
 func (c *Config[T]) Load() error {
+	return c.load(new(T))
+}
+
+// LoadInto is like Load, but layers the loaders over base instead of a zero value.
+// Fields of base that no loader sets are kept; fields a loader sets are overridden
+// according to the merge strategy. base is deep-copied, so its maps and slices are never modified.
+// Useful for partial runtime updates on top of an already-populated config.
+//
+// Example:
+//
+//	base := cfg.Get()
+//	base.FeatureFlags["beta"] = true // set programmatically
+//	if err := cfg.LoadInto(base); err != nil {
+//	    return err
+//	}
+func (c *Config[T]) LoadInto(base T) error {
 	accumulated := new(T)
+	reflect.ValueOf(accumulated).Elem().Set(deepCopy(reflect.ValueOf(&base).Elem()))
+	return c.load(accumulated)
+}
 
-	for i, loader := range c.loaders {
-		temp := new(T)
+// Validate runs the configured validator against the current data (see Get)
+// without re-running loaders, e.g. after modifying it through GetPtr.
+// Warnings do not fail Validate, as in Load. Returns nil if no validator is set.
+//
+// Example:
+//
+//	cfg.GetPtr().Server.Port = 80
+//	if err := cfg.Validate(); err != nil {
+//	    return err
+//	}
+func (c *Config[T]) Validate() error {
+	if c.validator == nil {
+		return nil
+	}
+	_, err := splitWarnings(c.validator.Validate(&c.data))
+	return err
+}
 
-		if err := loader.Load(temp); err != nil {
-			return fmt.Errorf("loader[%d] failed: %w", i, err)
+// load merges every loader into accumulated, then validates, hashes and stores it.
+func (c *Config[T]) load(accumulated *T) error {
+	if c.parallel {
+		temps, err := c.fetchParallel()
+		if err != nil {
+			return err
 		}
+		for i, temp := range temps {
+			if err := c.mergeLoader(accumulated, i, temp); err != nil {
+				return err
+			}
+		}
+	} else {
+		for i, loader := range c.loaders {
+			temp := new(T)
+
+			start := time.Now()
+			err := loader.Load(temp)
+			c.observeLoader(i, loader, time.Since(start), err)
+
+			if err != nil {
+				return loaderError(i, loader, err)
+			}
+			if err := c.mergeLoader(accumulated, i, temp); err != nil {
+				return err
+			}
+		}
+	}
 
-		if err := c.mergeFunc(accumulated, temp); err != nil {
-			return fmt.Errorf("merge loader[%d] failed: %w", i, err)
+	if c.interpolate {
+		if err := Interpolate(accumulated); err != nil {
+			return fmt.Errorf("config interpolation failed: %w", err)
 		}
 	}
 
+	for i, sanitizer := range c.sanitizers {
+		if err := sanitizer.Sanitize(accumulated); err != nil {
+			return fmt.Errorf("sanitizer[%d] failed: %w", i, err)
+		}
+	}
+
+	var warnings []error
 	if c.validator != nil {
-		if err := c.validator.Validate(accumulated); err != nil {
+		var err error
+		warnings, err = splitWarnings(c.validator.Validate(accumulated))
+		if err != nil {
 			return fmt.Errorf("config validation failed: %w", err)
 		}
 	}
 
+	hash, err := computeHash(accumulated, c.hashSecrets)
+	if err != nil {
+		return fmt.Errorf("config hash failed: %w", err)
+	}
+
+	oldHash, oldData := c.hash, c.data
 	c.data = *accumulated
+	c.hash = hash
+	c.warnings = warnings
+	c.unused = c.collectUnusedKeys()
+	c.snapshot.Store(accumulated)
+
+	if c.onChange != nil && oldHash != "" && oldHash != hash {
+		c.onChange(ChangeEvent[T]{
+			Old:     oldData,
+			New:     c.data,
+			OldHash: oldHash,
+			NewHash: hash,
+		})
+	}
+
+	return nil
+}
+
+// mergeLoader checks the required contribution of loader i and merges its temp into accumulated.
+func (c *Config[T]) mergeLoader(accumulated *T, i int, temp *T) error {
+	if c.required[i] && reflect.ValueOf(temp).Elem().IsZero() {
+		return fmt.Errorf("loader %s contributed no values", describeLoader(i, c.loaders[i]))
+	}
+	if err := c.mergeFunc(accumulated, temp); err != nil {
+		return fmt.Errorf("merge loader[%d] failed: %w", i, err)
+	}
 	return nil
 }
 
+// Hash returns a stable hash of the config from the last successful Load.
+// Returns empty string before the first Load.
+// Identical configs always hash identically, regardless of map iteration order.
+func (c *Config[T]) Hash() string {
+	return c.hash
+}
+
+// Warnings returns the validation warnings from the last successful Load (see WarningValidator),
+// for the caller to log. Returns nil if there were none.
+//
+// Example:
+//
+//	for _, w := range cfg.Warnings() {
+//	    logger.Warnw("config warning", "error", w)
+//	}
+func (c *Config[T]) Warnings() []error {
+	return c.warnings
+}
+
+// UnusedKeys returns the keys that sources of the last successful Load contain
+// but that match no field of T, sorted and without duplicates. Only loaders implementing
+// UnusedKeysReporter (e.g. loader.FileLoader) contribute. Returns nil if there were none.
+//
+// Unlike strict loaders, unused keys never fail Load: use it to spot dead config.
+//
+// Example:
+//
+//	for _, key := range cfg.UnusedKeys() {
+//	    logger.Warnw("unused config key", "key", key)
+//	}
+func (c *Config[T]) UnusedKeys() []string {
+	return c.unused
+}
+
+// collectUnusedKeys merges the unused keys reported by the loaders.
+func (c *Config[T]) collectUnusedKeys() []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, loader := range c.loaders {
+		reporter, ok := loader.(UnusedKeysReporter)
+		if !ok {
+			continue
+		}
+		for _, key := range reporter.UnusedKeys() {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 // Get returns the typed config data.
 // Must call Load() before Get(), otherwise returns zero value of T.
 func (c *Config[T]) Get() T {
@@ -125,3 +382,34 @@ func (c *Config[T]) Get() T {
 func (c *Config[T]) GetPtr() *T {
 	return &c.data
 }
+
+// loaderError wraps a loader failure with its index and name: Name() for a NamedLoader,
+// otherwise the loader's type name.
+func loaderError(index int, loader any, err error) error {
+	return fmt.Errorf("loader[%d] (%s) failed: %w", index, loaderName(loader), err)
+}
+
+// loaderName returns Name() for a NamedLoader, otherwise the type name without
+// package or pointer (e.g. "VaultLoader" for *vault.VaultLoader).
+func loaderName(loader any) string {
+	if named, ok := loader.(NamedLoader); ok {
+		return named.Name()
+	}
+	t := reflect.TypeOf(loader)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Name() == "" {
+		return fmt.Sprintf("%T", loader)
+	}
+	return t.Name()
+}
+
+// describeLoader names a loader for error messages.
+// Uses String() if the loader implements fmt.Stringer, otherwise its index.
+func describeLoader(index int, loader any) string {
+	if s, ok := loader.(fmt.Stringer); ok {
+		return s.String()
+	}
+	return fmt.Sprintf("[%d]", index)
+}
@@ -1,7 +1,9 @@
 package core
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -266,3 +268,201 @@ func TestConfig_LoadOrder(t *testing.T) {
 		t.Errorf("Expected load order [1,2,3], got %v", loadOrder)
 	}
 }
+
+// namedLoader is a MockLoader with a description for error messages
+type namedLoader struct {
+	MockLoader
+	name string
+}
+
+func (n *namedLoader) String() string {
+	return n.name
+}
+
+func TestConfig_RequireContribution(t *testing.T) {
+	contributing := &MockLoader{}
+	contributing.data.Server.Host = "localhost"
+
+	cfg := New[AppConfig](contributing).WithRequireContribution(0)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Expected contributing loader to pass, got: %v", err)
+	}
+}
+
+func TestConfig_RequireContribution_EmptyRequired(t *testing.T) {
+	file := &MockLoader{}
+	file.data.Server.Host = "localhost"
+	env := &namedLoader{name: "env(APP)"}
+
+	cfg := New[AppConfig](file, env).WithRequireContribution(1)
+	err := cfg.Load()
+
+	if err == nil {
+		t.Fatal("Expected error for empty required loader")
+	}
+	if err.Error() != "loader env(APP) contributed no values" {
+		t.Errorf("Unexpected error message: %v", err)
+	}
+}
+
+func TestConfig_RequireContribution_UnnamedLoader(t *testing.T) {
+	cfg := New[AppConfig](&MockLoader{}).WithRequireContribution(0)
+	err := cfg.Load()
+
+	if err == nil || err.Error() != "loader [0] contributed no values" {
+		t.Errorf("Expected index-based error, got: %v", err)
+	}
+}
+
+func TestConfig_RequireContribution_EmptyOptional(t *testing.T) {
+	file := &MockLoader{}
+	file.data.Server.Host = "localhost"
+	optional := &MockLoader{}
+
+	cfg := New[AppConfig](file, optional).WithRequireContribution(0)
+	if err := cfg.Load(); err != nil {
+		t.Errorf("Expected empty optional loader to be ignored, got: %v", err)
+	}
+}
+
+func TestConfig_LoadInto_KeepsSeedFields(t *testing.T) {
+	loader := &MockLoader{}
+	loader.data.Server.Port = 9090
+
+	seed := AppConfig{}
+	seed.Server.Host = "seed-host"
+	seed.Server.Port = 8080
+	seed.Database.Host = "seed-db"
+
+	cfg := New[AppConfig](loader)
+	if err := cfg.LoadInto(seed); err != nil {
+		t.Fatalf("LoadInto failed: %v", err)
+	}
+
+	result := cfg.Get()
+	// Verify: Seed fields not provided by any loader are kept
+	if result.Server.Host != "seed-host" || result.Database.Host != "seed-db" {
+		t.Errorf("Expected seed fields to be kept, got %+v", result)
+	}
+	// Verify: Fields provided by a loader override the seed
+	if result.Server.Port != 9090 {
+		t.Errorf("Expected loader to override port, got %d", result.Server.Port)
+	}
+}
+
+// multiDBLoader loads a fixed MultiDBConfig
+type multiDBLoader struct {
+	data MultiDBConfig
+}
+
+func (m *multiDBLoader) Load(dst *MultiDBConfig) error {
+	*dst = m.data
+	return nil
+}
+
+func TestConfig_LoadInto_DoesNotModifySeed(t *testing.T) {
+	seed := MultiDBConfig{Databases: map[string]DBConfig{"primary": {DSN: "postgres://primary"}}}
+	loader := &multiDBLoader{data: MultiDBConfig{Databases: map[string]DBConfig{"cache": {DSN: "redis://cache"}}}}
+
+	cfg := New[MultiDBConfig](loader)
+	if err := cfg.LoadInto(seed); err != nil {
+		t.Fatalf("LoadInto failed: %v", err)
+	}
+
+	if len(cfg.Get().Databases) != 2 {
+		t.Errorf("Expected seed and loader entries, got %v", cfg.Get().Databases)
+	}
+	if len(seed.Databases) != 1 {
+		t.Errorf("Expected seed map to be untouched, got %v", seed.Databases)
+	}
+}
+
+// DynamicConfig has sections whose keys are only known at runtime
+type DynamicConfig struct {
+	Features map[string]FeatureConfig
+	Extras   map[string]any
+}
+
+type FeatureConfig struct {
+	Enabled bool
+	Rollout int
+}
+
+// dynamicLoader loads a fixed DynamicConfig
+type dynamicLoader struct {
+	data DynamicConfig
+}
+
+func (d *dynamicLoader) Load(dst *DynamicConfig) error {
+	*dst = d.data
+	return nil
+}
+
+func TestConfig_Load_DynamicMapSections(t *testing.T) {
+	base := &dynamicLoader{data: DynamicConfig{
+		Features: map[string]FeatureConfig{
+			"beta":   {Enabled: true, Rollout: 10},
+			"legacy": {Enabled: true},
+		},
+		Extras: map[string]any{
+			"limits": map[string]any{"rps": 100, "burst": 20},
+			"region": "eu",
+		},
+	}}
+	override := &dynamicLoader{data: DynamicConfig{
+		Features: map[string]FeatureConfig{
+			"beta":     {Rollout: 50},
+			"checkout": {Enabled: true, Rollout: 5},
+		},
+		Extras: map[string]any{
+			"limits": map[string]any{"rps": 500},
+			"owner":  "payments",
+		},
+	}}
+
+	cfg := New[DynamicConfig](base, override)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	result := cfg.Get()
+
+	wantFeatures := map[string]FeatureConfig{
+		"beta":     {Enabled: true, Rollout: 50},
+		"legacy":   {Enabled: true},
+		"checkout": {Enabled: true, Rollout: 5},
+	}
+	if !reflect.DeepEqual(result.Features, wantFeatures) {
+		t.Errorf("Expected features %v, got %v", wantFeatures, result.Features)
+	}
+
+	wantExtras := map[string]any{
+		"limits": map[string]any{"rps": 500, "burst": 20},
+		"region": "eu",
+		"owner":  "payments",
+	}
+	if !reflect.DeepEqual(result.Extras, wantExtras) {
+		t.Errorf("Expected extras %v, got %v", wantExtras, result.Extras)
+	}
+
+	// Verify: Merging never writes into the loaders' own maps
+	if limits := base.data.Extras["limits"].(map[string]any); limits["rps"] != 100 {
+		t.Errorf("Expected base loader data to be untouched, got %v", limits)
+	}
+
+	// Verify: Reloading gives the same result
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if !reflect.DeepEqual(cfg.Get().Extras, wantExtras) {
+		t.Errorf("Expected same extras after reload, got %v", cfg.Get().Extras)
+	}
+}
+
+func TestConfig_LoadErrorNamesUnnamedLoaderByType(t *testing.T) {
+	cfg := New[AppConfig](&MockLoader{err: errors.New("boom")})
+
+	err := cfg.Load()
+	if err == nil || err.Error() != "loader[0] (MockLoader) failed: boom" {
+		t.Errorf("Expected error named after the loader type, got: %v", err)
+	}
+}
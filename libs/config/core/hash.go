@@ -0,0 +1,164 @@
+package core
+
+import (
+	"crypto/sha256"
+	"encoding"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// secretTag marks a field as secret: `secret:"true"`.
+// Secret fields can be excluded from Hash via WithHashSecrets(false).
+const secretTag = "secret"
+
+// ChangeEvent is passed to OnChange callbacks when a Load changes the config.
+type ChangeEvent[T any] struct {
+	Old     T
+	New     T
+	OldHash string
+	NewHash string
+}
+
+// computeHash returns a stable SHA-256 hex digest of v.
+// v is canonicalized first so map iteration order never affects the result.
+func computeHash(v any, includeSecrets bool) (string, error) {
+	canonical, err := canonicalize(reflect.ValueOf(v), includeSecrets)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(canonical)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+var (
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// canonicalize converts v into a tree of map[string]any, []any and primitives.
+// Types implementing encoding.TextMarshaler or json.Marshaler (e.g. time.Time) are
+// encoded with it, since their state is usually in unexported fields.
+func canonicalize(v reflect.Value, includeSecrets bool) (any, error) {
+	if !v.IsValid() {
+		return nil, nil
+	}
+	if (v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface) && v.IsNil() {
+		return nil, nil
+	}
+	if v.CanInterface() {
+		switch {
+		case v.Type().Implements(textMarshalerType):
+			text, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+			if err != nil {
+				return nil, fmt.Errorf("hash %s: %w", v.Type(), err)
+			}
+			return string(text), nil
+		case v.Type().Implements(jsonMarshalerType):
+			data, err := v.Interface().(json.Marshaler).MarshalJSON()
+			if err != nil {
+				return nil, fmt.Errorf("hash %s: %w", v.Type(), err)
+			}
+			return json.RawMessage(data), nil
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		return canonicalize(v.Elem(), includeSecrets)
+
+	case reflect.Struct:
+		out := make(map[string]any, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			if !includeSecrets && field.Tag.Get(secretTag) == "true" {
+				continue
+			}
+			value, err := canonicalize(v.Field(i), includeSecrets)
+			if err != nil {
+				return nil, err
+			}
+			out[field.Name] = value
+		}
+		return out, nil
+
+	case reflect.Map:
+		if v.IsNil() {
+			return nil, nil
+		}
+		return canonicalizeMap(v, includeSecrets)
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil, nil
+		}
+		out := make([]any, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			value, err := canonicalize(v.Index(i), includeSecrets)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = value
+		}
+		return out, nil
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil, nil
+
+	default:
+		return v.Interface(), nil
+	}
+}
+
+// mapEntry is one canonicalized map entry. Key is a typed encoding of the map key,
+// so keys that print the same (the string "1" and the int 1) stay distinct.
+type mapEntry struct {
+	Key   string
+	Value any
+
+	encodedValue string // tie-break for equal keys, e.g. distinct pointers to equal values
+}
+
+// canonicalizeMap converts a map into a list of entries sorted by key, then by value.
+// A list instead of a map keeps every entry, even when two keys encode the same.
+func canonicalizeMap(v reflect.Value, includeSecrets bool) (any, error) {
+	entries := make([]mapEntry, 0, v.Len())
+	for _, key := range v.MapKeys() {
+		k, err := canonicalize(key, includeSecrets)
+		if err != nil {
+			return nil, err
+		}
+		encodedKey, err := json.Marshal([]any{key.Type().String(), k})
+		if err != nil {
+			return nil, err
+		}
+		value, err := canonicalize(v.MapIndex(key), includeSecrets)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, mapEntry{Key: string(encodedKey), Value: value, encodedValue: string(encodedValue)})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Key != entries[j].Key {
+			return entries[i].Key < entries[j].Key
+		}
+		return entries[i].encodedValue < entries[j].encodedValue
+	})
+	return entries, nil
+}
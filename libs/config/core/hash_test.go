@@ -0,0 +1,191 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+type HashConfig struct {
+	Host     string
+	Password string `secret:"true"`
+	Features map[string]bool
+}
+
+// switchLoader returns whatever data currently points to
+type switchLoader struct {
+	data *HashConfig
+}
+
+func (s *switchLoader) Load(dst *HashConfig) error {
+	*dst = *s.data
+	return nil
+}
+
+func TestConfig_HashBeforeLoad(t *testing.T) {
+	cfg := New[HashConfig]()
+
+	if cfg.Hash() != "" {
+		t.Errorf("Expected empty hash before Load, got %s", cfg.Hash())
+	}
+}
+
+func TestConfig_HashStableAcrossReloads(t *testing.T) {
+	features := map[string]bool{}
+	for _, k := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		features[k] = true
+	}
+	loader := &switchLoader{data: &HashConfig{Host: "localhost", Features: features}}
+
+	changes := 0
+	cfg := New[HashConfig](loader).OnChange(func(e ChangeEvent[HashConfig]) {
+		changes++
+	})
+
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	first := cfg.Hash()
+
+	for i := 0; i < 20; i++ {
+		if err := cfg.Load(); err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+		if cfg.Hash() != first {
+			t.Fatalf("Expected stable hash %s, got %s", first, cfg.Hash())
+		}
+	}
+
+	if changes != 0 {
+		t.Errorf("Expected no OnChange calls for identical reloads, got %d", changes)
+	}
+}
+
+func TestConfig_HashChangesOnFieldChange(t *testing.T) {
+	loader := &switchLoader{data: &HashConfig{Host: "localhost"}}
+
+	var event *ChangeEvent[HashConfig]
+	cfg := New[HashConfig](loader).OnChange(func(e ChangeEvent[HashConfig]) {
+		event = &e
+	})
+
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	oldHash := cfg.Hash()
+
+	loader.data = &HashConfig{Host: "example.com"}
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Hash() == oldHash {
+		t.Fatal("Expected hash to change after field change")
+	}
+	if event == nil {
+		t.Fatal("Expected OnChange to be called")
+	}
+	if event.OldHash != oldHash || event.NewHash != cfg.Hash() {
+		t.Errorf("Expected hashes %s -> %s, got %s -> %s", oldHash, cfg.Hash(), event.OldHash, event.NewHash)
+	}
+	if event.Old.Host != "localhost" || event.New.Host != "example.com" {
+		t.Errorf("Expected Old/New hosts localhost/example.com, got %s/%s", event.Old.Host, event.New.Host)
+	}
+}
+
+func TestConfig_HashSecrets(t *testing.T) {
+	loader := &switchLoader{data: &HashConfig{Host: "localhost", Password: "one"}}
+
+	withSecrets := New[HashConfig](loader)
+	withoutSecrets := New[HashConfig](loader).WithHashSecrets(false)
+
+	withSecrets.Load()
+	withoutSecrets.Load()
+	h1, h2 := withSecrets.Hash(), withoutSecrets.Hash()
+
+	loader.data = &HashConfig{Host: "localhost", Password: "two"}
+	withSecrets.Load()
+	withoutSecrets.Load()
+
+	if withSecrets.Hash() == h1 {
+		t.Error("Expected secret change to alter hash when secrets are included")
+	}
+	if withoutSecrets.Hash() != h2 {
+		t.Error("Expected secret change to keep hash when secrets are excluded")
+	}
+}
+
+type TimedConfig struct {
+	Host     string
+	Deadline time.Time
+}
+
+type timedLoader struct {
+	data *TimedConfig
+}
+
+func (l *timedLoader) Load(dst *TimedConfig) error {
+	*dst = *l.data
+	return nil
+}
+
+func TestConfig_HashChangesOnTimeFieldChange(t *testing.T) {
+	loader := &timedLoader{data: &TimedConfig{Host: "localhost", Deadline: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}}
+
+	changes := 0
+	cfg := New[TimedConfig](loader).OnChange(func(e ChangeEvent[TimedConfig]) {
+		changes++
+	})
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	oldHash := cfg.Hash()
+
+	loader.data = &TimedConfig{Host: "localhost", Deadline: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)}
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Hash() == oldHash {
+		t.Error("Expected hash to change after a time.Time field change")
+	}
+	if changes != 1 {
+		t.Errorf("Expected 1 OnChange call, got %d", changes)
+	}
+}
+
+func TestComputeHash_MapKeysKeepTheirType(t *testing.T) {
+	a, err := computeHash(map[any]string{"1": "string", 1: "int"}, true)
+	if err != nil {
+		t.Fatalf("computeHash failed: %v", err)
+	}
+	b, err := computeHash(map[any]string{"1": "int", 1: "string"}, true)
+	if err != nil {
+		t.Fatalf("computeHash failed: %v", err)
+	}
+	if a == b {
+		t.Error("Expected the string key \"1\" and the int key 1 to hash differently")
+	}
+}
+
+func TestComputeHash_PointerKeysKeepEveryEntry(t *testing.T) {
+	first, second := "same", "same"
+	one, err := computeHash(map[*string]int{&first: 1}, true)
+	if err != nil {
+		t.Fatalf("computeHash failed: %v", err)
+	}
+	two, err := computeHash(map[*string]int{&first: 1, &second: 2}, true)
+	if err != nil {
+		t.Fatalf("computeHash failed: %v", err)
+	}
+	if one == two {
+		t.Error("Expected pointer keys that print the same not to collide")
+	}
+
+	// Stable regardless of map iteration order
+	for i := 0; i < 20; i++ {
+		again, _ := computeHash(map[*string]int{&second: 2, &first: 1}, true)
+		if again != two {
+			t.Fatalf("Expected stable hash %s, got %s", two, again)
+		}
+	}
+}
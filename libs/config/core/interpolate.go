@@ -0,0 +1,255 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ErrReferenceCycle is returned by Interpolate when references form a cycle,
+// e.g. a field that refers to itself directly or through other fields.
+var ErrReferenceCycle = errors.New("reference cycle")
+
+// Interpolate replaces every `${path}` in the string fields of cfg with the value at that dot-path
+// in cfg itself. Paths use the same keys as the loaders: the mapstructure tag, or the lowercased
+// field name. Map keys and slice indexes are path segments too ("databases.primary.dsn", "tags.0").
+//
+// Referenced fields may contain references themselves; they are resolved first.
+// Non-string values are formatted with fmt.Sprint.
+//
+// Returns error if:
+//   - A reference points to a path that does not exist
+//   - A `${` has no closing `}`
+//   - References form a cycle (wraps ErrReferenceCycle)
+//
+// Example:
+//
+//	type AppConfig struct {
+//	    App struct{ Home string } `mapstructure:"app"`
+//	    Log struct{ Dir string }  `mapstructure:"log"`
+//	}
+//
+//	cfg.App.Home = "/srv/app"
+//	cfg.Log.Dir = "${app.home}/logs"
+//	core.Interpolate(&cfg) // cfg.Log.Dir = "/srv/app/logs"
+func Interpolate[T any](cfg *T) error {
+	in := &interpolator{
+		root:     reflect.ValueOf(cfg).Elem(),
+		resolved: make(map[string]string),
+		visiting: make(map[string]bool),
+	}
+	return in.walk(in.root, "")
+}
+
+// interpolator resolves references against root, memoizing resolved paths.
+type interpolator struct {
+	root     reflect.Value
+	resolved map[string]string
+	visiting map[string]bool
+	stack    []string // paths being resolved, for cycle reporting
+}
+
+// walk visits every string reachable from v and stores its resolved value.
+// Map values and interface contents are not addressable, so they are resolved on a copy and written back.
+func (in *interpolator) walk(v reflect.Value, path string) error {
+	switch v.Kind() {
+	case reflect.String:
+		out, err := in.resolve(path, v.String())
+		if err != nil {
+			return err
+		}
+		v.SetString(out)
+
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return in.walk(v.Elem(), path)
+		}
+
+	case reflect.Interface:
+		if v.IsNil() || !v.CanSet() {
+			return nil
+		}
+		elem := reflect.New(v.Elem().Type()).Elem()
+		elem.Set(v.Elem())
+		if err := in.walk(elem, path); err != nil {
+			return err
+		}
+		v.Set(elem)
+
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			key, ok := fieldKey(v.Type().Field(i))
+			if !ok {
+				continue
+			}
+			if err := in.walk(v.Field(i), joinPath(path, key)); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := in.walk(v.Index(i), joinPath(path, strconv.Itoa(i))); err != nil {
+				return err
+			}
+		}
+
+	case reflect.Map:
+		if v.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		for _, key := range v.MapKeys() {
+			elem := reflect.New(v.Type().Elem()).Elem()
+			elem.Set(v.MapIndex(key))
+			if err := in.walk(elem, joinPath(path, key.String())); err != nil {
+				return err
+			}
+			v.SetMapIndex(key, elem)
+		}
+	}
+
+	return nil
+}
+
+// resolve substitutes all references in raw, the value found at path.
+func (in *interpolator) resolve(path, raw string) (string, error) {
+	if !strings.Contains(raw, "${") {
+		return raw, nil
+	}
+	if out, ok := in.resolved[path]; ok {
+		return out, nil
+	}
+	if in.visiting[path] {
+		cycle := append(append([]string(nil), in.stack[indexOf(in.stack, path):]...), path)
+		return "", fmt.Errorf("%w: %s", ErrReferenceCycle, strings.Join(cycle, " -> "))
+	}
+
+	in.visiting[path] = true
+	in.stack = append(in.stack, path)
+	defer func() {
+		delete(in.visiting, path)
+		in.stack = in.stack[:len(in.stack)-1]
+	}()
+
+	var out strings.Builder
+	rest := raw
+	for {
+		start := strings.Index(rest, "${")
+		if start < 0 {
+			out.WriteString(rest)
+			break
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return "", fmt.Errorf("%s: unterminated reference in %q", path, raw)
+		}
+
+		ref := rest[start+2 : start+end]
+		value, err := in.lookup(path, ref)
+		if err != nil {
+			return "", err
+		}
+
+		out.WriteString(rest[:start])
+		out.WriteString(value)
+		rest = rest[start+end+1:]
+	}
+
+	in.resolved[path] = out.String()
+	return in.resolved[path], nil
+}
+
+// lookup returns the resolved value at ref, referenced from path.
+func (in *interpolator) lookup(path, ref string) (string, error) {
+	v, canonical, ok := lookupPath(in.root, ref)
+	if !ok {
+		return "", fmt.Errorf("%s: unknown reference ${%s}", path, ref)
+	}
+
+	for v.Kind() == reflect.Interface && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.String {
+		return in.resolve(canonical, v.String())
+	}
+	return fmt.Sprint(v.Interface()), nil
+}
+
+// lookupPath walks root along the dot-separated ref and returns the value found
+// together with its canonical path (as built by walk).
+func lookupPath(root reflect.Value, ref string) (reflect.Value, string, bool) {
+	v, canonical := root, ""
+	for _, segment := range strings.Split(ref, ".") {
+		for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+			if v.IsNil() {
+				return reflect.Value{}, "", false
+			}
+			v = v.Elem()
+		}
+
+		switch v.Kind() {
+		case reflect.Struct:
+			found := false
+			for i := 0; i < v.NumField(); i++ {
+				field := v.Type().Field(i)
+				key, ok := fieldKey(field)
+				if ok && (key == segment || strings.EqualFold(field.Name, segment)) {
+					v, canonical, found = v.Field(i), joinPath(canonical, key), true
+					break
+				}
+			}
+			if !found {
+				return reflect.Value{}, "", false
+			}
+
+		case reflect.Map:
+			if v.Type().Key().Kind() != reflect.String {
+				return reflect.Value{}, "", false
+			}
+			v = v.MapIndex(reflect.ValueOf(segment).Convert(v.Type().Key()))
+			if !v.IsValid() {
+				return reflect.Value{}, "", false
+			}
+			canonical = joinPath(canonical, segment)
+
+		case reflect.Slice, reflect.Array:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= v.Len() {
+				return reflect.Value{}, "", false
+			}
+			v, canonical = v.Index(i), joinPath(canonical, segment)
+
+		default:
+			return reflect.Value{}, "", false
+		}
+	}
+
+	return v, canonical, true
+}
+
+// fieldKey returns the config key of an exported struct field, matching the loaders:
+// the mapstructure tag name, or the lowercased field name.
+func fieldKey(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+	key, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+	if key == "-" {
+		return "", false
+	}
+	if key == "" {
+		key = strings.ToLower(field.Name)
+	}
+	return key, true
+}
+
+func indexOf(items []string, item string) int {
+	for i, v := range items {
+		if v == item {
+			return i
+		}
+	}
+	return 0
+}
@@ -0,0 +1,132 @@
+package core
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type InterpolateConfig struct {
+	App struct {
+		Home string `mapstructure:"home"`
+		Port int    `mapstructure:"port"`
+	} `mapstructure:"app"`
+	Log struct {
+		Dir  string `mapstructure:"dir"`
+		File string `mapstructure:"file"`
+	} `mapstructure:"log"`
+	URL   string
+	Paths map[string]string `mapstructure:"paths"`
+}
+
+func TestInterpolate_FieldReference(t *testing.T) {
+	cfg := &InterpolateConfig{}
+	cfg.App.Home = "/srv/app"
+	cfg.App.Port = 8080
+	cfg.Log.File = "${log.dir}/app.log" // chained: log.file -> log.dir -> app.home
+	cfg.Log.Dir = "${app.home}/logs"
+	cfg.URL = "http://localhost:${app.port}"
+	cfg.Paths = map[string]string{"cache": "${app.home}/cache"}
+
+	if err := Interpolate(cfg); err != nil {
+		t.Fatalf("Interpolate failed: %v", err)
+	}
+
+	if cfg.Log.Dir != "/srv/app/logs" {
+		t.Errorf("Expected log.dir=/srv/app/logs, got %q", cfg.Log.Dir)
+	}
+	if cfg.Log.File != "/srv/app/logs/app.log" {
+		t.Errorf("Expected chained reference to resolve, got %q", cfg.Log.File)
+	}
+	if cfg.URL != "http://localhost:8080" {
+		t.Errorf("Expected non-string reference to be formatted, got %q", cfg.URL)
+	}
+	if cfg.Paths["cache"] != "/srv/app/cache" {
+		t.Errorf("Expected map value to resolve, got %q", cfg.Paths["cache"])
+	}
+}
+
+func TestInterpolate_DynamicSections(t *testing.T) {
+	type DynamicConfig struct {
+		Home    string         `mapstructure:"home"`
+		Extras  map[string]any `mapstructure:"extras"`
+		Section any            `mapstructure:"section"`
+	}
+
+	cfg := &DynamicConfig{
+		Home: "/srv/app",
+		Extras: map[string]any{
+			"cache": "${home}/cache",
+			"limits": map[string]any{
+				"dir": "${extras.cache}/limits", // chained through a dynamic value
+			},
+		},
+		Section: "${home}/section",
+	}
+
+	if err := Interpolate(cfg); err != nil {
+		t.Fatalf("Interpolate failed: %v", err)
+	}
+
+	if cfg.Extras["cache"] != "/srv/app/cache" {
+		t.Errorf("Expected map[string]any value to resolve, got %v", cfg.Extras["cache"])
+	}
+	if dir := cfg.Extras["limits"].(map[string]any)["dir"]; dir != "/srv/app/cache/limits" {
+		t.Errorf("Expected nested dynamic value to resolve, got %v", dir)
+	}
+	if cfg.Section != "/srv/app/section" {
+		t.Errorf("Expected interface field to resolve, got %v", cfg.Section)
+	}
+}
+
+func TestInterpolate_SelfReferenceCycle(t *testing.T) {
+	cfg := &InterpolateConfig{}
+	cfg.App.Home = "${app.home}/nested"
+
+	err := Interpolate(cfg)
+	if !errors.Is(err, ErrReferenceCycle) {
+		t.Fatalf("Expected ErrReferenceCycle, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "app.home -> app.home") {
+		t.Errorf("Expected cycle path in error, got %v", err)
+	}
+}
+
+func TestInterpolate_IndirectCycle(t *testing.T) {
+	cfg := &InterpolateConfig{}
+	cfg.Log.Dir = "${log.file}"
+	cfg.Log.File = "${log.dir}"
+
+	err := Interpolate(cfg)
+	if !errors.Is(err, ErrReferenceCycle) {
+		t.Fatalf("Expected ErrReferenceCycle, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "log.dir -> log.file -> log.dir") {
+		t.Errorf("Expected cycle path in error, got %v", err)
+	}
+}
+
+func TestInterpolate_UnknownReference(t *testing.T) {
+	cfg := &InterpolateConfig{}
+	cfg.Log.Dir = "${app.missing}/logs"
+
+	err := Interpolate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "log.dir: unknown reference ${app.missing}") {
+		t.Errorf("Expected unknown reference error, got %v", err)
+	}
+}
+
+func TestConfig_WithInterpolation(t *testing.T) {
+	loader := &MockLoader{data: AppConfig{}}
+	loader.data.Server.Host = "example.com"
+	loader.data.Database.Host = "db.${server.host}"
+
+	cfg := New[AppConfig](loader).WithInterpolation()
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if got := cfg.Get().Database.Host; got != "db.example.com" {
+		t.Errorf("Expected interpolated host, got %q", got)
+	}
+}
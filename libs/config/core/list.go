@@ -0,0 +1,40 @@
+package core
+
+// AppendMerge is the merge strategy for list-typed configs: src elements are appended to dst,
+// so every loader contributes its entries. Use DefaultMerge instead
+// when a later source should replace the whole list.
+//
+// Example:
+//
+//	dst := &[]Upstream{{Name: "a"}}
+//	src := &[]Upstream{{Name: "b"}, {Name: "c"}}
+//	AppendMerge(dst, src)
+//	// Result: a, b, c
+func AppendMerge[E any](dst, src *[]E) error {
+	*dst = append(*dst, *src...)
+	return nil
+}
+
+// NewListConfig creates a Config whose root is a list, such as a file containing
+// a top-level array of upstreams. Loaders fill a *[]E.
+//
+// Unlike New, where a later non-empty slice replaces the previous one (DefaultMerge),
+// entries from every loader are appended in loader order (AppendMerge).
+// Use WithMerge(core.DefaultMerge[[]E]) to get replace semantics.
+//
+// Example:
+//
+//	// upstreams.yaml:
+//	// - name: api
+//	//   url: http://api:8080
+//	// - name: auth
+//	//   url: http://auth:8080
+//	cfg := config.NewListConfig[Upstream](
+//	    loader.NewFileLoader("upstreams.yaml", "yaml"),
+//	    loader.NewFileLoader("upstreams.local.yaml", "yaml"), // appended
+//	)
+//	err := cfg.Load()
+//	upstreams := cfg.Get() // []Upstream
+func NewListConfig[E any](loaders ...Loader[*[]E]) *Config[[]E] {
+	return New[[]E](loaders...).WithMerge(AppendMerge[E])
+}
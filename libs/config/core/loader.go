@@ -5,3 +5,18 @@ type Loader[T any] interface {
 	// Load reads config from source and fills the provided data structure.
 	Load(T) error
 }
+
+// NamedLoader is an optional interface for loaders that report their type name.
+// Config.Load includes the name in loader errors, e.g. "loader[1] (EnvLoader) failed: ...";
+// loaders without it are named by their Go type.
+type NamedLoader interface {
+	// Name returns the loader type name, e.g. "EnvLoader".
+	Name() string
+}
+
+// UnusedKeysReporter is an optional interface for loaders that report, after Load,
+// the source keys that match no field of the target struct (see Config.UnusedKeys).
+type UnusedKeysReporter interface {
+	// UnusedKeys returns the unused keys of the last Load, e.g. "serevr.port".
+	UnusedKeys() []string
+}
@@ -5,15 +5,26 @@ import (
 	"reflect"
 )
 
+// mergeTag is the struct tag controlling per-field merge behavior.
+const mergeTag = "merge"
+
+// mergeKeep marks a field that keeps the first non-zero value it receives.
+const mergeKeep = "keep"
+
 // DefaultMerge is the default merge strategy using reflection.
 // Merges src into dst, only overriding non-zero values.
 //
 // Rules:
 //   - Struct fields: merge recursively, non-zero values override
 //   - Slices: override entirely if src slice is not empty
-//   - Maps: deep merge keys
-//   - Pointers: merge recursively if src is not nil
+//   - Maps: deep merge keys; struct, map and pointer values sharing a key are merged recursively
+//   - Interfaces (e.g. map[string]any values): merged recursively when both hold the same map,
+//     struct or pointer type, otherwise replaced
+//   - Pointers: merge recursively if src is not nil; a non-nil pointer to a scalar
+//     (bool, number, string) always overrides, so Ptr(false) or Ptr(0) is an explicit zero
 //   - Primitives: override if src is not zero value
+//   - Structs without exported fields (e.g. time.Time): override as a whole if src is not zero value
+//   - Fields tagged `merge:"keep"`: once set (non-zero), never overridden by later sources
 //
 // Example:
 //
@@ -36,6 +47,13 @@ func deepMerge(dst, src reflect.Value) error {
 
 	switch src.Kind() {
 	case reflect.Struct:
+		// Structs with only unexported state (e.g. time.Time) are values, not field sets
+		if !hasExportedFields(src.Type()) {
+			if !src.IsZero() {
+				dst.Set(src)
+			}
+			break
+		}
 		for i := 0; i < src.NumField(); i++ {
 			srcField := src.Field(i)
 			dstField := dst.Field(i)
@@ -44,6 +62,11 @@ func deepMerge(dst, src reflect.Value) error {
 				continue
 			}
 
+			// merge:"keep" - first non-zero value wins
+			if src.Type().Field(i).Tag.Get(mergeTag) == mergeKeep && !dstField.IsZero() {
+				continue
+			}
+
 			if !srcField.IsZero() {
 				if err := deepMerge(dstField, srcField); err != nil {
 					return fmt.Errorf("field %s: %w", src.Type().Field(i).Name, err)
@@ -66,7 +89,8 @@ func deepMerge(dst, src reflect.Value) error {
 				dstValue := dst.MapIndex(key)
 
 				if dstValue.IsValid() && !dstValue.IsZero() {
-					if srcValue.Kind() == reflect.Map || srcValue.Kind() == reflect.Struct {
+					// Entries sharing a key are deep-merged; map values are not addressable, so merge a copy
+					if kind := srcValue.Kind(); kind == reflect.Map || kind == reflect.Struct || kind == reflect.Ptr || kind == reflect.Interface {
 						merged := reflect.New(srcValue.Type()).Elem()
 						merged.Set(dstValue)
 						if err := deepMerge(merged, srcValue); err != nil {
@@ -77,13 +101,20 @@ func deepMerge(dst, src reflect.Value) error {
 						dst.SetMapIndex(key, srcValue)
 					}
 				} else {
-					dst.SetMapIndex(key, srcValue)
+					// Copy new entries so later merges never write into a loader's own maps
+					dst.SetMapIndex(key, deepCopy(srcValue))
 				}
 			}
 		}
 
 	case reflect.Ptr:
 		if !src.IsNil() {
+			if isScalar(src.Type().Elem().Kind()) {
+				// Explicit value, zero included: copy so dst never aliases the loader's pointer
+				dst.Set(reflect.New(src.Type().Elem()))
+				dst.Elem().Set(src.Elem())
+				break
+			}
 			if dst.IsNil() {
 				dst.Set(reflect.New(src.Type().Elem()))
 			}
@@ -92,6 +123,26 @@ func deepMerge(dst, src reflect.Value) error {
 			}
 		}
 
+	case reflect.Interface:
+		// Dynamic values (e.g. map[string]any sections): merge when both sides hold
+		// the same mergeable type, otherwise src replaces dst
+		if src.IsNil() {
+			break
+		}
+		srcElem := src.Elem()
+		kind := srcElem.Kind()
+		if dst.IsNil() || dst.Elem().Type() != srcElem.Type() ||
+			(kind != reflect.Map && kind != reflect.Struct && kind != reflect.Ptr) {
+			dst.Set(deepCopy(srcElem))
+			break
+		}
+		merged := reflect.New(srcElem.Type()).Elem()
+		merged.Set(deepCopy(dst.Elem()))
+		if err := deepMerge(merged, srcElem); err != nil {
+			return err
+		}
+		dst.Set(merged)
+
 	default:
 		if !src.IsZero() {
 			dst.Set(src)
@@ -101,6 +152,16 @@ func deepMerge(dst, src reflect.Value) error {
 	return nil
 }
 
+// isScalar reports whether kind holds a single value that pointers use to express
+// "explicitly set", as opposed to containers merged field by field.
+func isScalar(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr, reflect.Interface:
+		return false
+	}
+	return true
+}
+
 // ShallowMerge is an alternative merge strategy - overrides entire struct.
 // Useful when deep merge is not needed, only full config replacement.
 //
@@ -117,3 +178,13 @@ func ShallowMerge[T any](dst, src *T) error {
 	}
 	return nil
 }
+
+// hasExportedFields reports whether t has at least one exported field.
+func hasExportedFields(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			return true
+		}
+	}
+	return false
+}
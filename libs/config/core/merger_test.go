@@ -1,7 +1,10 @@
 package core
 
 import (
+	"fmt"
+	"reflect"
 	"testing"
+	"time"
 )
 
 type TestConfig struct {
@@ -237,3 +240,395 @@ func TestDefaultMerge_PointerFields(t *testing.T) {
 		t.Errorf("Expected value=200, got %v", dst.Value)
 	}
 }
+
+func TestDefaultMerge_KeepTag(t *testing.T) {
+	type KeepConfig struct {
+		InstanceID string `merge:"keep"`
+		Host       string
+	}
+
+	loader1 := &KeepConfig{InstanceID: "from-loader1", Host: "localhost"}
+	loader2 := &KeepConfig{InstanceID: "from-loader2", Host: "example.com"}
+
+	dst := &KeepConfig{}
+	if err := DefaultMerge(dst, loader1); err != nil {
+		t.Fatalf("DefaultMerge failed: %v", err)
+	}
+	if err := DefaultMerge(dst, loader2); err != nil {
+		t.Fatalf("DefaultMerge failed: %v", err)
+	}
+
+	// Kept field retains loader1's value
+	if dst.InstanceID != "from-loader1" {
+		t.Errorf("Expected InstanceID=from-loader1, got %s", dst.InstanceID)
+	}
+
+	// Untagged field is overridden as usual
+	if dst.Host != "example.com" {
+		t.Errorf("Expected Host=example.com, got %s", dst.Host)
+	}
+}
+
+func TestDefaultMerge_KeepTagSetsWhenZero(t *testing.T) {
+	type KeepConfig struct {
+		InstanceID string `merge:"keep"`
+	}
+
+	dst := &KeepConfig{}
+	src := &KeepConfig{InstanceID: "late"}
+
+	if err := DefaultMerge(dst, src); err != nil {
+		t.Fatalf("DefaultMerge failed: %v", err)
+	}
+
+	if dst.InstanceID != "late" {
+		t.Errorf("Expected InstanceID=late, got %s", dst.InstanceID)
+	}
+}
+
+type DBConfig struct {
+	DSN      string
+	MaxConns int
+	Options  map[string]string
+}
+
+type MultiDBConfig struct {
+	Databases map[string]DBConfig
+	Replicas  map[string]*DBConfig
+}
+
+func TestDefaultMerge_MapOfStructsOverlappingKeys(t *testing.T) {
+	dst := &MultiDBConfig{Databases: map[string]DBConfig{
+		"primary":   {DSN: "postgres://primary", MaxConns: 10, Options: map[string]string{"sslmode": "require"}},
+		"analytics": {DSN: "postgres://analytics"},
+	}}
+	src := &MultiDBConfig{Databases: map[string]DBConfig{
+		"primary": {MaxConns: 50, Options: map[string]string{"timeout": "5s"}},
+		"cache":   {DSN: "redis://cache"},
+	}}
+
+	if err := DefaultMerge(dst, src); err != nil {
+		t.Fatalf("DefaultMerge failed: %v", err)
+	}
+
+	primary := dst.Databases["primary"]
+	// Verify: Overlapping entry is deep-merged, not replaced
+	if primary.DSN != "postgres://primary" {
+		t.Errorf("Expected primary DSN to be kept, got %q", primary.DSN)
+	}
+	if primary.MaxConns != 50 {
+		t.Errorf("Expected primary MaxConns=50, got %d", primary.MaxConns)
+	}
+	if primary.Options["sslmode"] != "require" || primary.Options["timeout"] != "5s" {
+		t.Errorf("Expected nested options to be merged, got %v", primary.Options)
+	}
+
+	// Verify: Non-overlapping entries from both sides are present
+	if dst.Databases["analytics"].DSN != "postgres://analytics" {
+		t.Error("Expected dst-only entry to be kept")
+	}
+	if dst.Databases["cache"].DSN != "redis://cache" {
+		t.Error("Expected src-only entry to be added")
+	}
+}
+
+func TestDefaultMerge_MapOfStructPointers(t *testing.T) {
+	dst := &MultiDBConfig{Replicas: map[string]*DBConfig{
+		"eu": {DSN: "postgres://eu", MaxConns: 5},
+	}}
+	src := &MultiDBConfig{Replicas: map[string]*DBConfig{
+		"eu": {MaxConns: 20},
+	}}
+
+	if err := DefaultMerge(dst, src); err != nil {
+		t.Fatalf("DefaultMerge failed: %v", err)
+	}
+
+	eu := dst.Replicas["eu"]
+	if eu.DSN != "postgres://eu" || eu.MaxConns != 20 {
+		t.Errorf("Expected pointer entry to be deep-merged, got %+v", eu)
+	}
+}
+
+// newMergeFixture returns the fixed dst/src pair used by the strategy tests and benchmarks:
+// a file-like base config and an env-like partial override.
+func newMergeFixture() (dst, src *TestConfig) {
+	dst = &TestConfig{}
+	dst.Server.Host = "localhost"
+	dst.Server.Port = 8080
+	dst.Database.Host = "dbhost"
+	dst.Database.Port = 5432
+	dst.Features = map[string]bool{"beta": true, "search": true}
+	dst.Tags = []string{"file"}
+
+	src = &TestConfig{}
+	src.Server.Port = 9090
+	src.Database.Username = "admin"
+	src.Database.Password = "secret"
+	src.Features = map[string]bool{"search": false, "export": true}
+	return dst, src
+}
+
+func TestMergeStrategies_DocumentedResults(t *testing.T) {
+	expectedDeep := TestConfig{}
+	expectedDeep.Server.Host = "localhost"
+	expectedDeep.Server.Port = 9090
+	expectedDeep.Database.Host = "dbhost"
+	expectedDeep.Database.Port = 5432
+	expectedDeep.Database.Username = "admin"
+	expectedDeep.Database.Password = "secret"
+	expectedDeep.Features = map[string]bool{"beta": true, "search": false, "export": true}
+	expectedDeep.Tags = []string{"file"}
+
+	_, src := newMergeFixture()
+	expectedShallow := *src
+
+	tests := []struct {
+		name     string
+		merge    MergeFunc[TestConfig]
+		expected TestConfig
+	}{
+		{"DefaultMerge", DefaultMerge[TestConfig], expectedDeep},
+		{"CachedMerge", CachedMerge[TestConfig], expectedDeep},
+		{"ShallowMerge", ShallowMerge[TestConfig], expectedShallow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dst, src := newMergeFixture()
+			if err := tt.merge(dst, src); err != nil {
+				t.Fatalf("merge failed: %v", err)
+			}
+			if !reflect.DeepEqual(*dst, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, *dst)
+			}
+		})
+	}
+}
+
+func TestCachedMerge_MatchesDefaultMerge(t *testing.T) {
+	type KeepConfig struct {
+		Name    string `merge:"keep"`
+		Version int
+		Dbs     map[string]DBConfig
+		Replica *DBConfig
+	}
+
+	newPair := func() (*KeepConfig, *KeepConfig) {
+		dst := &KeepConfig{
+			Name: "first",
+			Dbs:  map[string]DBConfig{"primary": {DSN: "postgres://primary", MaxConns: 10}},
+		}
+		src := &KeepConfig{
+			Name:    "second",
+			Version: 2,
+			Dbs:     map[string]DBConfig{"primary": {MaxConns: 50}, "cache": {DSN: "redis://cache"}},
+			Replica: &DBConfig{DSN: "postgres://replica"},
+		}
+		return dst, src
+	}
+
+	expected, src := newPair()
+	if err := DefaultMerge(expected, src); err != nil {
+		t.Fatalf("DefaultMerge failed: %v", err)
+	}
+
+	// Run twice: the second call uses the cached plan
+	for i := 0; i < 2; i++ {
+		dst, src := newPair()
+		if err := CachedMerge(dst, src); err != nil {
+			t.Fatalf("CachedMerge failed: %v", err)
+		}
+		if !reflect.DeepEqual(dst, expected) {
+			t.Errorf("call %d: expected %+v, got %+v", i+1, expected, dst)
+		}
+	}
+}
+
+func TestCachedMerge_MatchesDefaultMergeCases(t *testing.T) {
+	type ParityConfig struct {
+		Name    string `merge:"keep"`
+		Enabled *bool
+		Replica *DBConfig
+		Dbs     map[string]DBConfig
+		Extras  map[string]any
+		Section any
+	}
+
+	tests := []struct {
+		name     string
+		dst, src func() *ParityConfig
+	}{
+		{
+			name: "pointers",
+			dst: func() *ParityConfig {
+				return &ParityConfig{Enabled: Ptr(true), Replica: &DBConfig{DSN: "postgres://a"}}
+			},
+			src: func() *ParityConfig { return &ParityConfig{Enabled: Ptr(false), Replica: &DBConfig{MaxConns: 5}} },
+		},
+		{
+			name: "maps",
+			dst:  func() *ParityConfig { return &ParityConfig{Dbs: map[string]DBConfig{"primary": {DSN: "postgres://a"}}} },
+			src: func() *ParityConfig {
+				return &ParityConfig{Dbs: map[string]DBConfig{"primary": {MaxConns: 5}, "cache": {DSN: "redis://c"}}}
+			},
+		},
+		{
+			name: "interface map values",
+			dst:  func() *ParityConfig { return &ParityConfig{Extras: map[string]any{"x": map[string]any{"a": 1}}} },
+			src: func() *ParityConfig {
+				return &ParityConfig{Extras: map[string]any{"x": map[string]any{"b": 2}, "y": "new"}}
+			},
+		},
+		{
+			name: "interface fields",
+			dst:  func() *ParityConfig { return &ParityConfig{Section: map[string]any{"a": 1, "b": 1}} },
+			src:  func() *ParityConfig { return &ParityConfig{Section: map[string]any{"b": 2}} },
+		},
+		{
+			name: "interface type change",
+			dst:  func() *ParityConfig { return &ParityConfig{Section: map[string]any{"a": 1}} },
+			src:  func() *ParityConfig { return &ParityConfig{Section: "replaced"} },
+		},
+		{
+			name: "keep",
+			dst:  func() *ParityConfig { return &ParityConfig{Name: "first"} },
+			src:  func() *ParityConfig { return &ParityConfig{Name: "second", Enabled: Ptr(true)} },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := tt.dst()
+			if err := DefaultMerge(expected, tt.src()); err != nil {
+				t.Fatalf("DefaultMerge failed: %v", err)
+			}
+
+			dst, src := tt.dst(), tt.src()
+			if err := CachedMerge(dst, src); err != nil {
+				t.Fatalf("CachedMerge failed: %v", err)
+			}
+			if !reflect.DeepEqual(dst, expected) {
+				t.Errorf("Expected %+v, got %+v", expected, dst)
+			}
+		})
+	}
+}
+
+func TestCachedMerge_CopiesNewMapEntries(t *testing.T) {
+	type DynamicConfig struct {
+		Extras map[string]any
+	}
+
+	dst := &DynamicConfig{}
+	src := &DynamicConfig{Extras: map[string]any{"limits": map[string]any{"rps": 100}}}
+	if err := CachedMerge(dst, src); err != nil {
+		t.Fatalf("CachedMerge failed: %v", err)
+	}
+
+	// Verify: Later changes to the merged config never reach the loader's map
+	dst.Extras["limits"].(map[string]any)["rps"] = 1
+	if rps := src.Extras["limits"].(map[string]any)["rps"]; rps != 100 {
+		t.Errorf("Expected loader map to be untouched, got rps %v", rps)
+	}
+}
+
+func TestCachedMerge_SelfReferencingType(t *testing.T) {
+	type Node struct {
+		Name string
+		Next *Node
+	}
+
+	dst := &Node{Name: "a", Next: &Node{Name: "b"}}
+	src := &Node{Next: &Node{Next: &Node{Name: "c"}}}
+
+	if err := CachedMerge(dst, src); err != nil {
+		t.Fatalf("CachedMerge failed: %v", err)
+	}
+
+	if dst.Name != "a" || dst.Next.Name != "b" || dst.Next.Next == nil || dst.Next.Next.Name != "c" {
+		t.Errorf("Expected a -> b -> c, got %+v", dst)
+	}
+}
+
+// Merge benchmarks reuse one dst, measuring the steady-state cost of merging
+// a partial override into an already populated config.
+
+func benchmarkMerge(b *testing.B, merge MergeFunc[TestConfig]) {
+	dst, src := newMergeFixture()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := merge(dst, src); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDefaultMerge(b *testing.B) {
+	benchmarkMerge(b, DefaultMerge[TestConfig])
+}
+
+func BenchmarkCachedMerge(b *testing.B) {
+	benchmarkMerge(b, CachedMerge[TestConfig])
+}
+
+func BenchmarkShallowMerge(b *testing.B) {
+	benchmarkMerge(b, ShallowMerge[TestConfig])
+}
+
+func TestAppendMerge(t *testing.T) {
+	dst := []string{"a"}
+	src := []string{"b", "c"}
+
+	if err := AppendMerge(&dst, &src); err != nil {
+		t.Fatalf("AppendMerge failed: %v", err)
+	}
+	if fmt.Sprint(dst) != "[a b c]" {
+		t.Errorf("Expected appended entries, got %v", dst)
+	}
+
+	// Verify: DefaultMerge replaces the list instead
+	replaced := []string{"a"}
+	if err := DefaultMerge(&replaced, &src); err != nil {
+		t.Fatalf("DefaultMerge failed: %v", err)
+	}
+	if fmt.Sprint(replaced) != "[b c]" {
+		t.Errorf("Expected DefaultMerge to replace, got %v", replaced)
+	}
+}
+
+func TestMerge_TimeFields(t *testing.T) {
+	type TimedConfig struct {
+		Deadline time.Time
+		Expires  *time.Time
+		Windows  map[string]time.Time
+	}
+
+	first := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	second := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	for name, merge := range map[string]func(dst, src *TimedConfig) error{
+		"DefaultMerge": DefaultMerge[TimedConfig],
+		"CachedMerge":  CachedMerge[TimedConfig],
+	} {
+		t.Run(name, func(t *testing.T) {
+			dst := &TimedConfig{Deadline: first, Windows: map[string]time.Time{"a": first}}
+			src := &TimedConfig{Deadline: second, Expires: &second, Windows: map[string]time.Time{"a": second}}
+			if err := merge(dst, src); err != nil {
+				t.Fatalf("merge failed: %v", err)
+			}
+			if !dst.Deadline.Equal(second) || dst.Expires == nil || !dst.Expires.Equal(second) || !dst.Windows["a"].Equal(second) {
+				t.Errorf("Expected every time to be %v, got %+v", second, dst)
+			}
+
+			// A zero time does not override
+			if err := merge(dst, &TimedConfig{}); err != nil {
+				t.Fatalf("merge failed: %v", err)
+			}
+			if !dst.Deadline.Equal(second) {
+				t.Errorf("Expected zero time to keep %v, got %v", second, dst.Deadline)
+			}
+		})
+	}
+}
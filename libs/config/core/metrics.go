@@ -0,0 +1,64 @@
+package core
+
+import "time"
+
+// MetricsSink receives the duration of every loader call made by Config.Load.
+// Implement it to export load timings to a metrics system; see contrib/prometheus.
+type MetricsSink interface {
+	// ObserveLoaderDuration records one loader call. name identifies the loader
+	// (its String() if it implements fmt.Stringer, otherwise "[index]"); err reports whether it failed.
+	ObserveLoaderDuration(name string, d time.Duration, err bool)
+}
+
+// WarnLogger is the logging capability used for slow-loader warnings.
+// ISugaredLogger from the log library satisfies it.
+type WarnLogger interface {
+	Warnw(msg string, keysAndValues ...any)
+}
+
+// WithMetrics reports every loader's duration to sink on each Load.
+// Returns *Config[T] to support method chaining.
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](fileLoader, vaultLoader).
+//	    WithMetrics(configprom.NewSink(prometheus.DefaultRegisterer))
+func (c *Config[T]) WithMetrics(sink MetricsSink) *Config[T] {
+	c.metrics = sink
+	return c
+}
+
+// WithSlowLoaderWarning logs a warning through logger when a loader takes longer than threshold,
+// e.g. a remote loader that hangs at startup. The loader still runs to completion.
+// Returns *Config[T] to support method chaining.
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](fileLoader, vaultLoader).
+//	    WithSlowLoaderWarning(2*time.Second, logger)
+//	// WARN config loader slow  loader=vault(secret/app) duration=3.2s threshold=2s
+func (c *Config[T]) WithSlowLoaderWarning(threshold time.Duration, logger WarnLogger) *Config[T] {
+	c.slowThreshold = threshold
+	c.slowLogger = logger
+	return c
+}
+
+// observeLoader reports one loader call to the metrics sink and the slow-loader warning.
+func (c *Config[T]) observeLoader(index int, loader Loader[*T], d time.Duration, err error) {
+	if c.metrics == nil && c.slowLogger == nil {
+		return
+	}
+
+	name := describeLoader(index, loader)
+	if c.metrics != nil {
+		c.metrics.ObserveLoaderDuration(name, d, err != nil)
+	}
+	if c.slowLogger != nil && d > c.slowThreshold {
+		c.slowLogger.Warnw("config loader slow",
+			"loader", name,
+			"duration", d,
+			"threshold", c.slowThreshold,
+			"failed", err != nil,
+		)
+	}
+}
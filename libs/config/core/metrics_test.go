@@ -0,0 +1,102 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// slowLoader sleeps before delegating to MockLoader.
+type slowLoader struct {
+	MockLoader
+	name  string
+	delay time.Duration
+}
+
+func (s *slowLoader) Load(dst *AppConfig) error {
+	time.Sleep(s.delay)
+	return s.MockLoader.Load(dst)
+}
+
+func (s *slowLoader) String() string {
+	return s.name
+}
+
+type observation struct {
+	name string
+	d    time.Duration
+	err  bool
+}
+
+type recordingSink struct {
+	observations []observation
+}
+
+func (r *recordingSink) ObserveLoaderDuration(name string, d time.Duration, err bool) {
+	r.observations = append(r.observations, observation{name, d, err})
+}
+
+type warning struct {
+	msg    string
+	fields map[string]any
+}
+
+type recordingWarnLogger struct {
+	warnings []warning
+}
+
+func (r *recordingWarnLogger) Warnw(msg string, keysAndValues ...any) {
+	fields := make(map[string]any)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields[fmt.Sprint(keysAndValues[i])] = keysAndValues[i+1]
+	}
+	r.warnings = append(r.warnings, warning{msg, fields})
+}
+
+func TestConfig_WithMetrics_ObservesEveryLoader(t *testing.T) {
+	fast := &MockLoader{}
+	slow := &slowLoader{name: "remote(vault)", delay: 20 * time.Millisecond}
+	failing := &MockLoader{err: errors.New("connection refused")}
+
+	sink := &recordingSink{}
+	err := New[AppConfig](fast, slow, failing).WithMetrics(sink).Load()
+	if err == nil {
+		t.Fatal("Expected Load to fail on the last loader")
+	}
+
+	if len(sink.observations) != 3 {
+		t.Fatalf("Expected 3 observations, got %+v", sink.observations)
+	}
+	if got := sink.observations[0]; got.name != "[0]" || got.err {
+		t.Errorf("Expected [0] without error, got %+v", got)
+	}
+	if got := sink.observations[1]; got.name != "remote(vault)" || got.d < slow.delay || got.err {
+		t.Errorf("Expected remote(vault) >= %v without error, got %+v", slow.delay, got)
+	}
+	if got := sink.observations[2]; got.name != "[2]" || !got.err {
+		t.Errorf("Expected [2] with error, got %+v", got)
+	}
+}
+
+func TestConfig_WithSlowLoaderWarning(t *testing.T) {
+	fast := &MockLoader{}
+	slow := &slowLoader{name: "remote(vault)", delay: 20 * time.Millisecond}
+
+	logger := &recordingWarnLogger{}
+	cfg := New[AppConfig](fast, slow).WithSlowLoaderWarning(10*time.Millisecond, logger)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if len(logger.warnings) != 1 {
+		t.Fatalf("Expected 1 warning for the slow loader, got %v", logger.warnings)
+	}
+	got := logger.warnings[0]
+	if got.msg != "config loader slow" || got.fields["loader"] != "remote(vault)" {
+		t.Errorf("Expected warning naming remote(vault), got %+v", got)
+	}
+	if got.fields["threshold"] != 10*time.Millisecond {
+		t.Errorf("Expected threshold field 10ms, got %v", got.fields["threshold"])
+	}
+}
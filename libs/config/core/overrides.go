@@ -0,0 +1,77 @@
+package core
+
+import (
+	"context"
+	"reflect"
+)
+
+// overridesKey keys the override chain for config type T in a context,
+// so overrides for different config types never mix.
+type overridesKey[T any] struct{}
+
+// overrides is one link of an override chain; parent holds the outer overrides.
+type overrides[T any] struct {
+	parent *overrides[T]
+	mutate func(*T)
+}
+
+// apply runs the chain on cfg, outermost first.
+func (o *overrides[T]) apply(cfg *T) {
+	if o.parent != nil {
+		o.parent.apply(cfg)
+	}
+	o.mutate(cfg)
+}
+
+// WithOverrides returns a context whose GetCtx reads of a Config[T] see mutate applied,
+// after any overrides already registered in ctx (outer then inner).
+// Overrides are only honored by a Config built with AllowContextOverrides.
+//
+// Example:
+//
+//	ctx := core.WithOverrides(ctx, func(c *AppConfig) {
+//	    c.Features.NewCheckout = true
+//	})
+//	cfg.GetCtx(ctx).Features.NewCheckout // true
+func WithOverrides[T any](ctx context.Context, mutate func(*T)) context.Context {
+	parent, _ := ctx.Value(overridesKey[T]{}).(*overrides[T])
+	return context.WithValue(ctx, overridesKey[T]{}, &overrides[T]{parent: parent, mutate: mutate})
+}
+
+// AllowContextOverrides makes GetCtx honor overrides registered with WithOverrides.
+// Without it, GetCtx ignores them, so production paths cannot be changed through a context by accident.
+// Returns *Config[T] to support method chaining.
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](loaders...).
+//	    AllowContextOverrides() // tests only
+func (c *Config[T]) AllowContextOverrides() *Config[T] {
+	c.allowOverrides = true
+	return c
+}
+
+// GetCtx returns the config from the last successful Load with the overrides in ctx applied
+// (see WithOverrides and AllowContextOverrides).
+// Overrides run on a deep copy, so shared state is never modified; without overrides
+// GetCtx costs a single ctx.Value lookup.
+// GetCtx is safe to call concurrently with Load; unlike Get, it does not see changes made through GetPtr.
+func (c *Config[T]) GetCtx(ctx context.Context) T {
+	var cfg T
+	if snapshot := c.snapshot.Load(); snapshot != nil {
+		cfg = *snapshot
+	}
+	if !c.allowOverrides {
+		return cfg
+	}
+
+	chain, ok := ctx.Value(overridesKey[T]{}).(*overrides[T])
+	if !ok {
+		return cfg
+	}
+
+	copied := new(T)
+	reflect.ValueOf(copied).Elem().Set(deepCopy(reflect.ValueOf(&cfg).Elem()))
+	chain.apply(copied)
+	return *copied
+}
@@ -0,0 +1,160 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+func TestConfig_GetCtx_LayeredOverrides(t *testing.T) {
+	loader := &MockLoader{data: AppConfig{}}
+	loader.data.Server.Host = "localhost"
+	loader.data.Server.Port = 8080
+
+	cfg := New[AppConfig](loader).AllowContextOverrides()
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	outer := WithOverrides(context.Background(), func(c *AppConfig) {
+		c.Server.Port = 9090
+		c.Server.Host = "outer"
+	})
+	inner := WithOverrides(outer, func(c *AppConfig) {
+		// Inner overrides run after outer ones and see their result
+		if c.Server.Port != 9090 {
+			t.Errorf("Expected inner override to see port 9090, got %d", c.Server.Port)
+		}
+		c.Server.Host = "inner"
+	})
+
+	if got := cfg.GetCtx(inner); got.Server.Host != "inner" || got.Server.Port != 9090 {
+		t.Errorf("Expected inner:9090, got %s:%d", got.Server.Host, got.Server.Port)
+	}
+	if got := cfg.GetCtx(outer); got.Server.Host != "outer" || got.Server.Port != 9090 {
+		t.Errorf("Expected outer:9090, got %s:%d", got.Server.Host, got.Server.Port)
+	}
+	if got := cfg.GetCtx(context.Background()); got.Server.Host != "localhost" || got.Server.Port != 8080 {
+		t.Errorf("Expected localhost:8080 without overrides, got %s:%d", got.Server.Host, got.Server.Port)
+	}
+	if got := cfg.Get(); got.Server.Host != "localhost" {
+		t.Errorf("Expected Get to be unaffected by overrides, got %s", got.Server.Host)
+	}
+}
+
+func TestConfig_GetCtx_OverridesDoNotTouchSharedMaps(t *testing.T) {
+	loader := &dynamicLoader{data: DynamicConfig{
+		Features: map[string]FeatureConfig{"beta": {Enabled: false}},
+	}}
+
+	cfg := New[DynamicConfig](loader).AllowContextOverrides()
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	ctx := WithOverrides(context.Background(), func(c *DynamicConfig) {
+		c.Features["beta"] = FeatureConfig{Enabled: true}
+	})
+
+	if !cfg.GetCtx(ctx).Features["beta"].Enabled {
+		t.Error("Expected override to enable beta")
+	}
+	if cfg.Get().Features["beta"].Enabled {
+		t.Error("Expected shared config map to be untouched")
+	}
+	if cfg.GetCtx(context.Background()).Features["beta"].Enabled {
+		t.Error("Expected snapshot map to be untouched")
+	}
+}
+
+func TestConfig_GetCtx_DisabledIgnoresOverrides(t *testing.T) {
+	loader := &MockLoader{data: AppConfig{}}
+	loader.data.Server.Port = 8080
+
+	cfg := New[AppConfig](loader)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	called := false
+	ctx := WithOverrides(context.Background(), func(c *AppConfig) {
+		called = true
+		c.Server.Port = 1
+	})
+
+	if got := cfg.GetCtx(ctx); got.Server.Port != 8080 {
+		t.Errorf("Expected overrides to be ignored, got port %d", got.Server.Port)
+	}
+	if called {
+		t.Error("Expected override not to run without AllowContextOverrides")
+	}
+}
+
+func TestConfig_GetCtx_IgnoresOverridesForOtherTypes(t *testing.T) {
+	loader := &MockLoader{data: AppConfig{}}
+	loader.data.Server.Port = 8080
+
+	cfg := New[AppConfig](loader).AllowContextOverrides()
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	ctx := WithOverrides(context.Background(), func(c *DynamicConfig) {
+		t.Error("Expected DynamicConfig override not to run for AppConfig")
+	})
+
+	if got := cfg.GetCtx(ctx); got.Server.Port != 8080 {
+		t.Errorf("Expected port 8080, got %d", got.Server.Port)
+	}
+}
+
+func TestConfig_GetCtx_BeforeLoad(t *testing.T) {
+	cfg := New[AppConfig]().AllowContextOverrides()
+
+	ctx := WithOverrides(context.Background(), func(c *AppConfig) {
+		c.Server.Port = 9090
+	})
+
+	if got := cfg.GetCtx(ctx); got.Server.Port != 9090 {
+		t.Errorf("Expected override on zero config, got port %d", got.Server.Port)
+	}
+}
+
+func TestConfig_GetCtx_ConcurrentWithLoad(t *testing.T) {
+	loader := &dynamicLoader{data: DynamicConfig{
+		Features: map[string]FeatureConfig{"beta": {Rollout: 10}},
+	}}
+
+	cfg := New[DynamicConfig](loader).AllowContextOverrides()
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	ctx := WithOverrides(context.Background(), func(c *DynamicConfig) {
+		c.Features["beta"] = FeatureConfig{Enabled: true, Rollout: 100}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 200; j++ {
+				if got := cfg.GetCtx(ctx).Features["beta"]; !got.Enabled || got.Rollout != 100 {
+					t.Errorf("Expected overridden beta, got %+v", got)
+					return
+				}
+			}
+		}()
+	}
+	for i := 0; i < 50; i++ {
+		if err := cfg.Load(); err != nil {
+			t.Fatalf("Load failed: %v", err)
+		}
+	}
+	wg.Wait()
+
+	if got := cfg.GetCtx(context.Background()).Features["beta"]; got.Enabled || got.Rollout != 10 {
+		t.Errorf("Expected loaded beta to be untouched, got %+v", got)
+	}
+}
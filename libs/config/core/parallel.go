@@ -0,0 +1,118 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// ContextLoader is an optional interface for loaders that can be cancelled.
+// With WithParallelLoaders, Config.Load calls LoadContext instead of Load and cancels ctx
+// as soon as another loader fails, so remote fetches stop early.
+type ContextLoader[T any] interface {
+	// LoadContext is Load, abandoning the fetch when ctx is done.
+	LoadContext(ctx context.Context, dst T) error
+}
+
+// WithParallelLoaders makes Load fetch every loader's temp struct concurrently,
+// then merge them sequentially in declared order, so priority is the same as a sequential Load
+// regardless of which fetch completes first. Useful with several slow remote loaders.
+//
+// The first loader error cancels outstanding fetches: loaders not yet started are skipped and
+// ContextLoader implementations see their context cancelled. Load waits for running fetches
+// before returning the error, so no loader outlives Load.
+// Metrics and slow-loader warnings are reported after all fetches, in declared order.
+// Returns *Config[T] to support method chaining.
+//
+// Example:
+//
+//	cfg := config.New[AppConfig](fileLoader, httpLoader, vaultLoader, consulLoader).
+//	    WithParallelLoaders(true).
+//	    WithMaxConcurrentLoads(2)
+func (c *Config[T]) WithParallelLoaders(enabled bool) *Config[T] {
+	c.parallel = enabled
+	return c
+}
+
+// WithMaxConcurrentLoads bounds how many loaders fetch at once with WithParallelLoaders.
+// n <= 0 means no bound (the default).
+// Returns *Config[T] to support method chaining.
+func (c *Config[T]) WithMaxConcurrentLoads(n int) *Config[T] {
+	c.maxConcurrent = n
+	return c
+}
+
+// fetchResult is the outcome of one loader call
+type fetchResult[T any] struct {
+	temp     *T
+	duration time.Duration
+	err      error
+	started  bool
+}
+
+// fetchParallel runs every loader into its own temp struct concurrently and returns
+// the temps in loader order, or the first loader error.
+func (c *Config[T]) fetchParallel() ([]*T, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	limit := c.maxConcurrent
+	if limit <= 0 || limit > len(c.loaders) {
+		limit = len(c.loaders)
+	}
+	slots := make(chan struct{}, limit)
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	results := make([]fetchResult[T], len(c.loaders))
+
+	for i, loader := range c.loaders {
+		wg.Add(1)
+		go func(i int, loader Loader[*T]) {
+			defer wg.Done()
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				return
+			}
+			if ctx.Err() != nil {
+				return
+			}
+
+			temp := new(T)
+			start := time.Now()
+			var err error
+			if cl, ok := loader.(ContextLoader[*T]); ok {
+				err = cl.LoadContext(ctx, temp)
+			} else {
+				err = loader.Load(temp)
+			}
+			results[i] = fetchResult[T]{temp: temp, duration: time.Since(start), err: err, started: true}
+
+			if err != nil {
+				once.Do(func() {
+					firstErr = loaderError(i, loader, err)
+					cancel()
+				})
+			}
+		}(i, loader)
+	}
+	wg.Wait()
+
+	temps := make([]*T, len(c.loaders))
+	for i, r := range results {
+		if r.started {
+			c.observeLoader(i, c.loaders[i], r.duration, r.err)
+		}
+		temps[i] = r.temp
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return temps, nil
+}
@@ -0,0 +1,246 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// gatedLoader sets Server.Host to host once gate is closed
+type gatedLoader struct {
+	host string
+	gate chan struct{}
+	done chan<- string
+}
+
+func (g *gatedLoader) Load(dst *AppConfig) error {
+	<-g.gate
+	dst.Server.Host = g.host
+	g.done <- g.host
+	return nil
+}
+
+// blockingLoader closes started, then blocks until its context is cancelled
+type blockingLoader struct {
+	started   chan struct{}
+	cancelled atomic.Bool
+}
+
+func (b *blockingLoader) Load(dst *AppConfig) error {
+	return b.LoadContext(context.Background(), dst)
+}
+
+func (b *blockingLoader) LoadContext(ctx context.Context, dst *AppConfig) error {
+	close(b.started)
+	<-ctx.Done()
+	b.cancelled.Store(true)
+	return ctx.Err()
+}
+
+// failingNamedLoader waits for after, then fails with a NamedLoader name
+type failingNamedLoader struct {
+	MockLoader
+	after chan struct{}
+}
+
+func (f *failingNamedLoader) Load(dst *AppConfig) error {
+	<-f.after
+	return f.MockLoader.Load(dst)
+}
+
+func (failingNamedLoader) Name() string { return "VaultLoader" }
+
+// flagLoader fails and sets failed if fail is true; otherwise it counts starts after a failure
+type flagLoader struct {
+	failed       *atomic.Bool
+	fail         bool
+	startedAfter *atomic.Int32
+}
+
+func (f *flagLoader) Load(dst *AppConfig) error {
+	if f.fail {
+		f.failed.Store(true)
+		return errors.New("unreachable")
+	}
+	if f.failed.Load() {
+		f.startedAfter.Add(1)
+	}
+	return nil
+}
+
+// countingLoader records the peak number of concurrent Load calls
+type countingLoader struct {
+	inFlight, peak *atomic.Int32
+}
+
+func (c countingLoader) Load(dst *AppConfig) error {
+	n := c.inFlight.Add(1)
+	defer c.inFlight.Add(-1)
+	for {
+		p := c.peak.Load()
+		if n <= p || c.peak.CompareAndSwap(p, n) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+	dst.Server.Port++
+	return nil
+}
+
+func TestConfig_ParallelLoaders_MergesInDeclaredOrder(t *testing.T) {
+	done := make(chan string, 3)
+	loaders := []*gatedLoader{
+		{host: "file", gate: make(chan struct{}), done: done},
+		{host: "env", gate: make(chan struct{}), done: done},
+		{host: "flag", gate: make(chan struct{}), done: done},
+	}
+
+	cfg := New[AppConfig](loaders[0], loaders[1], loaders[2]).WithParallelLoaders(true)
+	errc := make(chan error, 1)
+	go func() { errc <- cfg.Load() }()
+
+	// Complete the fetches in reverse order: highest priority first
+	var completed []string
+	for i := len(loaders) - 1; i >= 0; i-- {
+		close(loaders[i].gate)
+		completed = append(completed, <-done)
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if strings.Join(completed, ",") != "flag,env,file" {
+		t.Fatalf("Expected fetches to complete out of order, got %v", completed)
+	}
+	if got := cfg.Get().Server.Host; got != "flag" {
+		t.Errorf("Expected last declared loader to win, got %q", got)
+	}
+}
+
+func TestConfig_ParallelLoaders_MatchesSequential(t *testing.T) {
+	file := &MockLoader{}
+	file.data.Server.Host = "localhost"
+	file.data.Server.Port = 8080
+	env := &MockLoader{}
+	env.data.Server.Port = 9090
+	env.data.Database.Host = "dbhost"
+
+	sequential := New[AppConfig](file, env)
+	parallel := New[AppConfig](file, env).WithParallelLoaders(true)
+	if err := sequential.Load(); err != nil {
+		t.Fatalf("Sequential load failed: %v", err)
+	}
+	if err := parallel.Load(); err != nil {
+		t.Fatalf("Parallel load failed: %v", err)
+	}
+	if sequential.Get() != parallel.Get() || sequential.Hash() != parallel.Hash() {
+		t.Errorf("Expected parallel result %+v to match sequential %+v", parallel.Get(), sequential.Get())
+	}
+}
+
+func TestConfig_ParallelLoaders_ErrorCancelsOutstanding(t *testing.T) {
+	blocking := &blockingLoader{started: make(chan struct{})}
+	failing := &failingNamedLoader{MockLoader{err: errors.New("permission denied")}, blocking.started}
+
+	cfg := New[AppConfig](blocking, failing).WithParallelLoaders(true)
+
+	errc := make(chan error, 1)
+	go func() { errc <- cfg.Load() }()
+
+	select {
+	case err := <-errc:
+		if err == nil {
+			t.Fatal("Expected error from failing loader")
+		}
+		if !strings.Contains(err.Error(), "loader[1] (VaultLoader) failed: permission denied") {
+			t.Errorf("Expected error to identify loader[1], got: %v", err)
+		}
+		if errors.Is(err, context.Canceled) {
+			t.Errorf("Expected the failing loader's error, not the cancellation: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Load did not cancel the blocking loader")
+	}
+	if !blocking.cancelled.Load() {
+		t.Error("Expected blocking loader to see its context cancelled")
+	}
+}
+
+func TestConfig_ParallelLoaders_ErrorSkipsUnstarted(t *testing.T) {
+	var failed atomic.Bool
+	var startedAfterFailure atomic.Int32
+	failing := &flagLoader{failed: &failed, fail: true}
+	others := []Loader[*AppConfig]{failing}
+	for i := 0; i < 5; i++ {
+		others = append(others, &flagLoader{failed: &failed, startedAfter: &startedAfterFailure})
+	}
+
+	cfg := New[AppConfig](others...).WithParallelLoaders(true).WithMaxConcurrentLoads(1)
+	err := cfg.Load()
+	if err == nil || !strings.Contains(err.Error(), "loader[0] (flagLoader) failed: unreachable") {
+		t.Fatalf("Expected loader[0] error, got: %v", err)
+	}
+	if n := startedAfterFailure.Load(); n != 0 {
+		t.Errorf("Expected no loader to start after the failure, %d did", n)
+	}
+}
+
+func TestConfig_ParallelLoaders_MaxConcurrentLoads(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	loader := countingLoader{&inFlight, &peak}
+
+	cfg := New[AppConfig](loader, loader, loader, loader, loader).
+		WithParallelLoaders(true).
+		WithMaxConcurrentLoads(2)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if p := peak.Load(); p > 2 {
+		t.Errorf("Expected at most 2 concurrent loads, got %d", p)
+	}
+	if got := cfg.Get().Server.Port; got != 1 {
+		t.Errorf("Expected merged port 1, got %d", got)
+	}
+}
+
+func TestConfig_ParallelLoaders_ObservesInOrder(t *testing.T) {
+	sink := &recordingSink{}
+	cfg := New[AppConfig](
+		&slowLoader{name: "file", delay: 20 * time.Millisecond},
+		&slowLoader{name: "vault"},
+	).WithParallelLoaders(true).WithMetrics(sink)
+
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(sink.observations) != 2 || sink.observations[0].name != "file" || sink.observations[1].name != "vault" {
+		t.Errorf("Expected observations in declared order, got %+v", sink.observations)
+	}
+}
+
+// Three loaders sleeping 10ms each: sequential Load takes ~30ms, parallel ~10ms.
+func benchmarkSlowLoaders(b *testing.B, parallel bool) {
+	cfg := New[AppConfig](
+		&slowLoader{name: "http", delay: 10 * time.Millisecond},
+		&slowLoader{name: "vault", delay: 10 * time.Millisecond},
+		&slowLoader{name: "consul", delay: 10 * time.Millisecond},
+	).WithParallelLoaders(parallel)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := cfg.Load(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoad_SlowLoadersSequential(b *testing.B) {
+	benchmarkSlowLoaders(b, false)
+}
+
+func BenchmarkLoad_SlowLoadersParallel(b *testing.B) {
+	benchmarkSlowLoaders(b, true)
+}
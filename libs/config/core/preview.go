@@ -0,0 +1,152 @@
+package core
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// FieldChange describes one field that a merge would change.
+type FieldChange struct {
+	// Path is the dotted field path, e.g. "Server.Port" or "Labels[team]".
+	Path string
+	Old  any
+	New  any
+}
+
+// PreviewMerge merges src into a deep copy of current using DefaultMerge and reports
+// which fields would change. current (including its maps, slices and pointers) is never modified.
+// Useful for "what-if" tooling before applying a new loader.
+//
+// Example:
+//
+//	result, changes := core.PreviewMerge(current, &AppConfig{Server: ServerConfig{Port: 9090}})
+//	for _, c := range changes {
+//	    fmt.Printf("%s: %v -> %v\n", c.Path, c.Old, c.New) // Server.Port: 8080 -> 9090
+//	}
+func PreviewMerge[T any](current T, src *T) (result T, changes []FieldChange) {
+	original := reflect.ValueOf(&current).Elem()
+	copied := reflect.ValueOf(&result).Elem()
+	copied.Set(deepCopy(original))
+
+	if src != nil {
+		// Both sides share type T, so deepMerge cannot report a type mismatch
+		_ = deepMerge(copied, reflect.ValueOf(src).Elem())
+	}
+
+	return result, diffValues("", original, copied, nil)
+}
+
+// deepCopy returns a copy of v that shares no maps, slices or pointers with it.
+// Unexported fields are copied shallowly.
+func deepCopy(v reflect.Value) reflect.Value {
+	out := reflect.New(v.Type()).Elem()
+
+	switch v.Kind() {
+	case reflect.Struct:
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if out.Field(i).CanSet() {
+				out.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+
+	case reflect.Ptr:
+		if !v.IsNil() {
+			ptr := reflect.New(v.Type().Elem())
+			ptr.Elem().Set(deepCopy(v.Elem()))
+			out.Set(ptr)
+		}
+
+	case reflect.Map:
+		if !v.IsNil() {
+			m := reflect.MakeMapWithSize(v.Type(), v.Len())
+			for _, key := range v.MapKeys() {
+				m.SetMapIndex(key, deepCopy(v.MapIndex(key)))
+			}
+			out.Set(m)
+		}
+
+	case reflect.Interface:
+		if !v.IsNil() {
+			out.Set(deepCopy(v.Elem()))
+		}
+
+	case reflect.Slice:
+		if !v.IsNil() {
+			s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+			for i := 0; i < v.Len(); i++ {
+				s.Index(i).Set(deepCopy(v.Index(i)))
+			}
+			out.Set(s)
+		}
+
+	default:
+		out.Set(v)
+	}
+
+	return out
+}
+
+// diffValues appends a FieldChange for every leaf that differs between before and after.
+// Structs, non-nil pointers and maps are walked; slices and primitives are compared whole.
+func diffValues(path string, before, after reflect.Value, changes []FieldChange) []FieldChange {
+	switch before.Kind() {
+	case reflect.Struct:
+		for i := 0; i < before.NumField(); i++ {
+			field := before.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			changes = diffValues(joinPath(path, field.Name), before.Field(i), after.Field(i), changes)
+		}
+		return changes
+
+	case reflect.Ptr:
+		if !before.IsNil() && !after.IsNil() {
+			return diffValues(path, before.Elem(), after.Elem(), changes)
+		}
+
+	case reflect.Map:
+		keys := make(map[string]reflect.Value)
+		for _, key := range append(before.MapKeys(), after.MapKeys()...) {
+			keys[fmt.Sprint(key.Interface())] = key
+		}
+		names := make([]string, 0, len(keys))
+		for name := range keys {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			key := keys[name]
+			beforeValue, afterValue := before.MapIndex(key), after.MapIndex(key)
+			keyPath := fmt.Sprintf("%s[%s]", path, name)
+			if beforeValue.IsValid() && afterValue.IsValid() {
+				changes = diffValues(keyPath, beforeValue, afterValue, changes)
+			} else {
+				changes = append(changes, FieldChange{Path: keyPath, Old: interfaceOrNil(beforeValue), New: interfaceOrNil(afterValue)})
+			}
+		}
+		return changes
+	}
+
+	if !reflect.DeepEqual(before.Interface(), after.Interface()) {
+		changes = append(changes, FieldChange{Path: path, Old: before.Interface(), New: after.Interface()})
+	}
+	return changes
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
+
+func interfaceOrNil(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+	return v.Interface()
+}
@@ -0,0 +1,87 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+type PreviewConfig struct {
+	Server struct {
+		Host string
+		Port int
+	}
+	Cache    *struct{ TTL int }
+	Features map[string]bool
+	Tags     []string
+}
+
+func TestPreviewMerge_ReportsChanges(t *testing.T) {
+	current := PreviewConfig{}
+	current.Server.Host = "localhost"
+	current.Server.Port = 8080
+	current.Features = map[string]bool{"beta": false}
+	current.Tags = []string{"a"}
+
+	src := &PreviewConfig{}
+	src.Server.Port = 9090
+	src.Features = map[string]bool{"beta": true, "dark": true}
+
+	result, changes := PreviewMerge(current, src)
+
+	if result.Server.Port != 9090 || result.Server.Host != "localhost" {
+		t.Errorf("Expected merged result, got %+v", result.Server)
+	}
+
+	expected := []FieldChange{
+		{Path: "Server.Port", Old: 8080, New: 9090},
+		{Path: "Features[beta]", Old: false, New: true},
+		{Path: "Features[dark]", Old: nil, New: true},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected changes %+v, got %+v", expected, changes)
+	}
+}
+
+func TestPreviewMerge_LeavesOriginalUntouched(t *testing.T) {
+	current := PreviewConfig{}
+	current.Server.Port = 8080
+	current.Cache = &struct{ TTL int }{TTL: 30}
+	current.Features = map[string]bool{"beta": false}
+	current.Tags = []string{"a"}
+
+	src := &PreviewConfig{}
+	src.Cache = &struct{ TTL int }{TTL: 60}
+	src.Features = map[string]bool{"beta": true}
+	src.Tags = []string{"b"}
+
+	_, changes := PreviewMerge(current, src)
+
+	// Verify: maps and pointers shared with the original are not mutated
+	if current.Features["beta"] {
+		t.Error("Original map was mutated")
+	}
+	if current.Cache.TTL != 30 {
+		t.Errorf("Original pointer was mutated, TTL=%d", current.Cache.TTL)
+	}
+	if current.Tags[0] != "a" {
+		t.Error("Original slice was mutated")
+	}
+
+	if len(changes) != 3 {
+		t.Errorf("Expected 3 changes (Cache.TTL, Features[beta], Tags), got %+v", changes)
+	}
+}
+
+func TestPreviewMerge_NoChanges(t *testing.T) {
+	current := PreviewConfig{}
+	current.Server.Port = 8080
+
+	result, changes := PreviewMerge(current, &PreviewConfig{})
+
+	if len(changes) != 0 {
+		t.Errorf("Expected no changes, got %+v", changes)
+	}
+	if result.Server.Port != 8080 {
+		t.Errorf("Expected result to equal current, got %+v", result)
+	}
+}
@@ -0,0 +1,52 @@
+package core
+
+import "fmt"
+
+// Ptr returns a pointer to v. Use it for optional fields where the zero value is a valid
+// setting, so a source can override an earlier true/8080 with an explicit false/0
+// (DefaultMerge copies any non-nil pointer to a scalar, zero included).
+//
+// Example:
+//
+//	defaults := &AppConfig{Server: ServerConfig{TLS: core.Ptr(true), Port: core.Ptr(8080)}}
+//	override := &AppConfig{Server: ServerConfig{TLS: core.Ptr(false)}}
+//	// after merging: TLS = false, Port = 8080
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref returns *p, or def if p is nil.
+//
+// Example:
+//
+//	port := core.Deref(cfg.Server.Port, 8080)
+func Deref[T any](p *T, def T) T {
+	if p == nil {
+		return def
+	}
+	return *p
+}
+
+// IsSet reports whether p is non-nil, i.e. some source set the field, even to its zero value.
+func IsSet[T any](p *T) bool {
+	return p != nil
+}
+
+// RequiredPtr creates a validator that fails when the pointer field chosen by selector is nil.
+// Unlike a zero-value check, it accepts explicit zeros such as Ptr(false) and only rejects
+// fields no source set.
+//
+// Example:
+//
+//	validator := core.NewCompositeValidator(
+//	    core.RequiredPtr("server.tls", func(cfg *AppConfig) *bool { return cfg.Server.TLS }),
+//	)
+//	// error: "server.tls is required"
+func RequiredPtr[T any, P any](name string, selector func(*T) *P) Validator[T] {
+	return ValidatorFunc[T](func(cfg *T) error {
+		if selector(cfg) == nil {
+			return fmt.Errorf("%s is required", name)
+		}
+		return nil
+	})
+}
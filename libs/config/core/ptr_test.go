@@ -0,0 +1,107 @@
+package core
+
+import (
+	"testing"
+)
+
+type OptionalConfig struct {
+	Name    string
+	Enabled *bool
+	Retries *int
+	Server  *struct {
+		Host string
+		Port *int
+	}
+}
+
+func TestPtrHelpers(t *testing.T) {
+	p := Ptr(0)
+	if !IsSet(p) || *p != 0 {
+		t.Errorf("Expected Ptr(0) to be set to 0, got %v", p)
+	}
+	*p = 5
+	if q := Ptr(0); *q != 0 {
+		t.Errorf("Expected a fresh pointer per call, got %d", *q)
+	}
+
+	var unset *int
+	if IsSet(unset) {
+		t.Error("Expected nil pointer to be unset")
+	}
+	if got := Deref(unset, 8080); got != 8080 {
+		t.Errorf("Expected default 8080, got %d", got)
+	}
+	if got := Deref(Ptr(0), 8080); got != 0 {
+		t.Errorf("Expected explicit 0, got %d", got)
+	}
+}
+
+func TestMerge_PtrExplicitZeroOverrides(t *testing.T) {
+	merges := map[string]MergeFunc[OptionalConfig]{
+		"DefaultMerge": DefaultMerge[OptionalConfig],
+		"CachedMerge":  CachedMerge[OptionalConfig],
+	}
+	for name, merge := range merges {
+		defaults := &OptionalConfig{Name: "app", Enabled: Ptr(true), Retries: Ptr(3)}
+		override := &OptionalConfig{Enabled: Ptr(false)}
+
+		dst := &OptionalConfig{}
+		if err := merge(dst, defaults); err != nil {
+			t.Fatalf("%s: merge failed: %v", name, err)
+		}
+		if err := merge(dst, override); err != nil {
+			t.Fatalf("%s: merge failed: %v", name, err)
+		}
+
+		if !IsSet(dst.Enabled) || *dst.Enabled {
+			t.Errorf("%s: expected explicit false to override true, got %v", name, dst.Enabled)
+		}
+		if Deref(dst.Retries, -1) != 3 {
+			t.Errorf("%s: expected unset Retries to keep 3, got %v", name, dst.Retries)
+		}
+		if dst.Name != "app" {
+			t.Errorf("%s: expected name to be kept, got %q", name, dst.Name)
+		}
+
+		// dst must not alias a source's pointer
+		*override.Enabled = true
+		if *dst.Enabled {
+			t.Errorf("%s: expected merged pointer to be a copy of the source's", name)
+		}
+	}
+}
+
+func TestMerge_PtrInsideStructPointer(t *testing.T) {
+	dst := &OptionalConfig{}
+	dst.Server = &struct {
+		Host string
+		Port *int
+	}{Host: "localhost", Port: Ptr(8080)}
+
+	src := &OptionalConfig{}
+	src.Server = &struct {
+		Host string
+		Port *int
+	}{Port: Ptr(0)}
+
+	if err := DefaultMerge(dst, src); err != nil {
+		t.Fatalf("DefaultMerge failed: %v", err)
+	}
+	if dst.Server.Host != "localhost" {
+		t.Errorf("Expected struct pointer to merge field by field, got host %q", dst.Server.Host)
+	}
+	if Deref(dst.Server.Port, -1) != 0 {
+		t.Errorf("Expected explicit port 0, got %v", dst.Server.Port)
+	}
+}
+
+func TestRequiredPtr(t *testing.T) {
+	validator := RequiredPtr("enabled", func(cfg *OptionalConfig) *bool { return cfg.Enabled })
+
+	if err := validator.Validate(&OptionalConfig{}); err == nil || err.Error() != "enabled is required" {
+		t.Errorf("Expected 'enabled is required', got %v", err)
+	}
+	if err := validator.Validate(&OptionalConfig{Enabled: Ptr(false)}); err != nil {
+		t.Errorf("Expected explicit false to pass, got %v", err)
+	}
+}
@@ -0,0 +1,62 @@
+package core
+
+import "fmt"
+
+// Sanitizer normalizes config after merging and before validation.
+// Use it to trim whitespace, lowercase hostnames or fill derived fields.
+type Sanitizer[T any] interface {
+	// Sanitize modifies cfg in place.
+	// Returns error if the config cannot be normalized; Load then fails.
+	Sanitize(*T) error
+}
+
+// SanitizerFunc is a function adapter for the Sanitizer interface.
+// Allows using a function as a Sanitizer.
+//
+// Example:
+//
+//	addr := core.SanitizerFunc[AppConfig](func(cfg *AppConfig) error {
+//	    cfg.Server.Host = strings.ToLower(strings.TrimSpace(cfg.Server.Host))
+//	    cfg.Server.Addr = net.JoinHostPort(cfg.Server.Host, strconv.Itoa(cfg.Server.Port))
+//	    return nil
+//	})
+//
+//	cfg := config.New[AppConfig](loaders...).
+//	    WithSanitizer(addr)
+type SanitizerFunc[T any] func(*T) error
+
+// Sanitize implements the Sanitizer interface.
+func (f SanitizerFunc[T]) Sanitize(cfg *T) error {
+	return f(cfg)
+}
+
+// CompositeSanitizer combines multiple sanitizers.
+// Sanitizers run in order, so later ones see the changes made by earlier ones.
+//
+// Example:
+//
+//	sanitizer := core.NewCompositeSanitizer(
+//	    trimSanitizer,
+//	    addrSanitizer, // sees trimmed values
+//	)
+type CompositeSanitizer[T any] struct {
+	sanitizers []Sanitizer[T]
+}
+
+// NewCompositeSanitizer creates a new CompositeSanitizer.
+func NewCompositeSanitizer[T any](sanitizers ...Sanitizer[T]) *CompositeSanitizer[T] {
+	return &CompositeSanitizer[T]{
+		sanitizers: sanitizers,
+	}
+}
+
+// Sanitize runs all sanitizers in order.
+// Stops at the first error, prefixed with the sanitizer's index: "sanitizer[1]: ...".
+func (c *CompositeSanitizer[T]) Sanitize(cfg *T) error {
+	for i, sanitizer := range c.sanitizers {
+		if err := sanitizer.Sanitize(cfg); err != nil {
+			return fmt.Errorf("sanitizer[%d]: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestConfig_WithSanitizer_RunsBeforeValidation(t *testing.T) {
+	loader := &ValidatedMockLoader{data: ValidatedConfig{}}
+	loader.data.Server.Host = "  API.Example.COM "
+	loader.data.Server.Port = 8080
+
+	normalize := SanitizerFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		cfg.Server.Host = strings.ToLower(strings.TrimSpace(cfg.Server.Host))
+		return nil
+	})
+	// Only passes if normalize ran first
+	strict := ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		if cfg.Server.Host != "api.example.com" {
+			return fmt.Errorf("host %q is not normalized", cfg.Server.Host)
+		}
+		return nil
+	})
+
+	cfg := New[ValidatedConfig](loader).WithValidator(strict).WithSanitizer(normalize)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load should succeed once sanitized: %v", err)
+	}
+	if got := cfg.Get().Server.Host; got != "api.example.com" {
+		t.Errorf("Expected sanitized host, got %q", got)
+	}
+
+	// Verify: The same validator fails without the sanitizer
+	if err := New[ValidatedConfig](loader).WithValidator(strict).Load(); err == nil {
+		t.Error("Expected validation to fail without the sanitizer")
+	}
+}
+
+func TestConfig_WithSanitizer_RunsInOrder(t *testing.T) {
+	loader := &ValidatedMockLoader{data: ValidatedConfig{}}
+	loader.data.Database.Host = " db "
+	loader.data.Database.Port = 5432
+
+	var dsn string
+	trim := SanitizerFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		cfg.Database.Host = strings.TrimSpace(cfg.Database.Host)
+		return nil
+	})
+	derive := SanitizerFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		dsn = fmt.Sprintf("%s:%d", cfg.Database.Host, cfg.Database.Port)
+		return nil
+	})
+
+	cfg := New[ValidatedConfig](loader).WithSanitizer(trim).WithSanitizer(derive)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if dsn != "db:5432" {
+		t.Errorf("Expected derived value from trimmed host, got %q", dsn)
+	}
+}
+
+func TestConfig_WithSanitizer_ErrorAbortsLoad(t *testing.T) {
+	loader := &ValidatedMockLoader{data: ValidatedConfig{}}
+	validated := false
+
+	cfg := New[ValidatedConfig](loader).
+		WithSanitizer(
+			SanitizerFunc[ValidatedConfig](func(cfg *ValidatedConfig) error { return nil }),
+			SanitizerFunc[ValidatedConfig](func(cfg *ValidatedConfig) error { return fmt.Errorf("port missing") }),
+		).
+		WithValidator(ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+			validated = true
+			return nil
+		}))
+
+	err := cfg.Load()
+	if err == nil || !strings.Contains(err.Error(), "sanitizer[1] failed: port missing") {
+		t.Errorf("Expected sanitizer index in error, got %v", err)
+	}
+	if validated {
+		t.Error("Expected validation to be skipped after a sanitizer error")
+	}
+	if cfg.Hash() != "" {
+		t.Error("Expected failed Load not to store the config")
+	}
+}
+
+func TestCompositeSanitizer(t *testing.T) {
+	var order []int
+	step := func(i int, err error) Sanitizer[ValidatedConfig] {
+		return SanitizerFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+			order = append(order, i)
+			return err
+		})
+	}
+
+	composite := NewCompositeSanitizer(step(0, nil), step(1, fmt.Errorf("boom")), step(2, nil))
+	err := composite.Sanitize(&ValidatedConfig{})
+
+	if err == nil || err.Error() != "sanitizer[1]: boom" {
+		t.Errorf("Expected indexed error, got %v", err)
+	}
+	if fmt.Sprint(order) != "[0 1]" {
+		t.Errorf("Expected to stop at the failing sanitizer, ran %v", order)
+	}
+}
@@ -0,0 +1,180 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// durationType is formatted with Duration.String, which the env loader parses back.
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// ToEnv returns cfg as KEY=VALUE environment strings following the env loader convention,
+// the inverse of loader.EnvLoader: key "server.port" with prefix "app" becomes APP_SERVER_PORT.
+// Append the result to os.Environ() to hand the config to a child process.
+//
+// Values are formatted so the env loader decodes them back:
+//   - Strings, bools and numbers as is; time.Duration with its String form ("1m30s")
+//   - Slices of scalars joined with "," (elements containing "," do not round-trip)
+//   - Maps as a JSON object keyed like the loaders (mapstructure tag or lowercased field name)
+//
+// Zero values and nil pointers are left out, so the child falls back to its own defaults;
+// a non-nil pointer to a zero value (see Ptr) is written. Slices of structs, maps or slices
+// cannot be expressed as env strings and are skipped.
+//
+// Example:
+//
+//	env := core.ToEnv(cfg.GetPtr(), "APP") // ["APP_SERVER_HOST=localhost", "APP_SERVER_PORT=8080"]
+//	cmd := exec.Command("worker")
+//	cmd.Env = append(os.Environ(), env...)
+func ToEnv[T any](cfg *T, prefix string) []string {
+	if cfg == nil {
+		return nil
+	}
+
+	var env []string
+	walkEnv(reflect.ValueOf(cfg).Elem(), "", false, func(key, value string) {
+		env = append(env, envName(prefix, key)+"="+value)
+	})
+	return env
+}
+
+// walkEnv calls fn with the dotted key and formatted value of every leaf of v.
+// explicit is set below a non-nil pointer, whose zero values are still written.
+func walkEnv(v reflect.Value, key string, explicit bool, fn func(key, value string)) {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return
+		}
+		walkEnv(v.Elem(), key, true, fn)
+		return
+	}
+
+	if v.Kind() == reflect.Struct {
+		for i := 0; i < v.NumField(); i++ {
+			name, ok := fieldKey(v.Type().Field(i))
+			if !ok {
+				continue
+			}
+			if key != "" {
+				name = key + "." + name
+			}
+			walkEnv(v.Field(i), name, false, fn)
+		}
+		return
+	}
+
+	if v.IsZero() && !explicit {
+		return
+	}
+	if value, ok := envValue(v); ok {
+		fn(key, value)
+	}
+}
+
+// envValue formats a leaf value; false if it has no env representation.
+func envValue(v reflect.Value) (string, bool) {
+	switch v.Kind() {
+	case reflect.Map:
+		data, err := json.Marshal(plainValue(v))
+		if err != nil {
+			return "", false
+		}
+		return string(data), true
+
+	case reflect.Slice, reflect.Array:
+		items := make([]string, v.Len())
+		for i := range items {
+			item, ok := scalarValue(v.Index(i))
+			if !ok {
+				return "", false
+			}
+			items[i] = item
+		}
+		return strings.Join(items, ","), true
+
+	default:
+		return scalarValue(v)
+	}
+}
+
+// scalarValue formats a string, bool, number or time.Duration.
+func scalarValue(v reflect.Value) (string, bool) {
+	if v.Type() == durationType {
+		return time.Duration(v.Int()).String(), true
+	}
+	switch v.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(v.Interface()), true
+	case reflect.Interface:
+		if v.IsNil() {
+			return "", false
+		}
+		return scalarValue(v.Elem())
+	default:
+		return "", false
+	}
+}
+
+// plainValue converts v to maps, slices and scalars for JSON encoding,
+// keying struct fields like the loaders instead of by json tags.
+func plainValue(v reflect.Value) any {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return plainValue(v.Elem())
+
+	case reflect.Struct:
+		out := make(map[string]any)
+		for i := 0; i < v.NumField(); i++ {
+			if name, ok := fieldKey(v.Type().Field(i)); ok {
+				out[name] = plainValue(v.Field(i))
+			}
+		}
+		return out
+
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		out := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out[fmt.Sprint(iter.Key().Interface())] = plainValue(iter.Value())
+		}
+		return out
+
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		out := make([]any, v.Len())
+		for i := range out {
+			out[i] = plainValue(v.Index(i))
+		}
+		return out
+
+	default:
+		if v.Type() == durationType {
+			return time.Duration(v.Int()).String()
+		}
+		return v.Interface()
+	}
+}
+
+// envName converts a dotted key to the env loader's variable name: "server.max-conns" with
+// prefix "app" becomes APP_SERVER_MAX_CONNS.
+func envName(prefix, key string) string {
+	name := strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(key))
+	if prefix == "" {
+		return name
+	}
+	return strings.ToUpper(prefix) + "_" + name
+}
@@ -0,0 +1,80 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type EnvExportConfig struct {
+	Server struct {
+		Host     string        `mapstructure:"host"`
+		Port     int           `mapstructure:"port"`
+		MaxConns int           `mapstructure:"max-conns"`
+		Timeout  time.Duration `mapstructure:"timeout"`
+	} `mapstructure:"server"`
+	Debug   *bool             `mapstructure:"debug"`
+	Tags    []string          `mapstructure:"tags"`
+	Labels  map[string]string `mapstructure:"labels"`
+	Ignored string            `mapstructure:"-"`
+	Region  string
+	secret  string
+}
+
+func TestToEnv(t *testing.T) {
+	var cfg EnvExportConfig
+	cfg.Server.Host = "localhost"
+	cfg.Server.Port = 8080
+	cfg.Server.MaxConns = 0 // zero values are left out
+	cfg.Server.Timeout = 1500 * time.Millisecond
+	cfg.Debug = Ptr(false) // explicit zero is written
+	cfg.Tags = []string{"a", "b"}
+	cfg.Labels = map[string]string{"team": "payments"}
+	cfg.Ignored = "x"
+	cfg.Region = "eu"
+	cfg.secret = "s"
+
+	expected := []string{
+		"APP_SERVER_HOST=localhost",
+		"APP_SERVER_PORT=8080",
+		"APP_SERVER_TIMEOUT=1.5s",
+		"APP_DEBUG=false",
+		"APP_TAGS=a,b",
+		`APP_LABELS={"team":"payments"}`,
+		"APP_REGION=eu",
+	}
+	if got := ToEnv(&cfg, "app"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestToEnv_NoPrefix(t *testing.T) {
+	var cfg EnvExportConfig
+	cfg.Server.MaxConns = 5
+
+	expected := []string{"SERVER_MAX_CONNS=5"}
+	if got := ToEnv(&cfg, ""); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestToEnv_MapOfStructsUsesConfigKeys(t *testing.T) {
+	type DB struct {
+		DSN      string `mapstructure:"dsn"`
+		MaxConns int    `mapstructure:"max_conns"`
+	}
+	cfg := struct {
+		Databases map[string]DB `mapstructure:"databases"`
+	}{Databases: map[string]DB{"primary": {DSN: "postgres://x", MaxConns: 3}}}
+
+	expected := []string{`APP_DATABASES={"primary":{"dsn":"postgres://x","max_conns":3}}`}
+	if got := ToEnv(&cfg, "APP"); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestToEnv_Nil(t *testing.T) {
+	if got := ToEnv[EnvExportConfig](nil, "APP"); got != nil {
+		t.Errorf("Expected nil, got %v", got)
+	}
+}
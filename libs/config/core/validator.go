@@ -1,5 +1,11 @@
 package core
 
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
 // Validator defines an interface for validating config after loading.
 type Validator[T any] interface {
 	// Validate checks if the config is valid.
@@ -28,8 +34,116 @@ func (f ValidatorFunc[T]) Validate(cfg *T) error {
 	return f(cfg)
 }
 
+// NamedValidator is an optional interface for validators that carry a name.
+// CompositeValidator reports the name in ValidationError so messages say
+// which validator failed, not just its index.
+type NamedValidator[T any] interface {
+	Validator[T]
+
+	// Name returns a short, human-readable identifier (e.g. "port", "database").
+	Name() string
+}
+
+// namedValidator attaches a name to an existing Validator.
+type namedValidator[T any] struct {
+	Validator[T]
+	name string
+}
+
+func (n *namedValidator[T]) Name() string {
+	return n.name
+}
+
+// Named wraps validator with a name, turning it into a NamedValidator.
+//
+// Example:
+//
+//	validator := core.NewCompositeValidator(
+//	    core.Named[AppConfig]("port", portValidator),
+//	    core.Named[AppConfig]("database", dbValidator),
+//	)
+//	// error: "database: database host empty"
+func Named[T any](name string, validator Validator[T]) NamedValidator[T] {
+	return &namedValidator[T]{Validator: validator, name: name}
+}
+
+// Severity tells whether a validation issue blocks Load.
+type Severity int
+
+const (
+	// SeverityError fails Load (default).
+	SeverityError Severity = iota
+	// SeverityWarning is recorded in Config.Warnings but does not fail Load.
+	SeverityWarning
+)
+
+// String returns "error" or "warning".
+func (s Severity) String() string {
+	if s == SeverityWarning {
+		return "warning"
+	}
+	return "error"
+}
+
+// WarningValidator downgrades every issue reported by validator to a warning.
+// Config.Load records warnings in Config.Warnings instead of failing;
+// use it for deprecated settings or values that work but are not recommended.
+// The returned error is a *ValidationError with SeverityWarning, named after validator
+// when it implements NamedValidator.
+//
+// Example:
+//
+//	validator := core.NewCompositeValidator(
+//	    portValidator, // still fails Load
+//	    core.WarningValidator[AppConfig](core.Named[AppConfig]("tls", tlsRecommended)),
+//	)
+func WarningValidator[T any](validator Validator[T]) Validator[T] {
+	return ValidatorFunc[T](func(cfg *T) error {
+		err := validator.Validate(cfg)
+		if err == nil {
+			return nil
+		}
+		name := ""
+		if named, ok := validator.(NamedValidator[T]); ok {
+			name = named.Name()
+		}
+		return &ValidationError{Name: name, Severity: SeverityWarning, Cause: err}
+	})
+}
+
+// IsWarning reports whether err is a validation warning (see WarningValidator).
+// The outermost ValidationError in err's chain decides.
+func IsWarning(err error) bool {
+	var validationErr *ValidationError
+	return errors.As(err, &validationErr) && validationErr.Severity == SeverityWarning
+}
+
+// splitWarnings separates warnings from blocking errors in a validation result.
+// Errors joined with errors.Join are split individually.
+func splitWarnings(err error) (warnings []error, fatal error) {
+	if err == nil {
+		return nil, nil
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		var fatals []error
+		for _, inner := range joined.Unwrap() {
+			w, f := splitWarnings(inner)
+			warnings = append(warnings, w...)
+			if f != nil {
+				fatals = append(fatals, f)
+			}
+		}
+		return warnings, errors.Join(fatals...)
+	}
+	if IsWarning(err) {
+		return []error{err}, nil
+	}
+	return nil, err
+}
+
 // CompositeValidator combines multiple validators.
-// All validators must pass for validation to succeed.
+// All validators must pass for validation to succeed; warnings (see WarningValidator)
+// are collected without stopping the remaining validators.
 //
 // Example:
 //
@@ -51,28 +165,100 @@ func NewCompositeValidator[T any](validators ...Validator[T]) *CompositeValidato
 
 // Validate runs all validators in order.
 // Returns the first error encountered, or nil if all pass.
+// The error is wrapped in a ValidationError when there is more than one validator
+// or when the failing validator is a NamedValidator.
+// Warnings reported before the first error are joined with it (errors.Join);
+// if there is no error, the joined warnings are returned.
 func (c *CompositeValidator[T]) Validate(cfg *T) error {
+	var warnings []error
 	for i, validator := range c.validators {
-		if err := validator.Validate(cfg); err != nil {
-			if len(c.validators) > 1 {
+		err := validator.Validate(cfg)
+		if err == nil {
+			continue
+		}
+
+		name := ""
+		if named, ok := validator.(NamedValidator[T]); ok {
+			name = named.Name()
+		}
+		wrap := func(err error, severity Severity) error {
+			if len(c.validators) > 1 || name != "" {
 				return &ValidationError{
 					ValidatorIndex: i,
+					Name:           name,
+					Severity:       severity,
 					Cause:          err,
 				}
 			}
 			return err
 		}
+
+		w, fatal := splitWarnings(err)
+		for _, warning := range w {
+			warnings = append(warnings, wrap(warning, SeverityWarning))
+		}
+		if fatal != nil {
+			if len(warnings) == 0 {
+				return wrap(fatal, SeverityError)
+			}
+			return errors.Join(append(warnings, wrap(fatal, SeverityError))...)
+		}
 	}
-	return nil
+	return errors.Join(warnings...)
+}
+
+// ForEachMapEntry validates every entry of a map section with keys chosen at runtime,
+// such as `databases: map[string]DBConfig` keyed by logical name.
+// Entries are validated in key order; each error is prefixed with its map key
+// and all errors are joined, so one Load reports every broken entry.
+//
+// Example:
+//
+//	validator := core.ForEachMapEntry(
+//	    func(cfg *AppConfig) map[string]DBConfig { return cfg.Databases },
+//	    core.ValidatorFunc[DBConfig](func(db *DBConfig) error {
+//	        if db.DSN == "" {
+//	            return fmt.Errorf("dsn is required")
+//	        }
+//	        return nil
+//	    }),
+//	)
+//	// error: "analytics: dsn is required"
+func ForEachMapEntry[T any, V any](selector func(*T) map[string]V, validator Validator[V]) Validator[T] {
+	return ValidatorFunc[T](func(cfg *T) error {
+		entries := selector(cfg)
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var errs []error
+		for _, key := range keys {
+			entry := entries[key]
+			if err := validator.Validate(&entry); err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", key, err))
+			}
+		}
+		return errors.Join(errs...)
+	})
 }
 
 // ValidationError wraps validation errors with context.
 type ValidationError struct {
 	ValidatorIndex int
-	Cause          error
+	// Name is the failing validator's name; empty unless it implements NamedValidator.
+	Name string
+	// Severity is SeverityWarning for issues reported through WarningValidator.
+	Severity Severity
+	Cause    error
 }
 
+// Error returns the cause, prefixed with the validator name when known.
 func (e *ValidationError) Error() string {
+	if e.Name != "" {
+		return e.Name + ": " + e.Cause.Error()
+	}
 	return e.Cause.Error()
 }
 
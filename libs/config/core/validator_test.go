@@ -1,7 +1,9 @@
 package core
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -224,6 +226,68 @@ func TestCompositeValidator_SecondFail(t *testing.T) {
 	}
 }
 
+func TestCompositeValidator_NamedFail(t *testing.T) {
+	loader := &ValidatedMockLoader{
+		data: ValidatedConfig{},
+	}
+	loader.data.Server.Port = 8080 // Will pass first validator
+	// Database.Host is empty - will fail second validator
+
+	portValidator := ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		if cfg.Server.Port < 1024 {
+			return fmt.Errorf("server port too low")
+		}
+		return nil
+	})
+
+	dbValidator := ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		if cfg.Database.Host == "" {
+			return fmt.Errorf("database host empty")
+		}
+		return nil
+	})
+
+	composite := NewCompositeValidator[ValidatedConfig](
+		Named[ValidatedConfig]("port", portValidator),
+		Named[ValidatedConfig]("database", dbValidator),
+	)
+
+	err := New[ValidatedConfig](loader).WithValidator(composite).Load()
+	if err == nil {
+		t.Fatal("Load should fail when named validator fails")
+	}
+
+	var validationErr *ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected ValidationError, got %T", err)
+	}
+	if validationErr.Name != "database" {
+		t.Errorf("Expected validator name 'database', got %q", validationErr.Name)
+	}
+	if validationErr.ValidatorIndex != 1 {
+		t.Errorf("Expected validator index 1, got %d", validationErr.ValidatorIndex)
+	}
+	if !strings.Contains(err.Error(), "database: database host empty") {
+		t.Errorf("Expected error to name the failing validator, got: %v", err)
+	}
+}
+
+func TestCompositeValidator_UnnamedError(t *testing.T) {
+	cause := fmt.Errorf("boom")
+	composite := NewCompositeValidator[ValidatedConfig](
+		ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error { return nil }),
+		ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error { return cause }),
+	)
+
+	err := composite.Validate(&ValidatedConfig{})
+	if err == nil || err.Error() != "boom" {
+		t.Errorf("Expected unnamed error message unchanged, got: %v", err)
+	}
+	if !errors.Is(err, cause) {
+		t.Error("Expected ValidationError to unwrap to cause")
+	}
+}
+
 func TestConfig_NoValidator(t *testing.T) {
 	loader := &ValidatedMockLoader{
 		data: ValidatedConfig{},
@@ -253,3 +317,159 @@ func TestConfig_WithValidator_MethodChaining(t *testing.T) {
 		t.Fatalf("Load should succeed: %v", err)
 	}
 }
+
+func TestForEachMapEntry(t *testing.T) {
+	type DB struct{ DSN string }
+	type Config struct{ Databases map[string]DB }
+
+	requireDSN := ValidatorFunc[DB](func(db *DB) error {
+		if db.DSN == "" {
+			return fmt.Errorf("dsn is required")
+		}
+		return nil
+	})
+	validator := ForEachMapEntry(func(cfg *Config) map[string]DB { return cfg.Databases }, requireDSN)
+
+	valid := &Config{Databases: map[string]DB{"primary": {DSN: "postgres://primary"}}}
+	if err := validator.Validate(valid); err != nil {
+		t.Errorf("Expected valid config to pass, got %v", err)
+	}
+
+	invalid := &Config{Databases: map[string]DB{
+		"primary":   {DSN: "postgres://primary"},
+		"reporting": {},
+		"analytics": {},
+	}}
+	err := validator.Validate(invalid)
+	if err == nil {
+		t.Fatal("Expected per-entry errors")
+	}
+
+	// Verify: Every broken entry is reported, prefixed with its key, in key order
+	expected := "analytics: dsn is required\nreporting: dsn is required"
+	if err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+
+	if err := validator.Validate(&Config{}); err != nil {
+		t.Errorf("Expected nil map to pass, got %v", err)
+	}
+}
+
+func TestConfig_WarningValidator_LoadsAndRecordsWarning(t *testing.T) {
+	loader := &ValidatedMockLoader{data: ValidatedConfig{}}
+	loader.data.Server.Host = "localhost"
+	loader.data.Server.Port = 8080
+
+	plainHTTP := ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		if cfg.Server.Port == 8080 {
+			return fmt.Errorf("port 8080 serves plain HTTP")
+		}
+		return nil
+	})
+
+	cfg := New[ValidatedConfig](loader).WithValidator(NewCompositeValidator[ValidatedConfig](
+		WarningValidator[ValidatedConfig](Named[ValidatedConfig]("tls", plainHTTP)),
+		&ServerValidator{},
+	))
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load should succeed with only warnings: %v", err)
+	}
+
+	warnings := cfg.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("Expected 1 warning, got %v", warnings)
+	}
+	if !IsWarning(warnings[0]) {
+		t.Errorf("Expected warning severity, got %v", warnings[0])
+	}
+	if warnings[0].Error() != "tls: port 8080 serves plain HTTP" {
+		t.Errorf("Expected named warning, got %q", warnings[0].Error())
+	}
+
+	// Verify: Warnings are replaced on the next successful Load
+	loader.data.Server.Port = 8443
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if len(cfg.Warnings()) != 0 {
+		t.Errorf("Expected no warnings after reload, got %v", cfg.Warnings())
+	}
+}
+
+func TestConfig_WarningValidator_ErrorsStillFail(t *testing.T) {
+	loader := &ValidatedMockLoader{data: ValidatedConfig{}}
+	loader.data.Server.Port = 80
+
+	warning := WarningValidator[ValidatedConfig](ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		return fmt.Errorf("deprecated setting")
+	}))
+
+	err := New[ValidatedConfig](loader).WithValidator(NewCompositeValidator[ValidatedConfig](warning, &ServerValidator{})).Load()
+	if err == nil {
+		t.Fatal("Load should fail when an error-level validator fails")
+	}
+	if IsWarning(err) {
+		t.Errorf("Expected blocking error, got warning %v", err)
+	}
+	if !strings.Contains(err.Error(), "server port must be between") || strings.Contains(err.Error(), "deprecated setting") {
+		t.Errorf("Expected only the blocking error in message, got: %v", err)
+	}
+}
+
+func TestForEachMapEntry_WarningsSplitFromErrors(t *testing.T) {
+	type ServicesConfig struct {
+		Services map[string]ValidatedConfig
+	}
+
+	entry := ValidatorFunc[ValidatedConfig](func(cfg *ValidatedConfig) error {
+		if cfg.Server.Host == "" {
+			return fmt.Errorf("host is required")
+		}
+		return nil
+	})
+	validator := ForEachMapEntry(
+		func(cfg *ServicesConfig) map[string]ValidatedConfig { return cfg.Services },
+		WarningValidator[ValidatedConfig](entry),
+	)
+
+	cfg := &ServicesConfig{Services: map[string]ValidatedConfig{"a": {}, "b": {}}}
+	warnings, fatal := splitWarnings(validator.Validate(cfg))
+	if fatal != nil {
+		t.Errorf("Expected no blocking error, got %v", fatal)
+	}
+	if len(warnings) != 2 || warnings[0].Error() != "a: host is required" {
+		t.Errorf("Expected one warning per entry, got %v", warnings)
+	}
+}
+
+func TestConfig_Validate_InMemory(t *testing.T) {
+	cfg := New[ValidatedConfig]().WithValidator(&ServerValidator{})
+
+	// Verify: zero data is invalid without ever calling Load
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "server port") {
+		t.Errorf("Expected port validation error, got: %v", err)
+	}
+
+	cfg.GetPtr().Server.Host = "localhost"
+	cfg.GetPtr().Server.Port = 8080
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Expected valid config to pass, got: %v", err)
+	}
+
+	cfg.GetPtr().Server.Host = ""
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "host cannot be empty") {
+		t.Errorf("Expected host validation error, got: %v", err)
+	}
+}
+
+func TestConfig_Validate_WarningsAndNoValidator(t *testing.T) {
+	if err := New[ValidatedConfig]().Validate(); err != nil {
+		t.Errorf("Expected nil without a validator, got: %v", err)
+	}
+
+	warning := WarningValidator[ValidatedConfig](&ServerValidator{})
+	if err := New[ValidatedConfig]().WithValidator(warning).Validate(); err != nil {
+		t.Errorf("Expected warnings not to fail Validate, got: %v", err)
+	}
+}
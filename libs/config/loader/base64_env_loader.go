@@ -0,0 +1,76 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+
+	"github.com/spf13/viper"
+)
+
+// Base64EnvLoader loads a whole config from a single base64-encoded environment variable.
+// Useful when containers inject the entire config as one blob.
+type Base64EnvLoader struct {
+	envName  string
+	fileType string
+}
+
+// NewBase64EnvLoader creates a new Base64EnvLoader.
+//
+// Parameters:
+//   - envName: name of the environment variable holding the blob
+//   - format: format of the decoded content (json, yaml, toml, properties, hcl)
+//
+// Example:
+//
+//	// APP_CONFIG=$(base64 -w0 config.json)
+//	loader := loader.NewBase64EnvLoader("APP_CONFIG", "json")
+func NewBase64EnvLoader(envName, format string) *Base64EnvLoader {
+	return &Base64EnvLoader{
+		envName:  envName,
+		fileType: format,
+	}
+}
+
+// Load reads the environment variable, base64-decodes it and unmarshals it into dst.
+//
+// Returns error if:
+//   - The environment variable is not set
+//   - The value is not valid base64 (standard encoding)
+//   - The decoded content cannot be parsed as the configured format
+func (b *Base64EnvLoader) Load(dst interface{}) error {
+	encoded, ok := os.LookupEnv(b.envName)
+	if !ok {
+		return fmt.Errorf("environment variable %s is not set", b.envName)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return fmt.Errorf("failed to decode base64 from %s: %w", b.envName, err)
+	}
+
+	v := viper.New()
+	v.SetConfigType(b.fileType)
+
+	if err := v.ReadConfig(bytes.NewReader(decoded)); err != nil {
+		return fmt.Errorf("failed to read config from %s: %w", b.envName, err)
+	}
+
+	if err := v.Unmarshal(dst); err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	return nil
+}
+
+// String describes the loader in error messages.
+// Example: "base64env(APP_CONFIG)"
+func (b *Base64EnvLoader) String() string {
+	return fmt.Sprintf("base64env(%s)", b.envName)
+}
+
+// Name implements core.NamedLoader.
+func (b *Base64EnvLoader) Name() string {
+	return "Base64EnvLoader"
+}
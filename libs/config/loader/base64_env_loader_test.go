@@ -0,0 +1,88 @@
+package loader
+
+import (
+	"encoding/base64"
+	"os"
+	"testing"
+)
+
+func TestBase64EnvLoader_LoadJSON(t *testing.T) {
+	jsonContent := `{"server": {"host": "localhost", "port": 8080}, "database": {"host": "dbhost", "port": 5432}}`
+	os.Setenv("APP_CONFIG_B64", base64.StdEncoding.EncodeToString([]byte(jsonContent)))
+	defer os.Unsetenv("APP_CONFIG_B64")
+
+	loader := NewBase64EnvLoader("APP_CONFIG_B64", "json")
+	cfg := &TestConfig{}
+
+	if err := loader.Load(cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Server.Host != "localhost" {
+		t.Errorf("Expected server.host=localhost, got %s", cfg.Server.Host)
+	}
+
+	if cfg.Server.Port != 8080 {
+		t.Errorf("Expected server.port=8080, got %d", cfg.Server.Port)
+	}
+
+	if cfg.Database.Host != "dbhost" {
+		t.Errorf("Expected database.host=dbhost, got %s", cfg.Database.Host)
+	}
+
+	if cfg.Database.Port != 5432 {
+		t.Errorf("Expected database.port=5432, got %d", cfg.Database.Port)
+	}
+}
+
+func TestBase64EnvLoader_LoadYAML(t *testing.T) {
+	yamlContent := "server:\n  host: yamlhost\n  port: 9090\n"
+	os.Setenv("APP_CONFIG_B64", base64.StdEncoding.EncodeToString([]byte(yamlContent)))
+	defer os.Unsetenv("APP_CONFIG_B64")
+
+	loader := NewBase64EnvLoader("APP_CONFIG_B64", "yaml")
+	cfg := &TestConfig{}
+
+	if err := loader.Load(cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Server.Host != "yamlhost" || cfg.Server.Port != 9090 {
+		t.Errorf("Expected yamlhost:9090, got %s:%d", cfg.Server.Host, cfg.Server.Port)
+	}
+}
+
+func TestBase64EnvLoader_MissingVar(t *testing.T) {
+	os.Unsetenv("APP_CONFIG_MISSING")
+
+	loader := NewBase64EnvLoader("APP_CONFIG_MISSING", "json")
+	cfg := &TestConfig{}
+
+	if err := loader.Load(cfg); err == nil {
+		t.Error("Expected error for missing environment variable")
+	}
+}
+
+func TestBase64EnvLoader_InvalidBase64(t *testing.T) {
+	os.Setenv("APP_CONFIG_B64", "not base64!!")
+	defer os.Unsetenv("APP_CONFIG_B64")
+
+	loader := NewBase64EnvLoader("APP_CONFIG_B64", "json")
+	cfg := &TestConfig{}
+
+	if err := loader.Load(cfg); err == nil {
+		t.Error("Expected error for invalid base64")
+	}
+}
+
+func TestBase64EnvLoader_InvalidContent(t *testing.T) {
+	os.Setenv("APP_CONFIG_B64", base64.StdEncoding.EncodeToString([]byte("{invalid json")))
+	defer os.Unsetenv("APP_CONFIG_B64")
+
+	loader := NewBase64EnvLoader("APP_CONFIG_B64", "json")
+	cfg := &TestConfig{}
+
+	if err := loader.Load(cfg); err == nil {
+		t.Error("Expected error for invalid JSON content")
+	}
+}
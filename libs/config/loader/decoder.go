@@ -0,0 +1,19 @@
+package loader
+
+// Decoder decodes raw file contents into dst.
+// Set it on a FileLoader with WithDecoder to bypass Viper (e.g. encoding/json or a custom YAML decoder).
+type Decoder interface {
+	Decode(data []byte, dst interface{}) error
+}
+
+// DecoderFunc adapts a function to the Decoder interface.
+//
+// Example:
+//
+//	loader.NewFileLoader("config.json", "json").WithDecoder(loader.DecoderFunc(json.Unmarshal))
+type DecoderFunc func(data []byte, dst interface{}) error
+
+// Decode implements Decoder.
+func (f DecoderFunc) Decode(data []byte, dst interface{}) error {
+	return f(data, dst)
+}
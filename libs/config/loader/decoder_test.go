@@ -0,0 +1,60 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// strictJSONDecoder is a custom decoder that rejects unknown keys.
+type strictJSONDecoder struct{}
+
+func (strictJSONDecoder) Decode(data []byte, dst interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	return dec.Decode(dst)
+}
+
+func TestFileLoader_WithDecoderMatchesViper(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "test.json")
+	content := `{"server": {"host": "localhost", "port": 8080}, "database": {"host": "dbhost", "port": 5432}}`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	viperCfg := &TestConfig{}
+	if err := NewFileLoader(configPath, "json").Load(viperCfg); err != nil {
+		t.Fatalf("Viper load failed: %v", err)
+	}
+
+	decoderCfg := &TestConfig{}
+	if err := NewFileLoader(configPath, "json").WithDecoder(strictJSONDecoder{}).Load(decoderCfg); err != nil {
+		t.Fatalf("Decoder load failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(viperCfg, decoderCfg) {
+		t.Errorf("Expected decoder result %+v to match viper result %+v", decoderCfg, viperCfg)
+	}
+}
+
+func TestFileLoader_WithDecoderErrors(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "test.json")
+	if err := os.WriteFile(configPath, []byte(`{"serverr": {}}`), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	err := NewFileLoader(configPath, "json").WithDecoder(strictJSONDecoder{}).Load(&TestConfig{})
+	if err == nil || !strings.Contains(err.Error(), `unknown field "serverr"`) {
+		t.Errorf("Expected decoder error, got: %v", err)
+	}
+
+	// Verify: missing file is reported before decoding
+	err = NewFileLoader(filepath.Join(t.TempDir(), "missing.json"), "json").WithDecoder(DecoderFunc(json.Unmarshal)).Load(&TestConfig{})
+	if err == nil || !strings.Contains(err.Error(), "failed to read config file") {
+		t.Errorf("Expected read error, got: %v", err)
+	}
+}
@@ -0,0 +1,232 @@
+package loader
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+// Doc formats accepted by DocOptions.Format.
+const (
+	DocFormatMarkdown = "markdown"
+	DocFormatJSON     = "json"
+)
+
+// DocOptions configures Document.
+type DocOptions struct {
+	// EnvPrefix is the EnvLoader prefix used to derive env var names ("APP" -> APP_SERVER_HOST).
+	EnvPrefix string
+
+	// Format is DocFormatMarkdown (default) or DocFormatJSON.
+	Format string
+}
+
+// DocEntry describes one config key, as listed by Document.
+type DocEntry struct {
+	Key         string `json:"key"`
+	Type        string `json:"type"`
+	Default     string `json:"default,omitempty"`
+	Description string `json:"description,omitempty"`
+	Secret      bool   `json:"secret,omitempty"`
+	Env         string `json:"env,omitempty"`
+	Flag        string `json:"flag,omitempty"`
+}
+
+// Document renders a reference of every config key of T, for platform docs that would
+// otherwise be kept up to date by hand.
+//
+// Each key lists its Go type and what the loaders already read from the struct tags:
+//   - mapstructure: the dotted key, as in ExtractKeysFromType
+//   - default and usage: default value and description, as in RegisterFlags
+//   - secret:"true": marks the key as secret (its default is still shown)
+//   - env: the env var bound by the tag; otherwise the one EnvLoader derives from EnvPrefix
+//   - the flag RegisterFlags defines for the key, when its type is supported
+//
+// Slices and maps get one row for the whole value. When their elements are structs,
+// representative rows follow for the element fields, keyed "servers[].host" or "routes.*.target";
+// those fields are only set through the file, so they have no env var or flag.
+//
+// Example:
+//
+//	doc, err := loader.Document[AppConfig](loader.DocOptions{EnvPrefix: "APP"})
+//	// | Key | Type | Default | Env | Flag | Secret | Description |
+//	// | `server.port` | `int` | `8080` | `APP_SERVER_PORT` | `--server.port` |  | Server port |
+func Document[T any](opts DocOptions) ([]byte, error) {
+	entries := DocEntries[T](opts.EnvPrefix)
+
+	switch opts.Format {
+	case "", DocFormatMarkdown:
+		return renderMarkdownDoc(entries), nil
+	case DocFormatJSON:
+		data, err := json.MarshalIndent(entries, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return append(data, '\n'), nil
+	default:
+		return nil, fmt.Errorf("unknown doc format %q", opts.Format)
+	}
+}
+
+// DocEntries returns the entries Document renders, in field order.
+func DocEntries[T any](envPrefix string) []DocEntry {
+	env := &EnvLoader{prefix: envPrefix}
+	var entries []DocEntry
+	walkDocFields(reflect.TypeOf((*T)(nil)).Elem(), "", false, func(key string, field reflect.StructField, t reflect.Type, settable bool) {
+		entry := DocEntry{
+			Key:         key,
+			Type:        docTypeName(field.Type),
+			Default:     field.Tag.Get("default"),
+			Description: field.Tag.Get("usage"),
+			Secret:      field.Tag.Get("secret") == "true",
+		}
+		if settable {
+			entry.Env = docEnvName(env, key, field, t)
+			if isFlagType(t) {
+				entry.Flag = "--" + key
+			}
+		}
+		entries = append(entries, entry)
+	})
+	return entries
+}
+
+// walkDocFields calls fn for every leaf of t like registerStructFlags, then for the fields of
+// struct elements of slices and maps. settable is false below a slice or map.
+func walkDocFields(t reflect.Type, prefix string, nested bool, fn func(key string, field reflect.StructField, t reflect.Type, settable bool)) {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if tag == "" {
+			tag = strings.ToLower(field.Name)
+		}
+		if tag == "-" {
+			continue
+		}
+
+		name := tag
+		if prefix != "" {
+			name = prefix + "." + tag
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if fieldType.Kind() == reflect.Struct && !isCustomFlagType(fieldType) {
+			walkDocFields(fieldType, name, nested, fn)
+			continue
+		}
+
+		fn(name, field, fieldType, !nested)
+
+		if elem := collectionElem(fieldType); elem != nil {
+			if fieldType.Kind() == reflect.Map {
+				walkDocFields(elem, name+".*", true, fn)
+			} else {
+				walkDocFields(elem, name+"[]", true, fn)
+			}
+		}
+	}
+}
+
+// collectionElem returns the struct element type of a slice, array or map, or nil.
+func collectionElem(t reflect.Type) reflect.Type {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		elem := t.Elem()
+		if elem.Kind() == reflect.Ptr {
+			elem = elem.Elem()
+		}
+		if elem.Kind() == reflect.Struct && !isCustomFlagType(elem) {
+			return elem
+		}
+	}
+	return nil
+}
+
+// docTypeName returns the Go type of t, with anonymous structs shortened to "struct".
+func docTypeName(t reflect.Type) string {
+	if t.Name() != "" {
+		return t.String()
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + docTypeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + docTypeName(t.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", t.Len(), docTypeName(t.Elem()))
+	case reflect.Map:
+		return "map[" + docTypeName(t.Key()) + "]" + docTypeName(t.Elem())
+	case reflect.Struct:
+		return "struct"
+	default:
+		return t.String()
+	}
+}
+
+// docEnvName returns the env var that sets key: the env tag, or else the conventional name.
+// Slices of structs cannot be set from env (only JSON objects are decoded), so they get none.
+func docEnvName(env *EnvLoader, key string, field reflect.StructField, t reflect.Type) string {
+	if name := field.Tag.Get("env"); name == "-" {
+		return ""
+	} else if name != "" {
+		return name
+	}
+	if t.Kind() != reflect.Map && collectionElem(t) != nil {
+		return ""
+	}
+	return env.conventionalEnvName(key)
+}
+
+// isFlagType reports whether RegisterFlags can define a flag for t.
+func isFlagType(t reflect.Type) bool {
+	return defineFlag(pflag.NewFlagSet("doc", pflag.ContinueOnError), "doc", t, "", "") == nil
+}
+
+// renderMarkdownDoc renders entries as a Markdown table.
+func renderMarkdownDoc(entries []DocEntry) []byte {
+	var b bytes.Buffer
+	b.WriteString("| Key | Type | Default | Env | Flag | Secret | Description |\n")
+	b.WriteString("|-----|------|---------|-----|------|--------|-------------|\n")
+	for _, e := range entries {
+		secret := ""
+		if e.Secret {
+			secret = "yes"
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s | %s |\n",
+			markdownCode(e.Key), markdownCode(e.Type), markdownCode(e.Default),
+			markdownCode(e.Env), markdownCode(e.Flag), secret, markdownCell(e.Description))
+	}
+	return b.Bytes()
+}
+
+// markdownCode formats s as inline code in a table cell; empty stays empty.
+func markdownCode(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "`" + markdownCell(s) + "`"
+}
+
+// markdownCell escapes the characters that would break a table row.
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
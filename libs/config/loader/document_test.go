@@ -0,0 +1,96 @@
+package loader
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite testdata/*.golden files")
+
+type DocumentConfig struct {
+	Server struct {
+		Host    string        `mapstructure:"host" default:"localhost" usage:"Address to listen on"`
+		Port    int           `mapstructure:"port" default:"8080" usage:"Port to listen on"`
+		Timeout time.Duration `mapstructure:"read-timeout" default:"5s" usage:"Request read timeout"`
+	} `mapstructure:"server"`
+	Database struct {
+		URL      string `mapstructure:"url" env:"DATABASE_URL" usage:"Connection string"`
+		Password string `mapstructure:"password" secret:"true" usage:"Database password | rotated monthly"`
+		PoolSize *int   `mapstructure:"pool_size" usage:"Max open connections"`
+	} `mapstructure:"database"`
+	Tags      []string          `mapstructure:"tags" default:"web,api"`
+	Labels    map[string]string `mapstructure:"labels"`
+	Upstreams []struct {
+		Host   string `mapstructure:"host" usage:"Upstream host"`
+		Weight int    `mapstructure:"weight" default:"1"`
+	} `mapstructure:"upstreams"`
+	Routes map[string]struct {
+		Target string `mapstructure:"target"`
+	} `mapstructure:"routes"`
+	Internal string `mapstructure:"-"`
+	Debug    bool
+}
+
+func assertGolden(t *testing.T, name string, got []byte) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Reading golden file: %v (run with -update to create it)", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("Output differs from %s (run with -update to accept):\n%s", path, got)
+	}
+}
+
+func TestDocument_Markdown(t *testing.T) {
+	doc, err := Document[DocumentConfig](DocOptions{EnvPrefix: "app"})
+	if err != nil {
+		t.Fatalf("Document failed: %v", err)
+	}
+	assertGolden(t, "document.md.golden", doc)
+}
+
+func TestDocument_JSON(t *testing.T) {
+	doc, err := Document[DocumentConfig](DocOptions{EnvPrefix: "app", Format: DocFormatJSON})
+	if err != nil {
+		t.Fatalf("Document failed: %v", err)
+	}
+	assertGolden(t, "document.json.golden", doc)
+}
+
+func TestDocument_UnknownFormat(t *testing.T) {
+	if _, err := Document[DocumentConfig](DocOptions{Format: "yaml"}); err == nil || !strings.Contains(err.Error(), "yaml") {
+		t.Errorf("Expected unknown format error, got %v", err)
+	}
+}
+
+func TestDocEntries_MatchLoaders(t *testing.T) {
+	entries := DocEntries[RegisterFlagsConfig]("APP")
+
+	var keys []string
+	for _, e := range entries {
+		keys = append(keys, e.Key)
+	}
+	if want := ExtractKeysFromType(RegisterFlagsConfig{}); strings.Join(keys, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected the keys of ExtractKeysFromType %v, got %v", want, keys)
+	}
+
+	for _, e := range entries {
+		if e.Env != (&EnvLoader{prefix: "APP"}).conventionalEnvName(e.Key) {
+			t.Errorf("Expected %s to use the EnvLoader name, got %q", e.Key, e.Env)
+		}
+		if e.Flag != "--"+e.Key {
+			t.Errorf("Expected %s to have a RegisterFlags flag, got %q", e.Key, e.Flag)
+		}
+	}
+}
@@ -1,26 +1,44 @@
 package loader
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"reflect"
 	"strings"
 
+	"github.com/go-viper/mapstructure/v2"
 	"github.com/spf13/viper"
 )
 
 // EnvLoader loads configuration from environment variables.
 // Example: APP_SERVER_HOST will be converted to server.host
+//
+// A field tagged `env:"NAME"` is also read from that exact variable, regardless of the prefix.
+// When both are set, the tagged variable wins over the conventional one:
+//
+//	type Config struct {
+//	    Database struct {
+//	        URL string `mapstructure:"url" env:"DATABASE_URL"` // DATABASE_URL, then APP_DATABASE_URL
+//	    } `mapstructure:"database"`
+//	}
 type EnvLoader struct {
-	prefix string
-	keys   []string // Optional: specific keys to bind
+	prefix   string
+	keys     []string          // Optional: specific keys to bind
+	envNames map[string]string // Key -> env var from `env` tags (WithAutoKeys, or dst at Load)
+	ignore   []string          // Env var name patterns excluded from warnings
+	warnings []string          // Unknown prefixed env vars found by the last Load
+	strict   bool              // Fail Load on unknown keys and unknown prefixed env vars
 }
 
 // NewEnvLoader creates a new EnvLoader with the given prefix.
 // If prefix is "APP", it will read env vars like APP_*.
 // Pass empty string "" if no prefix is needed.
-func NewEnvLoader(prefix string) *EnvLoader {
-	return &EnvLoader{
+// opts are applied in order (see WithEnvKeys, WithEnvAutoKeys, WithEnvIgnore).
+func NewEnvLoader(prefix string, opts ...EnvOption) *EnvLoader {
+	return applyOptions(&EnvLoader{
 		prefix: prefix,
-	}
+	}, opts)
 }
 
 // WithKeys specifies which keys to bind from environment.
@@ -49,16 +67,43 @@ func (e *EnvLoader) WithKeys(keys ...string) *EnvLoader {
 //	}
 //
 //	loader := loader.NewEnvLoader("APP").WithAutoKeys(AppConfig{})
+//
+// `env` tags on example are bound as well (see EnvLoader).
 func (e *EnvLoader) WithAutoKeys(example interface{}) *EnvLoader {
 	e.keys = ExtractKeysFromType(example)
+	e.envNames = ExtractEnvNamesFromType(example)
+	return e
+}
+
+// WithIgnore excludes env vars matching any of the patterns from Warnings.
+// Patterns use path.Match syntax against the full variable name.
+//
+// Example:
+//
+//	loader := loader.NewEnvLoader("APP").
+//	    WithAutoKeys(AppConfig{}).
+//	    WithIgnore("APP_DEBUG_*") // reserved for other tools
+func (e *EnvLoader) WithIgnore(patterns ...string) *EnvLoader {
+	e.ignore = append(e.ignore, patterns...)
 	return e
 }
 
+// Warnings returns the env vars found by the last Load that carry the prefix
+// but do not map to any known key, each with the closest valid key when one is similar.
+// Only reported when both a prefix and keys (WithKeys/WithAutoKeys) are set.
+//
+// Example: "APP_SEVER_PORT (sever.port) does not match any config key; did you mean server.port?"
+func (e *EnvLoader) Warnings() []string {
+	return e.warnings
+}
+
 // Load reads environment variables and unmarshals them into dst.
 //
 // Conversion rules (handled automatically by Viper):
 //   - Prefix is automatically uppercased: "app" -> "APP_"
 //   - Underscore (_) is converted to dot (.): APP_SERVER_HOST -> server.host
+//   - Map and struct sections accept a JSON object: APP_DATABASES='{"primary":{"dsn":"..."}}'
+//   - A field tagged `env:"NAME"` reads NAME first, then its conventional name
 //
 // Example: with prefix="app", env var APP_SERVER_HOST maps to field server.host
 func (e *EnvLoader) Load(dst interface{}) error {
@@ -77,15 +122,96 @@ func (e *EnvLoader) Load(dst interface{}) error {
 	// Bind specific keys if provided
 	// This is necessary because AutomaticEnv() doesn't populate AllSettings()
 	// but only works when Get() is called
-	if len(e.keys) > 0 {
-		for _, key := range e.keys {
-			v.BindEnv(key)
+	envNames := e.resolveEnvNames(dst)
+	for _, key := range e.keys {
+		v.BindEnv(key)
+	}
+
+	// Tagged variables override the conventional ones (and AutomaticEnv), and are read
+	// even when their key is not among the keys
+	for key, name := range envNames {
+		if value, ok := os.LookupEnv(name); ok && value != "" {
+			v.Set(key, value)
 		}
 	}
 
-	if err := v.Unmarshal(dst); err != nil {
-		return fmt.Errorf("failed to unmarshal config: %w", err)
+	if _, err := unmarshal(v, dst, e.strict, viper.DecodeHook(envDecodeHook)); err != nil {
+		return err
 	}
 
+	e.warnings = e.unknownEnvWarnings(os.Environ(), envNames)
+	if e.strict && len(e.warnings) > 0 {
+		return fmt.Errorf("%w: %s", ErrUnknownKeys, strings.Join(e.warnings, "; "))
+	}
 	return nil
 }
+
+// WithStrict makes Load fail instead of ignoring what matches no field of dst:
+// keys (from WithKeys) without a field, and prefixed env vars that Warnings would report
+// (e.g. APP_SERVR_PORT). The error wraps ErrUnknownKeys and lists them.
+// Returns *EnvLoader to support method chaining.
+//
+// Example:
+//
+//	loader := loader.NewEnvLoader("APP").WithAutoKeys(AppConfig{}).WithStrict()
+func (e *EnvLoader) WithStrict() *EnvLoader {
+	e.strict = true
+	return e
+}
+
+// resolveEnvNames merges the `env` tags of dst over those captured by WithAutoKeys.
+func (e *EnvLoader) resolveEnvNames(dst interface{}) map[string]string {
+	names := ExtractEnvNamesFromType(dst)
+	for key, name := range e.envNames {
+		if _, ok := names[key]; !ok {
+			names[key] = name
+		}
+	}
+	return names
+}
+
+// conventionalEnvName returns the env var derived from key: "server.host" -> "APP_SERVER_HOST".
+func (e *EnvLoader) conventionalEnvName(key string) string {
+	name := strings.ToUpper(envKeyReplacer.Replace(key))
+	if e.prefix == "" {
+		return name
+	}
+	return strings.ToUpper(e.prefix) + "_" + name
+}
+
+// envDecodeHook keeps Viper's default hooks and adds JSON decoding for map and struct sections,
+// whose keys cannot be expressed as individual env vars.
+var envDecodeHook = mapstructure.ComposeDecodeHookFunc(
+	jsonObjectHook,
+	mapstructure.StringToTimeDurationHookFunc(),
+	mapstructure.StringToSliceHookFunc(","),
+)
+
+// jsonObjectHook decodes a JSON object string into a map or struct target.
+func jsonObjectHook(from, to reflect.Type, data interface{}) (interface{}, error) {
+	if from.Kind() != reflect.String || (to.Kind() != reflect.Map && to.Kind() != reflect.Struct) {
+		return data, nil
+	}
+
+	raw := strings.TrimSpace(data.(string))
+	if !strings.HasPrefix(raw, "{") {
+		return data, nil
+	}
+
+	var decoded map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+		return nil, fmt.Errorf("invalid JSON object: %w", err)
+	}
+	return decoded, nil
+}
+
+// String describes the loader in error messages.
+// Example: "env(APP)"
+func (e *EnvLoader) String() string {
+	return fmt.Sprintf("env(%s)", e.prefix)
+}
+
+// Name implements core.NamedLoader.
+func (e *EnvLoader) Name() string {
+	return "EnvLoader"
+}
@@ -2,7 +2,11 @@ package loader
 
 import (
 	"os"
+	"strings"
 	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
+	"github.com/spf13/pflag"
 )
 
 func TestEnvLoader_Load(t *testing.T) {
@@ -196,3 +200,68 @@ func TestEnvLoader_UnderscoreConversion(t *testing.T) {
 		t.Errorf("Expected server.host=localhost, got %s", cfg.Server.Host)
 	}
 }
+
+func TestLoader_String(t *testing.T) {
+	tests := []struct {
+		name   string
+		loader interface{ String() string }
+		want   string
+	}{
+		{"env", NewEnvLoader("APP"), "env(APP)"},
+		{"file", NewFileLoader("config.yaml", "yaml"), "file(config.yaml)"},
+		{"flags", NewFlagLoader(pflag.NewFlagSet("app", pflag.ContinueOnError)), "flags(app)"},
+		{"base64env", NewBase64EnvLoader("APP_CONFIG", "json"), "base64env(APP_CONFIG)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.loader.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoader_Name(t *testing.T) {
+	tests := []struct {
+		loader core.NamedLoader
+		want   string
+	}{
+		{NewEnvLoader("APP"), "EnvLoader"},
+		{NewFileLoader("config.yaml", "yaml"), "FileLoader"},
+		{NewFlagLoader(pflag.NewFlagSet("app", pflag.ContinueOnError)), "FlagLoader"},
+		{NewBase64EnvLoader("APP_CONFIG", "json"), "Base64EnvLoader"},
+		{NewSecretLoader(NewEnvSecretProvider("APP")), "SecretLoader"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.loader.Name(); got != tt.want {
+			t.Errorf("Name() = %s, want %s", got, tt.want)
+		}
+	}
+}
+
+// typedEnvLoader adapts EnvLoader to core.Loader[*TestConfig], keeping its Name
+type typedEnvLoader struct {
+	*EnvLoader
+}
+
+func (l typedEnvLoader) Load(dst *TestConfig) error {
+	return l.EnvLoader.Load(dst)
+}
+
+func TestEnvLoader_ErrorIncludesName(t *testing.T) {
+	os.Setenv("APP_SERVER_PORT", "not-a-number")
+	defer os.Unsetenv("APP_SERVER_PORT")
+
+	envLoader := typedEnvLoader{NewEnvLoader("APP").WithKeys("server.port")}
+	cfg := core.New[TestConfig](envLoader)
+
+	err := cfg.Load()
+	if err == nil {
+		t.Fatal("Expected error for invalid port")
+	}
+	if !strings.Contains(err.Error(), "loader[0] (EnvLoader) failed") {
+		t.Errorf("Expected error to name EnvLoader, got: %v", err)
+	}
+}
@@ -0,0 +1,43 @@
+package loader
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+type MapSectionConfig struct {
+	Databases map[string]struct {
+		DSN      string `mapstructure:"dsn"`
+		MaxConns int    `mapstructure:"max_conns"`
+	} `mapstructure:"databases"`
+	Server struct {
+		Port int `mapstructure:"port"`
+	} `mapstructure:"server"`
+}
+
+func TestExtractKeysFromType_MapParentKey(t *testing.T) {
+	keys := ExtractKeysFromType(MapSectionConfig{})
+
+	expected := []string{"databases", "server.port"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("Expected keys %v, got %v", expected, keys)
+	}
+}
+
+func TestEnvLoader_MapSectionFromJSON(t *testing.T) {
+	os.Setenv("APP_DATABASES", `{"primary":{"dsn":"postgres://primary","max_conns":10},"cache":{"dsn":"redis://cache"}}`)
+	defer os.Unsetenv("APP_DATABASES")
+
+	var cfg MapSectionConfig
+	if err := NewEnvLoader("APP").WithAutoKeys(MapSectionConfig{}).Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Databases["primary"].DSN != "postgres://primary" || cfg.Databases["primary"].MaxConns != 10 {
+		t.Errorf("Expected primary entry from JSON blob, got %+v", cfg.Databases["primary"])
+	}
+	if cfg.Databases["cache"].DSN != "redis://cache" {
+		t.Errorf("Expected cache entry from JSON blob, got %+v", cfg.Databases["cache"])
+	}
+}
@@ -0,0 +1,111 @@
+package loader
+
+import (
+	"reflect"
+	"testing"
+)
+
+type envTagConfig struct {
+	Database struct {
+		URL  string `mapstructure:"url" env:"DATABASE_URL"`
+		Pool int    `mapstructure:"pool"`
+	} `mapstructure:"database"`
+	Token string `mapstructure:"token" env:"API_TOKEN"`
+}
+
+func TestEnvLoader_EnvTagOnly(t *testing.T) {
+	t.Setenv("DATABASE_URL", "postgres://tagged/db")
+	t.Setenv("API_TOKEN", "secret")
+
+	loader := NewEnvLoader("APP").WithAutoKeys(envTagConfig{})
+	var cfg envTagConfig
+	if err := loader.Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Database.URL != "postgres://tagged/db" {
+		t.Errorf("Expected database.url from DATABASE_URL, got %q", cfg.Database.URL)
+	}
+	if cfg.Token != "secret" {
+		t.Errorf("Expected token from API_TOKEN, got %q", cfg.Token)
+	}
+}
+
+func TestEnvLoader_EnvTagConventionOnly(t *testing.T) {
+	t.Setenv("APP_DATABASE_URL", "postgres://convention/db")
+	t.Setenv("APP_DATABASE_POOL", "5")
+
+	loader := NewEnvLoader("APP").WithAutoKeys(envTagConfig{})
+	var cfg envTagConfig
+	if err := loader.Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Database.URL != "postgres://convention/db" {
+		t.Errorf("Expected database.url from APP_DATABASE_URL, got %q", cfg.Database.URL)
+	}
+	if cfg.Database.Pool != 5 {
+		t.Errorf("Expected database.pool=5, got %d", cfg.Database.Pool)
+	}
+}
+
+func TestEnvLoader_EnvTagWinsOverConvention(t *testing.T) {
+	t.Setenv("DATABASE_URL", "postgres://tagged/db")
+	t.Setenv("APP_DATABASE_URL", "postgres://convention/db")
+
+	loader := NewEnvLoader("APP").WithAutoKeys(envTagConfig{})
+	var cfg envTagConfig
+	if err := loader.Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Database.URL != "postgres://tagged/db" {
+		t.Errorf("Expected DATABASE_URL to take precedence, got %q", cfg.Database.URL)
+	}
+}
+
+func TestEnvLoader_EnvTagWithoutAutoKeys(t *testing.T) {
+	t.Setenv("DATABASE_URL", "postgres://tagged/db")
+
+	// Tags are read from dst, so they apply with WithKeys too
+	loader := NewEnvLoader("APP").WithKeys("database.pool")
+	var cfg envTagConfig
+	if err := loader.Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Database.URL != "postgres://tagged/db" {
+		t.Errorf("Expected database.url from DATABASE_URL, got %q", cfg.Database.URL)
+	}
+}
+
+func TestEnvLoader_EnvTagNotWarned(t *testing.T) {
+	type prefixedTagConfig struct {
+		Port int `mapstructure:"port" env:"APP_LEGACY_PORT"`
+	}
+	t.Setenv("APP_LEGACY_PORT", "8080")
+
+	loader := NewEnvLoader("APP").WithAutoKeys(prefixedTagConfig{})
+	var cfg prefixedTagConfig
+	if err := loader.Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Port != 8080 {
+		t.Errorf("Expected port=8080, got %d", cfg.Port)
+	}
+	if warnings := loader.Warnings(); len(warnings) != 0 {
+		t.Errorf("Expected no warnings for a tagged variable, got %v", warnings)
+	}
+}
+
+func TestExtractEnvNamesFromType(t *testing.T) {
+	got := ExtractEnvNamesFromType(&envTagConfig{})
+	want := map[string]string{
+		"database.url": "DATABASE_URL",
+		"token":        "API_TOKEN",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,99 @@
+package loader
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+// envKeyReplacer mirrors the replacer used by Load: "server.host" -> "SERVER_HOST".
+var envKeyReplacer = strings.NewReplacer(".", "_", "-", "_")
+
+// unknownEnvWarnings reports prefixed variables in environ that match no known key.
+// Variables named by `env` tags (envNames) are known too.
+func (e *EnvLoader) unknownEnvWarnings(environ []string, envNames map[string]string) []string {
+	if e.prefix == "" || len(e.keys) == 0 {
+		return nil
+	}
+
+	prefix := strings.ToUpper(e.prefix) + "_"
+	known := make(map[string]string, len(e.keys)+len(envNames)) // env var name -> key
+	for _, key := range e.keys {
+		known[e.conventionalEnvName(key)] = key
+	}
+	for key, name := range envNames {
+		known[name] = key
+	}
+
+	var warnings []string
+	for _, entry := range environ {
+		name, _, _ := strings.Cut(entry, "=")
+		if !strings.HasPrefix(name, prefix) || e.ignored(name) {
+			continue
+		}
+		if _, ok := known[name]; ok {
+			continue
+		}
+
+		// Map back to a dotted key the same way the replacer maps keys to env vars
+		guess := strings.ToLower(strings.ReplaceAll(strings.TrimPrefix(name, prefix), "_", "."))
+		warning := fmt.Sprintf("%s (%s) does not match any config key", name, guess)
+		if suggestion := closestKey(guess, e.keys); suggestion != "" {
+			warning += fmt.Sprintf("; did you mean %s?", suggestion)
+		}
+		warnings = append(warnings, warning)
+	}
+
+	sort.Strings(warnings)
+	return warnings
+}
+
+func (e *EnvLoader) ignored(name string) bool {
+	for _, pattern := range e.ignore {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// closestKey returns the key with the smallest edit distance to guess,
+// or "" if none is within a third of the guess length (at least 2 edits).
+func closestKey(guess string, keys []string) string {
+	maxDistance := len(guess) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	best, bestDistance := "", maxDistance+1
+	for _, key := range keys {
+		normalized := strings.ToLower(strings.NewReplacer("_", ".", "-", ".").Replace(key))
+		if d := levenshtein(guess, normalized); d < bestDistance {
+			best, bestDistance = key, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,92 @@
+package loader
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestEnvLoader_WarnsOnUnknownVar(t *testing.T) {
+	os.Setenv("APP_SERVER_HOST", "localhost")
+	os.Setenv("APP_SEVER_PORT", "9090") // typo
+	defer os.Unsetenv("APP_SERVER_HOST")
+	defer os.Unsetenv("APP_SEVER_PORT")
+
+	loader := NewEnvLoader("APP").WithAutoKeys(TestConfig{})
+	var cfg TestConfig
+	if err := loader.Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	warnings := loader.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("Expected 1 warning, got %v", warnings)
+	}
+	if !strings.Contains(warnings[0], "APP_SEVER_PORT") {
+		t.Errorf("Expected warning to name APP_SEVER_PORT, got %q", warnings[0])
+	}
+	if !strings.Contains(warnings[0], "did you mean server.port?") {
+		t.Errorf("Expected suggestion server.port, got %q", warnings[0])
+	}
+}
+
+func TestEnvLoader_WarningsIgnorePatterns(t *testing.T) {
+	os.Setenv("APP_DEBUG_PPROF", "1")
+	os.Setenv("APP_SEVER_PORT", "9090")
+	defer os.Unsetenv("APP_DEBUG_PPROF")
+	defer os.Unsetenv("APP_SEVER_PORT")
+
+	loader := NewEnvLoader("APP").WithAutoKeys(TestConfig{}).WithIgnore("APP_DEBUG_*", "APP_SEVER_*")
+	var cfg TestConfig
+	if err := loader.Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if warnings := loader.Warnings(); len(warnings) != 0 {
+		t.Errorf("Expected ignored vars to produce no warnings, got %v", warnings)
+	}
+}
+
+func TestEnvLoader_WarningWithoutSuggestion(t *testing.T) {
+	os.Setenv("APP_COMPLETELY_UNRELATED", "x")
+	defer os.Unsetenv("APP_COMPLETELY_UNRELATED")
+
+	loader := NewEnvLoader("APP").WithKeys("server.port")
+	var cfg TestConfig
+	loader.Load(&cfg)
+
+	warnings := loader.Warnings()
+	if len(warnings) != 1 || strings.Contains(warnings[0], "did you mean") {
+		t.Errorf("Expected a warning without suggestion, got %v", warnings)
+	}
+}
+
+func TestEnvLoader_NoWarningsWithoutKeys(t *testing.T) {
+	os.Setenv("APP_SEVER_PORT", "9090")
+	defer os.Unsetenv("APP_SEVER_PORT")
+
+	loader := NewEnvLoader("APP")
+	var cfg TestConfig
+	loader.Load(&cfg)
+
+	if warnings := loader.Warnings(); len(warnings) != 0 {
+		t.Errorf("Expected no warnings without known keys, got %v", warnings)
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"", "", 0},
+		{"sever.port", "server.port", 1},
+		{"kitten", "sitting", 3},
+		{"abc", "", 3},
+	}
+	for _, tt := range tests {
+		if got := levenshtein(tt.a, tt.b); got != tt.want {
+			t.Errorf("levenshtein(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package loader
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
+)
+
+type FeatureConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	Rollout int  `mapstructure:"rollout"`
+}
+
+type DynamicConfig struct {
+	Features map[string]FeatureConfig `mapstructure:"features"`
+	Extras   map[string]any           `mapstructure:"extras"`
+}
+
+// dynamicFileLoader adapts FileLoader to core.Loader[*DynamicConfig]
+type dynamicFileLoader struct {
+	*FileLoader
+}
+
+func (l dynamicFileLoader) Load(dst *DynamicConfig) error {
+	return l.FileLoader.Load(dst)
+}
+
+func TestConfig_DynamicSectionsAcrossFiles(t *testing.T) {
+	base := writeConfigFile(t, "config.yaml", `
+features:
+  beta:
+    enabled: true
+    rollout: 10
+extras:
+  limits:
+    rps: 100
+    burst: 20
+`)
+	override := writeConfigFile(t, "config.local.json", `{
+  "features": {"checkout": {"enabled": true, "rollout": 5}},
+  "extras": {"limits": {"rps": 500}, "owner": "payments"}
+}`)
+
+	cfg := core.New[DynamicConfig](
+		dynamicFileLoader{NewFileLoader(base, "yaml")},
+		dynamicFileLoader{NewFileLoader(override, "json")},
+	)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	result := cfg.Get()
+
+	wantFeatures := map[string]FeatureConfig{
+		"beta":     {Enabled: true, Rollout: 10},
+		"checkout": {Enabled: true, Rollout: 5},
+	}
+	if !reflect.DeepEqual(result.Features, wantFeatures) {
+		t.Errorf("Expected features %v, got %v", wantFeatures, result.Features)
+	}
+
+	limits, ok := result.Extras["limits"].(map[string]any)
+	if !ok {
+		t.Fatalf("Expected extras.limits to be a map, got %T", result.Extras["limits"])
+	}
+	// Numbers keep each decoder's type (YAML int, JSON float64)
+	if limits["rps"] != float64(500) || limits["burst"] != 20 {
+		t.Errorf("Expected limits rps=500 (json) burst=20 (yaml), got %v", limits)
+	}
+	if result.Extras["owner"] != "payments" {
+		t.Errorf("Expected extras.owner=payments, got %v", result.Extras["owner"])
+	}
+}
@@ -0,0 +1,184 @@
+package loader
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeConfigTree writes files (name → content) into one temp dir and returns it.
+func writeConfigTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create test dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create test file: %v", err)
+		}
+	}
+	return dir
+}
+
+func TestFileLoader_Include(t *testing.T) {
+	dir := writeConfigTree(t, map[string]string{
+		"config.yaml": `
+include: [shared/pools.yaml, secrets.json]
+primary:
+  dsn: postgres://primary
+  pool:
+    max_conns: 50
+`,
+		"shared/pools.yaml": `
+primary:
+  dsn: postgres://from-pools
+  pool:
+    max_conns: 10
+    idle_timeout: 30s
+    ssl_mode: disable
+`,
+		"secrets.json": `{"primary": {"pool": {"ssl_mode": "require"}}}`,
+	})
+
+	var cfg DatabasesConfig
+	if err := NewFileLoader(filepath.Join(dir, "config.yaml"), "yaml").Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	// Own keys win, later includes win over earlier ones, nested maps are deep-merged
+	want := DatabaseConfig{
+		DSN:  "postgres://primary",
+		Pool: PoolConfig{MaxConns: 50, IdleTimeout: 30 * time.Second, SSLMode: "require"},
+	}
+	if cfg.Primary != want {
+		t.Errorf("Expected %+v, got %+v", want, cfg.Primary)
+	}
+}
+
+func TestFileLoader_IncludeSinglePath(t *testing.T) {
+	dir := writeConfigTree(t, map[string]string{
+		"config.yaml": "include: base.yaml\n",
+		"base.yaml":   "primary:\n  dsn: postgres://base\n",
+	})
+
+	var cfg DatabasesConfig
+	if err := NewFileLoader(filepath.Join(dir, "config.yaml"), "yaml").Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Primary.DSN != "postgres://base" {
+		t.Errorf("Expected dsn from base.yaml, got %q", cfg.Primary.DSN)
+	}
+}
+
+func TestFileLoader_NestedIncludes(t *testing.T) {
+	// Nested paths are relative to the file that includes them
+	dir := writeConfigTree(t, map[string]string{
+		"config.yaml":       "include: [envs/prod.yaml]\nprimary:\n  dsn: postgres://primary\n",
+		"envs/prod.yaml":    "include: [../shared/pools.yaml]\nprimary:\n  pool:\n    max_conns: 40\n",
+		"shared/pools.yaml": "include: [tls.yaml]\nprimary:\n  pool:\n    max_conns: 10\n    idle_timeout: 1m\n",
+		"shared/tls.yaml":   "primary:\n  pool:\n    ssl_mode: verify-full\n",
+	})
+
+	var cfg DatabasesConfig
+	if err := NewFileLoader(filepath.Join(dir, "config.yaml"), "yaml").Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	want := DatabaseConfig{
+		DSN:  "postgres://primary",
+		Pool: PoolConfig{MaxConns: 40, IdleTimeout: time.Minute, SSLMode: "verify-full"},
+	}
+	if cfg.Primary != want {
+		t.Errorf("Expected %+v, got %+v", want, cfg.Primary)
+	}
+}
+
+func TestFileLoader_IncludeCycle(t *testing.T) {
+	dir := writeConfigTree(t, map[string]string{
+		"config.yaml": "include: [a.yaml]\n",
+		"a.yaml":      "include: [b.yaml]\n",
+		"b.yaml":      "include: [a.yaml]\n",
+	})
+
+	var cfg DatabasesConfig
+	err := NewFileLoader(filepath.Join(dir, "config.yaml"), "yaml").Load(&cfg)
+	if !errors.Is(err, ErrIncludeCycle) {
+		t.Fatalf("Expected ErrIncludeCycle, got: %v", err)
+	}
+
+	chain := strings.Join([]string{
+		filepath.Join(dir, "config.yaml"),
+		filepath.Join(dir, "a.yaml"),
+		filepath.Join(dir, "b.yaml"),
+		filepath.Join(dir, "a.yaml"),
+	}, " -> ")
+	if !strings.Contains(err.Error(), chain) {
+		t.Errorf("Expected error to name the include chain %q, got: %v", chain, err)
+	}
+}
+
+func TestFileLoader_IncludeSelf(t *testing.T) {
+	dir := writeConfigTree(t, map[string]string{
+		"config.yaml": "include: [./config.yaml]\n",
+	})
+
+	var cfg DatabasesConfig
+	err := NewFileLoader(filepath.Join(dir, "config.yaml"), "yaml").Load(&cfg)
+	if !errors.Is(err, ErrIncludeCycle) {
+		t.Fatalf("Expected ErrIncludeCycle, got: %v", err)
+	}
+}
+
+func TestFileLoader_IncludeDepth(t *testing.T) {
+	dir := writeConfigTree(t, map[string]string{
+		"config.yaml": "include: [a.yaml]\n",
+		"a.yaml":      "include: [b.yaml]\n",
+		"b.yaml":      "include: [c.yaml]\n",
+		"c.yaml":      "primary:\n  dsn: postgres://c\n",
+	})
+	path := filepath.Join(dir, "config.yaml")
+
+	var cfg DatabasesConfig
+	if err := NewFileLoader(path, "yaml", WithFileIncludeDepth(3)).Load(&cfg); err != nil {
+		t.Fatalf("Expected depth 3 to load, got: %v", err)
+	}
+	if cfg.Primary.DSN != "postgres://c" {
+		t.Errorf("Expected dsn from c.yaml, got %q", cfg.Primary.DSN)
+	}
+
+	err := NewFileLoader(path, "yaml").WithIncludeDepth(2).Load(&cfg)
+	if !errors.Is(err, ErrIncludeDepth) {
+		t.Fatalf("Expected ErrIncludeDepth, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), filepath.Join(dir, "b.yaml")) {
+		t.Errorf("Expected error to name the include chain, got: %v", err)
+	}
+}
+
+func TestFileLoader_IncludeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    string
+	}{
+		{"missing file", "include: [missing.yaml]\n", "missing.yaml"},
+		{"invalid entry", "include: [1]\n", "invalid include"},
+		{"invalid value", "include:\n  path: a.yaml\n", "invalid include"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := writeConfigFile(t, "config.yaml", tt.content)
+
+			var cfg DatabasesConfig
+			err := NewFileLoader(path, "yaml").Load(&cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got: %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package loader
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+
+	"github.com/go-viper/mapstructure/v2"
+	"go.yaml.in/yaml/v3"
+)
+
+// isListTarget reports whether dst points to a slice, i.e. the file root is a list.
+func isListTarget(dst interface{}) bool {
+	v := reflect.ValueOf(dst)
+	return v.Kind() == reflect.Ptr && v.Elem().Kind() == reflect.Slice
+}
+
+// loadList decodes a file whose root is a list. Viper only reads map roots,
+// so the file is parsed directly and decoded with Viper's default hooks.
+func (f *FileLoader) loadList(dst interface{}) error {
+	data, err := os.ReadFile(f.filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", f.filePath, err)
+	}
+
+	var raw interface{}
+	switch f.fileType {
+	case "yaml", "yml":
+		err = yaml.Unmarshal(data, &raw)
+	case "json":
+		err = json.Unmarshal(data, &raw)
+	default:
+		return fmt.Errorf("failed to read config file %s: list root not supported for %s files", f.filePath, f.fileType)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", f.filePath, err)
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           dst,
+		WeaklyTypedInput: true,
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapstructure.StringToTimeDurationHookFunc(),
+			mapstructure.StringToSliceHookFunc(","),
+		),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+	if err := decoder.Decode(raw); err != nil {
+		return fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
+)
+
+type Upstream struct {
+	Name    string        `mapstructure:"name"`
+	URL     string        `mapstructure:"url"`
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+func writeConfigFile(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+	return path
+}
+
+func TestFileLoader_LoadYAMLList(t *testing.T) {
+	path := writeConfigFile(t, "upstreams.yaml", `
+- name: api
+  url: http://api:8080
+  timeout: 2s
+- name: auth
+  url: http://auth:8080
+- name: billing
+  url: http://billing:8080
+  timeout: 500ms
+`)
+
+	var upstreams []Upstream
+	if err := NewFileLoader(path, "yaml").Load(&upstreams); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if len(upstreams) != 3 {
+		t.Fatalf("Expected 3 upstreams, got %d: %+v", len(upstreams), upstreams)
+	}
+	if upstreams[0].Name != "api" || upstreams[0].Timeout != 2*time.Second {
+		t.Errorf("Expected api with 2s timeout, got %+v", upstreams[0])
+	}
+	if upstreams[2].URL != "http://billing:8080" || upstreams[2].Timeout != 500*time.Millisecond {
+		t.Errorf("Expected billing upstream, got %+v", upstreams[2])
+	}
+}
+
+func TestFileLoader_LoadJSONList(t *testing.T) {
+	path := writeConfigFile(t, "upstreams.json", `[{"name": "api", "url": "http://api:8080", "timeout": "1s"}]`)
+
+	var upstreams []Upstream
+	if err := NewFileLoader(path, "json").Load(&upstreams); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if len(upstreams) != 1 || upstreams[0].Timeout != time.Second {
+		t.Errorf("Expected 1 upstream with 1s timeout, got %+v", upstreams)
+	}
+}
+
+func TestFileLoader_ListRootUnsupportedType(t *testing.T) {
+	path := writeConfigFile(t, "upstreams.toml", `name = "api"`)
+
+	var upstreams []Upstream
+	err := NewFileLoader(path, "toml").Load(&upstreams)
+	if err == nil || !strings.Contains(err.Error(), "list root not supported for toml") {
+		t.Errorf("Expected unsupported list root error, got %v", err)
+	}
+}
+
+// upstreamFileLoader adapts FileLoader to core.Loader[*[]Upstream]
+type upstreamFileLoader struct {
+	*FileLoader
+}
+
+func (l upstreamFileLoader) Load(dst *[]Upstream) error {
+	return l.FileLoader.Load(dst)
+}
+
+func TestNewListConfig_AppendsFiles(t *testing.T) {
+	base := writeConfigFile(t, "upstreams.yaml", `
+- name: api
+  url: http://api:8080
+- name: auth
+  url: http://auth:8080
+`)
+	local := writeConfigFile(t, "upstreams.local.yaml", `
+- name: mock
+  url: http://localhost:9000
+`)
+
+	cfg := core.NewListConfig[Upstream](
+		upstreamFileLoader{NewFileLoader(base, "yaml")},
+		upstreamFileLoader{NewFileLoader(local, "yaml")},
+	)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	var names []string
+	for _, u := range cfg.Get() {
+		names = append(names, u.Name)
+	}
+	if strings.Join(names, ",") != "api,auth,mock" {
+		t.Errorf("Expected entries from both files in order, got %v", names)
+	}
+	if cfg.Hash() == "" {
+		t.Error("Expected list config to be hashed")
+	}
+}
@@ -2,6 +2,8 @@ package loader
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/spf13/viper"
 )
@@ -9,8 +11,12 @@ import (
 // FileLoader loads configuration from files.
 // Supported formats: JSON, YAML, TOML, Properties, HCL
 type FileLoader struct {
-	filePath string
-	fileType string
+	filePath     string
+	fileType     string
+	decoder      Decoder
+	includeDepth int
+	strict       bool
+	unused       []string // keys of the last Load matching no field, see UnusedKeys
 }
 
 // NewFileLoader creates a new FileLoader.
@@ -18,20 +24,54 @@ type FileLoader struct {
 // Parameters:
 //   - path: path to config file
 //   - fileType: file type (json, yaml, toml, properties, hcl)
+//   - opts: applied in order (see WithFileDecoder)
 //
 // Example:
 //
 //	loader := loader.NewFileLoader("config.yaml", "yaml")
 //	loader := loader.NewFileLoader("config.json", "json")
-func NewFileLoader(path, fileType string) *FileLoader {
-	return &FileLoader{
+func NewFileLoader(path, fileType string, opts ...FileOption) *FileLoader {
+	return applyOptions(&FileLoader{
 		filePath: path,
 		fileType: fileType,
-	}
+	}, opts)
+}
+
+// WithDecoder decodes the file with d instead of Viper.
+// fileType is then only informational; d receives the raw file contents
+// and decides how keys map to fields (e.g. json tags for encoding/json).
+// Returns *FileLoader to support method chaining.
+//
+// Example:
+//
+//	fileLoader := loader.NewFileLoader("config.json", "json").
+//	    WithDecoder(loader.DecoderFunc(json.Unmarshal))
+func (f *FileLoader) WithDecoder(d Decoder) *FileLoader {
+	f.decoder = d
+	return f
 }
 
 // Load reads config file and unmarshals it into dst.
+// If dst points to a slice (e.g. *[]Upstream), the file root must be a list;
+// list roots are supported for JSON and YAML files.
+//
+// A top-level `include` key (a path or a list of paths) loads other files first.
+// Paths are relative to the including file; included files are deep-merged in listed
+// order beneath the including file's own keys and may include files themselves,
+// up to DefaultIncludeDepth levels (see WithIncludeDepth).
+//
+// Returns error if:
+//   - Includes form a cycle (wraps ErrIncludeCycle, names the include chain)
+//   - Includes nest deeper than the include depth (wraps ErrIncludeDepth)
 func (f *FileLoader) Load(dst interface{}) error {
+	f.unused = nil
+	if f.decoder != nil {
+		return f.loadWithDecoder(dst)
+	}
+	if isListTarget(dst) {
+		return f.loadList(dst)
+	}
+
 	v := viper.New()
 	v.SetConfigFile(f.filePath)
 	v.SetConfigType(f.fileType)
@@ -40,9 +80,72 @@ func (f *FileLoader) Load(dst interface{}) error {
 		return fmt.Errorf("failed to read config file %s: %w", f.filePath, err)
 	}
 
-	if err := v.Unmarshal(dst); err != nil {
+	if v.IsSet(IncludeKey) {
+		root, err := filepath.Abs(f.filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read config file %s: %w", f.filePath, err)
+		}
+		settings, err := f.resolveIncludes(v, f.filePath, []string{root})
+		if err != nil {
+			return err
+		}
+		v = viper.New()
+		if err := v.MergeConfigMap(settings); err != nil {
+			return fmt.Errorf("failed to read config file %s: %w", f.filePath, err)
+		}
+	}
+
+	unused, err := unmarshal(v, dst, f.strict)
+	if err != nil {
+		return err
+	}
+	f.unused = unused
+	return nil
+}
+
+// UnusedKeys returns the keys of the file read by the last successful Load that match no field
+// of dst, sorted, e.g. "serevr.port" or a setting left over after its field was removed.
+// A softer diagnostic than WithStrict: Load still succeeds. Like WithStrict, it applies to
+// Viper decoding only; it returns nil for list roots and custom decoders.
+// Config.UnusedKeys collects it from every loader.
+func (f *FileLoader) UnusedKeys() []string {
+	return f.unused
+}
+
+// WithStrict makes Load fail when the file has keys that match no field of dst,
+// e.g. a misspelled "serevr.port". The error wraps ErrUnknownKeys and lists the keys.
+// Applies to Viper decoding only: not to list roots or custom decoders.
+// Returns *FileLoader to support method chaining.
+//
+// Example:
+//
+//	fileLoader := loader.NewFileLoader("config.yaml", "yaml").WithStrict()
+func (f *FileLoader) WithStrict() *FileLoader {
+	f.strict = true
+	return f
+}
+
+// loadWithDecoder reads the file and hands its contents to the custom decoder.
+func (f *FileLoader) loadWithDecoder(dst interface{}) error {
+	data, err := os.ReadFile(f.filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read config file %s: %w", f.filePath, err)
+	}
+
+	if err := f.decoder.Decode(data, dst); err != nil {
 		return fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
 	return nil
 }
+
+// String describes the loader in error messages.
+// Example: "file(config.yaml)"
+func (f *FileLoader) String() string {
+	return fmt.Sprintf("file(%s)", f.filePath)
+}
+
+// Name implements core.NamedLoader.
+func (f *FileLoader) Name() string {
+	return "FileLoader"
+}
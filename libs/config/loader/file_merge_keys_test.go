@@ -0,0 +1,108 @@
+package loader
+
+import (
+	"testing"
+	"time"
+)
+
+type PoolConfig struct {
+	MaxConns    int           `mapstructure:"max_conns"`
+	IdleTimeout time.Duration `mapstructure:"idle_timeout"`
+	SSLMode     string        `mapstructure:"ssl_mode"`
+}
+
+type DatabaseConfig struct {
+	DSN  string     `mapstructure:"dsn"`
+	Pool PoolConfig `mapstructure:"pool"`
+}
+
+type DatabasesConfig struct {
+	Databases map[string]DatabaseConfig `mapstructure:"databases"`
+	Primary   DatabaseConfig            `mapstructure:"primary"`
+}
+
+func TestFileLoader_YAMLMergeKeys(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", `
+defaults:
+  pool: &pool
+    max_conns: 10
+    idle_timeout: 30s
+  tls: &tls
+    ssl_mode: require
+  db: &db
+    pool:
+      max_conns: 5
+      idle_timeout: 1m
+
+primary:
+  dsn: postgres://primary
+  pool:
+    <<: *pool
+    max_conns: 50
+
+databases:
+  orders:
+    dsn: postgres://orders
+    pool: *pool
+  users:
+    dsn: postgres://users
+    pool:
+      <<: [*pool, *tls]
+  billing:
+    <<: *db
+    dsn: postgres://billing
+`)
+
+	var cfg DatabasesConfig
+	if err := NewFileLoader(path, "yaml").Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	// Local keys override merged ones
+	wantPrimary := PoolConfig{MaxConns: 50, IdleTimeout: 30 * time.Second}
+	if cfg.Primary.Pool != wantPrimary {
+		t.Errorf("Expected primary pool %+v, got %+v", wantPrimary, cfg.Primary.Pool)
+	}
+
+	tests := []struct {
+		name string
+		want DatabaseConfig
+	}{
+		{"orders", DatabaseConfig{DSN: "postgres://orders", Pool: PoolConfig{MaxConns: 10, IdleTimeout: 30 * time.Second}}},
+		{"users", DatabaseConfig{DSN: "postgres://users", Pool: PoolConfig{MaxConns: 10, IdleTimeout: 30 * time.Second, SSLMode: "require"}}},
+		{"billing", DatabaseConfig{DSN: "postgres://billing", Pool: PoolConfig{MaxConns: 5, IdleTimeout: time.Minute}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cfg.Databases[tt.name]; got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFileLoader_YAMLMergeKeysAreShallow(t *testing.T) {
+	// Per the YAML spec a local key replaces the merged value as a whole, nested maps included
+	path := writeConfigFile(t, "config.yaml", `
+base: &base
+  dsn: postgres://base
+  pool:
+    max_conns: 5
+    idle_timeout: 1m
+
+primary:
+  <<: *base
+  pool:
+    max_conns: 20
+`)
+
+	var cfg DatabasesConfig
+	if err := NewFileLoader(path, "yaml").Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	want := DatabaseConfig{DSN: "postgres://base", Pool: PoolConfig{MaxConns: 20}}
+	if cfg.Primary != want {
+		t.Errorf("Expected %+v, got %+v", want, cfg.Primary)
+	}
+}
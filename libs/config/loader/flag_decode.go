@@ -0,0 +1,118 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+)
+
+// flagDecodeHook keeps Viper's default hooks and adds "k=v,k2=v2" strings for map fields,
+// and custom value types: fields implementing pflag.Value or encoding.TextUnmarshaler.
+var flagDecodeHook = mapstructure.ComposeDecodeHookFunc(
+	flagValueHook,
+	mapstructure.TextUnmarshallerHookFunc(),
+	keyValueStringHook,
+	mapstructure.StringToTimeDurationHookFunc(),
+	mapstructure.StringToSliceHookFunc(","),
+)
+
+// keyValueStringHook decodes "k=v,k2=v2" (the StringToString flag syntax) into a string-keyed map.
+func keyValueStringHook(from, to reflect.Type, data interface{}) (interface{}, error) {
+	if from.Kind() != reflect.String || to.Kind() != reflect.Map || to.Key().Kind() != reflect.String {
+		return data, nil
+	}
+
+	raw := strings.TrimSpace(data.(string))
+	if raw == "" {
+		return map[string]interface{}{}, nil
+	}
+
+	out := make(map[string]interface{})
+	for _, pair := range strings.Split(raw, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not in key=value form", pair)
+		}
+		out[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return out, nil
+}
+
+// describeDecodeError rewrites mapstructure field errors so each one names its flag
+// and the type of the field it failed to fill. Unrecognized errors are returned unchanged.
+func (f *FlagLoader) describeDecodeError(dst interface{}, err error) error {
+	var fieldErrs []*mapstructure.DecodeError
+	collectDecodeErrors(err, &fieldErrs)
+	if len(fieldErrs) == 0 {
+		return err
+	}
+
+	dstType := reflect.TypeOf(dst)
+	described := make([]error, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		key, _, _ := strings.Cut(fieldErr.Name(), "[")
+		flagName := key
+		if f.namespace != "" {
+			flagName = f.namespace + "." + key
+		}
+
+		cause := fieldErr.Unwrap().Error()
+		if fieldErr.Name() != key {
+			cause = fieldErr.Name() + ": " + cause // element of a slice or map
+		}
+
+		if fieldType, ok := fieldTypeForKey(dstType, key); ok {
+			described = append(described, fmt.Errorf("flag --%s (%s): %s", flagName, fieldType, cause))
+		} else {
+			described = append(described, fmt.Errorf("flag --%s: %s", flagName, cause))
+		}
+	}
+	return errors.Join(described...)
+}
+
+// collectDecodeErrors gathers the per-field errors from a (possibly joined) mapstructure error.
+func collectDecodeErrors(err error, out *[]*mapstructure.DecodeError) {
+	switch e := err.(type) {
+	case *mapstructure.DecodeError:
+		*out = append(*out, e)
+	case interface{ Unwrap() []error }:
+		for _, inner := range e.Unwrap() {
+			collectDecodeErrors(inner, out)
+		}
+	case interface{ Unwrap() error }:
+		collectDecodeErrors(e.Unwrap(), out)
+	}
+}
+
+// fieldTypeForKey finds the type of the field addressed by a dotted key,
+// matching keys the same way as extractStructKeys.
+func fieldTypeForKey(t reflect.Type, key string) (reflect.Type, bool) {
+	for _, segment := range strings.Split(key, ".") {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct {
+			return nil, false
+		}
+
+		found := false
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			tag, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+			if tag == "" {
+				tag = strings.ToLower(field.Name)
+			}
+			if field.IsExported() && strings.EqualFold(tag, segment) {
+				t, found = field.Type, true
+				break
+			}
+		}
+		if !found {
+			return nil, false
+		}
+	}
+	return t, true
+}
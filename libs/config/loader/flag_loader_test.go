@@ -1,8 +1,10 @@
 package loader
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
 	"github.com/spf13/pflag"
 )
 
@@ -214,3 +216,88 @@ func TestFlagLoader_Shorthand(t *testing.T) {
 		t.Errorf("Expected server.port=4000, got %d", cfg.Server.Port)
 	}
 }
+
+// typedFlagLoader adapts FlagLoader to core.Loader[*TestConfig]
+type typedFlagLoader struct {
+	*FlagLoader
+}
+
+func (l typedFlagLoader) Load(dst *TestConfig) error {
+	return l.FlagLoader.Load(dst)
+}
+
+// bootstrapConfig mimics a library or app bootstrap: define flags, then build a Config
+func bootstrapConfig(t *testing.T, flags *FlagLoader, defaultPort int) *core.Config[TestConfig] {
+	t.Helper()
+	err := flags.Define(func(fs *pflag.FlagSet) {
+		fs.String("server.host", "localhost", "Server host")
+		fs.Int("server.port", defaultPort, "Server port")
+	})
+	if err != nil {
+		t.Fatalf("Define failed: %v", err)
+	}
+	return core.New[TestConfig](typedFlagLoader{flags})
+}
+
+func TestFlagLoader_TwoConfigsShareCommandLine(t *testing.T) {
+	original := pflag.CommandLine
+	pflag.CommandLine = pflag.NewFlagSet("app", pflag.ContinueOnError)
+	t.Cleanup(func() { pflag.CommandLine = original })
+
+	libCfg := bootstrapConfig(t, NewFlagLoader(nil).WithNamespace("mylib"), 7000)
+	appCfg := bootstrapConfig(t, NewFlagLoader(nil), 8080)
+
+	if err := pflag.CommandLine.Parse([]string{"--server.port=9090", "--mylib.server.host=lib.internal"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if err := libCfg.Load(); err != nil {
+		t.Fatalf("lib Load failed: %v", err)
+	}
+	if err := appCfg.Load(); err != nil {
+		t.Fatalf("app Load failed: %v", err)
+	}
+
+	if lib := libCfg.Get(); lib.Server.Host != "lib.internal" || lib.Server.Port != 7000 {
+		t.Errorf("Expected lib config lib.internal:7000, got %s:%d", lib.Server.Host, lib.Server.Port)
+	}
+	if app := appCfg.Get(); app.Server.Host != "localhost" || app.Server.Port != 9090 {
+		t.Errorf("Expected app config localhost:9090, got %s:%d", app.Server.Host, app.Server.Port)
+	}
+}
+
+func TestFlagLoader_DefineReportsRedefinition(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	first := NewFlagLoader(flags)
+	second := NewFlagLoader(flags)
+
+	if err := first.Define(func(fs *pflag.FlagSet) { fs.IntP("server.port", "p", 8080, "") }); err != nil {
+		t.Fatalf("first Define failed: %v", err)
+	}
+
+	err := second.Define(func(fs *pflag.FlagSet) {
+		fs.String("server.host", "localhost", "")
+		fs.IntP("server.port", "p", 9090, "")
+	})
+	if err == nil {
+		t.Fatal("Expected redefinition error")
+	}
+	expected := "flags(app): flag redefined: --server.port already defined by flags(app); -p already defined by flags(app)"
+	if err.Error() != expected {
+		t.Errorf("Expected %q, got %q", expected, err.Error())
+	}
+
+	// Verify: Nothing from the failed Define was registered
+	if flags.Lookup("server.host") != nil {
+		t.Error("Expected no flags to be registered after a collision")
+	}
+}
+
+func TestFlagLoader_DefineReportsDirectDefinition(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	flags.Int("server.port", 8080, "")
+
+	err := NewFlagLoader(flags).Define(func(fs *pflag.FlagSet) { fs.Int("server.port", 9090, "") })
+	if err == nil || !strings.Contains(err.Error(), "--server.port already defined by another flag definition") {
+		t.Errorf("Expected redefinition error, got: %v", err)
+	}
+}
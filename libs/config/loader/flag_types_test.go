@@ -0,0 +1,122 @@
+package loader
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type FlagTypesConfig struct {
+	Tags    []string          `mapstructure:"tags"`
+	Ports   []int             `mapstructure:"ports"`
+	Timeout time.Duration     `mapstructure:"timeout"`
+	Labels  map[string]string `mapstructure:"labels"`
+}
+
+func newFlagTypesSet() *pflag.FlagSet {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	flags.StringSlice("tags", nil, "")
+	flags.IntSlice("ports", nil, "")
+	flags.Duration("timeout", 5*time.Second, "")
+	flags.StringToString("labels", nil, "")
+	return flags
+}
+
+func TestFlagLoader_CollectionTypes(t *testing.T) {
+	flags := newFlagTypesSet()
+	err := flags.Parse([]string{
+		"--tags=a,b", "--tags=c", // CSV plus repeated flag
+		"--ports=80,443", "--ports=8080",
+		"--timeout=1m30s",
+		"--labels=team=core,env=prod", "--labels=tier=1",
+	})
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	cfg := &FlagTypesConfig{}
+	if err := NewFlagLoader(flags).Load(cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	expected := &FlagTypesConfig{
+		Tags:    []string{"a", "b", "c"},
+		Ports:   []int{80, 443, 8080},
+		Timeout: 90 * time.Second,
+		Labels:  map[string]string{"team": "core", "env": "prod", "tier": "1"},
+	}
+	if !reflect.DeepEqual(cfg, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, cfg)
+	}
+}
+
+func TestFlagLoader_CollectionDefaults(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	flags.StringSlice("tags", []string{"x", "y"}, "")
+	flags.StringToString("labels", map[string]string{"team": "core"}, "")
+	flags.Parse(nil)
+
+	cfg := &FlagTypesConfig{}
+	if err := NewFlagLoader(flags).Load(cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(cfg.Tags, []string{"x", "y"}) || cfg.Labels["team"] != "core" {
+		t.Errorf("Expected defaults to be loaded, got %+v", cfg)
+	}
+}
+
+func TestFlagLoader_KeyValueStringIntoMap(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	flags.String("labels", "", "")
+	flags.Parse([]string{"--labels=team=core, env=prod"})
+
+	cfg := &FlagTypesConfig{}
+	if err := NewFlagLoader(flags).Load(cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(cfg.Labels, map[string]string{"team": "core", "env": "prod"}) {
+		t.Errorf("Expected key=value string to decode into map, got %v", cfg.Labels)
+	}
+}
+
+func TestFlagLoader_DecodeErrorsNameFlagAndType(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	flags.String("timeout", "", "")
+	flags.StringSlice("ports", nil, "")
+	flags.String("labels", "", "")
+	flags.Parse([]string{"--timeout=soon", "--ports=80,abc", "--labels=oops"})
+
+	err := NewFlagLoader(flags).Load(&FlagTypesConfig{})
+	if err == nil {
+		t.Fatal("Expected decode error")
+	}
+
+	for _, want := range []string{
+		"flag --timeout (time.Duration): ",
+		"flag --ports ([]int): ports[1]: ",
+		`flag --labels (map[string]string): "oops" is not in key=value form`,
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, got: %v", want, err)
+		}
+	}
+}
+
+func TestFlagLoader_DecodeErrorUsesNamespacedFlag(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	loader := NewFlagLoader(flags).WithNamespace("mylib")
+	if err := loader.Define(func(fs *pflag.FlagSet) { fs.String("timeout", "", "") }); err != nil {
+		t.Fatalf("Define failed: %v", err)
+	}
+	flags.Parse([]string{"--mylib.timeout=soon"})
+
+	err := loader.Load(&FlagTypesConfig{})
+	if err == nil || !strings.Contains(err.Error(), "flag --mylib.timeout (time.Duration)") {
+		t.Errorf("Expected namespaced flag in error, got: %v", err)
+	}
+}
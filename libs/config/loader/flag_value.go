@@ -0,0 +1,67 @@
+package loader
+
+import (
+	"encoding"
+	"reflect"
+	"strings"
+
+	"github.com/spf13/pflag"
+)
+
+var (
+	flagValueType       = reflect.TypeOf((*pflag.Value)(nil)).Elem()
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// newCustomFlagValue returns a flag value for a custom field type t, holding the zero value of t,
+// or false if t is neither a pflag.Value (via pointer) nor text-(un)marshalable.
+func newCustomFlagValue(t reflect.Type) (pflag.Value, bool) {
+	ptr := reflect.PointerTo(t)
+	switch {
+	case ptr.Implements(flagValueType):
+		return reflect.New(t).Interface().(pflag.Value), true
+	case ptr.Implements(textUnmarshalerType) && t.Implements(textMarshalerType):
+		return &textFlagValue{ptr: reflect.New(t)}, true
+	default:
+		return nil, false
+	}
+}
+
+// textFlagValue adapts a value implementing encoding.TextMarshaler and TextUnmarshaler to pflag.Value.
+type textFlagValue struct {
+	ptr reflect.Value
+}
+
+// String implements pflag.Value.
+func (v *textFlagValue) String() string {
+	text, err := v.ptr.Elem().Interface().(encoding.TextMarshaler).MarshalText()
+	if err != nil {
+		return ""
+	}
+	return string(text)
+}
+
+// Set implements pflag.Value.
+func (v *textFlagValue) Set(s string) error {
+	return v.ptr.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+}
+
+// Type implements pflag.Value.
+func (v *textFlagValue) Type() string {
+	return strings.ToLower(v.ptr.Elem().Type().Name())
+}
+
+// flagValueHook decodes a flag's string value into a field whose pointer type implements pflag.Value,
+// using the field type's own Set method.
+func flagValueHook(from, to reflect.Type, data interface{}) (interface{}, error) {
+	if from.Kind() != reflect.String || !reflect.PointerTo(to).Implements(flagValueType) {
+		return data, nil
+	}
+
+	ptr := reflect.New(to)
+	if err := ptr.Interface().(pflag.Value).Set(data.(string)); err != nil {
+		return nil, err
+	}
+	return ptr.Elem().Interface(), nil
+}
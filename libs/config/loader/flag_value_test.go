@@ -0,0 +1,136 @@
+package loader
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+// severity is a custom int type whose pointer implements pflag.Value
+type severity int
+
+func (s *severity) String() string {
+	return [...]string{"low", "high"}[*s]
+}
+
+func (s *severity) Set(text string) error {
+	switch text {
+	case "low":
+		*s = 0
+	case "high":
+		*s = 1
+	default:
+		return fmt.Errorf("invalid severity %q", text)
+	}
+	return nil
+}
+
+func (s *severity) Type() string {
+	return "severity"
+}
+
+// hostList is a comma-list whose pointer implements pflag.Value
+type hostList []string
+
+func (h *hostList) String() string {
+	return strings.Join(*h, ",")
+}
+
+func (h *hostList) Set(text string) error {
+	*h = strings.Split(text, ",")
+	return nil
+}
+
+func (h *hostList) Type() string {
+	return "hosts"
+}
+
+type CustomFlagConfig struct {
+	Alerts struct {
+		Severity severity `mapstructure:"severity" default:"high" usage:"alert severity"`
+	} `mapstructure:"alerts"`
+	Hosts  hostList `mapstructure:"hosts"`
+	BindIP net.IP   `mapstructure:"bind_ip" default:"127.0.0.1"`
+}
+
+func TestFlagLoader_CustomValues(t *testing.T) {
+	var sev severity
+	hosts := hostList{}
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	flags.Var(&sev, "alerts.severity", "")
+	flags.Var(&hosts, "hosts", "")
+	if err := flags.Parse([]string{"--alerts.severity=high", "--hosts=a:1,b:2"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var cfg CustomFlagConfig
+	if err := NewFlagLoader(flags).Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Alerts.Severity != 1 {
+		t.Errorf("Expected severity high, got %v", cfg.Alerts.Severity)
+	}
+	if !reflect.DeepEqual(cfg.Hosts, hostList{"a:1", "b:2"}) {
+		t.Errorf("Expected hosts [a:1 b:2], got %v", cfg.Hosts)
+	}
+}
+
+func TestRegisterFlags_CustomValues(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	if err := RegisterFlags(flags, CustomFlagConfig{}); err != nil {
+		t.Fatalf("RegisterFlags failed: %v", err)
+	}
+
+	for name, typ := range map[string]string{"alerts.severity": "severity", "hosts": "hosts", "bind_ip": "ip"} {
+		flag := flags.Lookup(name)
+		if flag == nil {
+			t.Fatalf("Expected --%s to be registered", name)
+		}
+		if flag.Value.Type() != typ {
+			t.Errorf("Expected --%s of type %s, got %s", name, typ, flag.Value.Type())
+		}
+	}
+	if def := flags.Lookup("alerts.severity").DefValue; def != "high" {
+		t.Errorf("Expected default high, got %q", def)
+	}
+
+	if err := flags.Parse([]string{"--hosts=x:1", "--bind_ip=10.0.0.1"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var cfg CustomFlagConfig
+	if err := NewFlagLoader(flags).Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Alerts.Severity != 1 || !reflect.DeepEqual(cfg.Hosts, hostList{"x:1"}) || !cfg.BindIP.Equal(net.ParseIP("10.0.0.1")) {
+		t.Errorf("Expected severity high, hosts [x:1] and bind_ip 10.0.0.1, got %+v", cfg)
+	}
+}
+
+func TestRegisterFlags_InvalidCustomDefault(t *testing.T) {
+	type Config struct {
+		Severity severity `mapstructure:"severity" default:"extreme"`
+	}
+
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	err := RegisterFlags(flags, Config{})
+	if err == nil || !strings.Contains(err.Error(), "--severity") || !strings.Contains(err.Error(), "extreme") {
+		t.Errorf("Expected invalid default error naming the flag, got: %v", err)
+	}
+}
+
+func TestFlagLoader_InvalidCustomValue(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	flags.String("alerts.severity", "", "")
+	flags.Parse([]string{"--alerts.severity=extreme"})
+
+	var cfg CustomFlagConfig
+	err := NewFlagLoader(flags).Load(&cfg)
+	if err == nil || !strings.Contains(err.Error(), "--alerts.severity") {
+		t.Errorf("Expected decode error naming the flag, got: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package loader
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EnvValue lists the types supported by GetEnv.
+type EnvValue interface {
+	string | int | int64 | bool | float64 | time.Duration
+}
+
+// GetEnv reads a single typed environment variable, without a config struct.
+// key uses the same conventions as EnvLoader: "server.port" reads SERVER_PORT,
+// "app.server.port" reads APP_SERVER_PORT.
+//
+// Returns:
+//   - T: the parsed value, or def if the variable is unset or empty
+//   - error: parse error (def is returned alongside it)
+//
+// Example:
+//
+//	port, err := loader.GetEnv("server.port", 8080)
+//	timeout, err := loader.GetEnv("http.timeout", 5*time.Second) // HTTP_TIMEOUT=1m30s
+func GetEnv[T EnvValue](key string, def T) (T, error) {
+	name := strings.ToUpper(envKeyReplacer.Replace(key))
+	raw, ok := os.LookupEnv(name)
+	if !ok || raw == "" {
+		return def, nil
+	}
+
+	var (
+		parsed any
+		err    error
+	)
+	switch any(def).(type) {
+	case string:
+		parsed = raw
+	case int:
+		parsed, err = strconv.Atoi(raw)
+	case int64:
+		parsed, err = strconv.ParseInt(raw, 10, 64)
+	case bool:
+		parsed, err = strconv.ParseBool(raw)
+	case float64:
+		parsed, err = strconv.ParseFloat(raw, 64)
+	case time.Duration:
+		parsed, err = time.ParseDuration(raw)
+	}
+	if err != nil {
+		return def, fmt.Errorf("env %s: invalid %T value %q: %w", name, def, raw, err)
+	}
+
+	return parsed.(T), nil
+}
@@ -0,0 +1,70 @@
+package loader
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetEnv_Int(t *testing.T) {
+	t.Setenv("SERVER_PORT", "9090")
+
+	port, err := GetEnv("server.port", 8080)
+	if err != nil {
+		t.Fatalf("GetEnv failed: %v", err)
+	}
+	if port != 9090 {
+		t.Errorf("Expected 9090, got %d", port)
+	}
+}
+
+func TestGetEnv_Bool(t *testing.T) {
+	t.Setenv("APP_FEATURE_BETA", "true")
+
+	beta, err := GetEnv("app.feature-beta", false)
+	if err != nil {
+		t.Fatalf("GetEnv failed: %v", err)
+	}
+	if !beta {
+		t.Error("Expected true")
+	}
+}
+
+func TestGetEnv_Duration(t *testing.T) {
+	t.Setenv("HTTP_TIMEOUT", "1m30s")
+
+	timeout, err := GetEnv("http.timeout", 5*time.Second)
+	if err != nil {
+		t.Fatalf("GetEnv failed: %v", err)
+	}
+	if timeout != 90*time.Second {
+		t.Errorf("Expected 1m30s, got %v", timeout)
+	}
+}
+
+func TestGetEnv_DefaultWhenUnset(t *testing.T) {
+	port, err := GetEnv("unset.port", 8080)
+	if err != nil {
+		t.Fatalf("GetEnv failed: %v", err)
+	}
+	if port != 8080 {
+		t.Errorf("Expected default 8080, got %d", port)
+	}
+
+	host, _ := GetEnv("unset.host", "localhost")
+	if host != "localhost" {
+		t.Errorf("Expected default localhost, got %s", host)
+	}
+}
+
+func TestGetEnv_InvalidValue(t *testing.T) {
+	t.Setenv("SERVER_PORT", "not-a-number")
+
+	port, err := GetEnv("server.port", 8080)
+	if err == nil || !strings.Contains(err.Error(), `env SERVER_PORT: invalid int value "not-a-number"`) {
+		t.Errorf("Expected parse error, got %v", err)
+	}
+	if port != 8080 {
+		t.Errorf("Expected default alongside error, got %d", port)
+	}
+}
@@ -0,0 +1,87 @@
+package loader
+
+// Option configures a loader of type L when it is constructed.
+// Each option is equivalent to the loader's chainable With* method of the same name,
+// so both styles configure a loader identically.
+//
+// Example:
+//
+//	env := loader.NewEnvLoader("APP",
+//	    loader.WithEnvAutoKeys(AppConfig{}),
+//	    loader.WithEnvIgnore("APP_DEBUG_*"),
+//	)
+//	// same as loader.NewEnvLoader("APP").WithAutoKeys(AppConfig{}).WithIgnore("APP_DEBUG_*")
+type Option[L any] func(*L)
+
+// EnvOption configures an EnvLoader (see NewEnvLoader).
+type EnvOption = Option[EnvLoader]
+
+// FileOption configures a FileLoader (see NewFileLoader).
+type FileOption = Option[FileLoader]
+
+// FlagOption configures a FlagLoader (see NewFlagLoader).
+type FlagOption = Option[FlagLoader]
+
+// applyOptions applies opts to l in order and returns l.
+func applyOptions[L any](l *L, opts []Option[L]) *L {
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// WithEnvKeys is the option form of EnvLoader.WithKeys.
+func WithEnvKeys(keys ...string) EnvOption {
+	return func(e *EnvLoader) {
+		e.WithKeys(keys...)
+	}
+}
+
+// WithEnvAutoKeys is the option form of EnvLoader.WithAutoKeys.
+func WithEnvAutoKeys(example interface{}) EnvOption {
+	return func(e *EnvLoader) {
+		e.WithAutoKeys(example)
+	}
+}
+
+// WithEnvIgnore is the option form of EnvLoader.WithIgnore.
+func WithEnvIgnore(patterns ...string) EnvOption {
+	return func(e *EnvLoader) {
+		e.WithIgnore(patterns...)
+	}
+}
+
+// WithEnvStrict is the option form of EnvLoader.WithStrict.
+func WithEnvStrict() EnvOption {
+	return func(e *EnvLoader) {
+		e.WithStrict()
+	}
+}
+
+// WithFileDecoder is the option form of FileLoader.WithDecoder.
+func WithFileDecoder(d Decoder) FileOption {
+	return func(f *FileLoader) {
+		f.WithDecoder(d)
+	}
+}
+
+// WithFileIncludeDepth is the option form of FileLoader.WithIncludeDepth.
+func WithFileIncludeDepth(depth int) FileOption {
+	return func(f *FileLoader) {
+		f.WithIncludeDepth(depth)
+	}
+}
+
+// WithFileStrict is the option form of FileLoader.WithStrict.
+func WithFileStrict() FileOption {
+	return func(f *FileLoader) {
+		f.WithStrict()
+	}
+}
+
+// WithFlagNamespace is the option form of FlagLoader.WithNamespace.
+func WithFlagNamespace(namespace string) FlagOption {
+	return func(f *FlagLoader) {
+		f.WithNamespace(namespace)
+	}
+}
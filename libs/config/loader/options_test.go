@@ -0,0 +1,117 @@
+package loader
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestEnvLoader_OptionsMatchChainedSetters(t *testing.T) {
+	os.Setenv("APP_SERVER_HOST", "localhost")
+	os.Setenv("APP_SERVER_PORT", "9090")
+	os.Setenv("APP_SEVER_HOST", "typo")
+	os.Setenv("APP_DEBUG_PPROF", "1")
+	defer func() {
+		os.Unsetenv("APP_SERVER_HOST")
+		os.Unsetenv("APP_SERVER_PORT")
+		os.Unsetenv("APP_SEVER_HOST")
+		os.Unsetenv("APP_DEBUG_PPROF")
+	}()
+
+	chained := NewEnvLoader("APP").WithAutoKeys(TestConfig{}).WithIgnore("APP_DEBUG_*")
+	withOpts := NewEnvLoader("APP",
+		WithEnvAutoKeys(TestConfig{}),
+		WithEnvIgnore("APP_DEBUG_*"),
+	)
+
+	if !reflect.DeepEqual(chained, withOpts) {
+		t.Fatalf("Expected option-built loader %+v to equal chained loader %+v", withOpts, chained)
+	}
+
+	var chainedCfg, optsCfg TestConfig
+	if err := chained.Load(&chainedCfg); err != nil {
+		t.Fatalf("Chained load failed: %v", err)
+	}
+	if err := withOpts.Load(&optsCfg); err != nil {
+		t.Fatalf("Options load failed: %v", err)
+	}
+	if !reflect.DeepEqual(chainedCfg, optsCfg) {
+		t.Errorf("Expected config %+v, got %+v", chainedCfg, optsCfg)
+	}
+	if optsCfg.Server.Port != 9090 {
+		t.Errorf("Expected server.port=9090, got %d", optsCfg.Server.Port)
+	}
+	if !reflect.DeepEqual(chained.Warnings(), withOpts.Warnings()) || len(withOpts.Warnings()) != 1 {
+		t.Errorf("Expected identical single warning, got %v and %v", chained.Warnings(), withOpts.Warnings())
+	}
+}
+
+func TestEnvLoader_KeysOptionMatchesChainedSetter(t *testing.T) {
+	chained := NewEnvLoader("APP").WithKeys("server.host", "server.port")
+	withOpts := NewEnvLoader("APP", WithEnvKeys("server.host", "server.port"))
+	if !reflect.DeepEqual(chained, withOpts) {
+		t.Errorf("Expected option-built loader %+v to equal chained loader %+v", withOpts, chained)
+	}
+}
+
+func TestFileLoader_OptionsMatchChainedSetters(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "test.json")
+	if err := os.WriteFile(configPath, []byte(`{"server": {"host": "localhost", "port": 8080}}`), 0644); err != nil {
+		t.Fatalf("Failed to create test file: %v", err)
+	}
+
+	chained := NewFileLoader(configPath, "json").WithDecoder(strictJSONDecoder{})
+	withOpts := NewFileLoader(configPath, "json", WithFileDecoder(strictJSONDecoder{}))
+
+	if !reflect.DeepEqual(chained, withOpts) {
+		t.Fatalf("Expected option-built loader %+v to equal chained loader %+v", withOpts, chained)
+	}
+
+	var chainedCfg, optsCfg TestConfig
+	if err := chained.Load(&chainedCfg); err != nil {
+		t.Fatalf("Chained load failed: %v", err)
+	}
+	if err := withOpts.Load(&optsCfg); err != nil {
+		t.Fatalf("Options load failed: %v", err)
+	}
+	if !reflect.DeepEqual(chainedCfg, optsCfg) {
+		t.Errorf("Expected config %+v, got %+v", chainedCfg, optsCfg)
+	}
+}
+
+func TestFlagLoader_OptionsMatchChainedSetters(t *testing.T) {
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String("app.server.host", "localhost", "Server host")
+	flags.Int("app.server.port", 8080, "Server port")
+	flags.Parse([]string{"--app.server.port=9090"})
+
+	chained := NewFlagLoader(flags).WithNamespace("app")
+	withOpts := NewFlagLoader(flags, WithFlagNamespace("app"))
+
+	if !reflect.DeepEqual(chained, withOpts) {
+		t.Fatalf("Expected option-built loader %+v to equal chained loader %+v", withOpts, chained)
+	}
+
+	var chainedCfg, optsCfg TestConfig
+	if err := chained.Load(&chainedCfg); err != nil {
+		t.Fatalf("Chained load failed: %v", err)
+	}
+	if err := withOpts.Load(&optsCfg); err != nil {
+		t.Fatalf("Options load failed: %v", err)
+	}
+	if !reflect.DeepEqual(chainedCfg, optsCfg) {
+		t.Errorf("Expected config %+v, got %+v", chainedCfg, optsCfg)
+	}
+}
+
+func TestNewLoaders_NoOptions(t *testing.T) {
+	if !reflect.DeepEqual(NewEnvLoader("APP"), &EnvLoader{prefix: "APP"}) {
+		t.Error("Expected NewEnvLoader without options to be unconfigured")
+	}
+	if !reflect.DeepEqual(NewFileLoader("c.yaml", "yaml"), &FileLoader{filePath: "c.yaml", fileType: "yaml"}) {
+		t.Error("Expected NewFileLoader without options to be unconfigured")
+	}
+}
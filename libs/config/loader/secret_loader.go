@@ -0,0 +1,128 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ErrSecretNotFound is returned (wrapped) by a SecretProvider when a secret does not exist.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// SecretProvider fetches secret values by name from a secret store (Vault, a cloud secret manager, env, ...).
+// SecretLoader calls GetSecret on every Load, so a rotated secret is picked up by the next Load
+// without a restart.
+type SecretProvider interface {
+	// GetSecret returns the current value of the named secret.
+	// Return an error wrapping ErrSecretNotFound if it does not exist.
+	GetSecret(name string) (string, error)
+}
+
+// SecretProviderFunc adapts a function to SecretProvider.
+type SecretProviderFunc func(name string) (string, error)
+
+// GetSecret implements SecretProvider.
+func (f SecretProviderFunc) GetSecret(name string) (string, error) {
+	return f(name)
+}
+
+// EnvSecretProvider reads secrets from environment variables.
+// Values are read on every GetSecret, so updating the variable rotates the secret.
+type EnvSecretProvider struct {
+	prefix string
+}
+
+// NewEnvSecretProvider creates an EnvSecretProvider.
+// Secret "db_password" is read from DB_PASSWORD, or from APP_DB_PASSWORD with prefix "APP".
+func NewEnvSecretProvider(prefix string) *EnvSecretProvider {
+	return &EnvSecretProvider{prefix: prefix}
+}
+
+// GetSecret implements SecretProvider.
+func (e *EnvSecretProvider) GetSecret(name string) (string, error) {
+	envName := strings.ToUpper(envKeyReplacer.Replace(name))
+	if e.prefix != "" {
+		envName = strings.ToUpper(e.prefix) + "_" + envName
+	}
+
+	value, ok := os.LookupEnv(envName)
+	if !ok {
+		return "", fmt.Errorf("%w: environment variable %s is not set", ErrSecretNotFound, envName)
+	}
+	return value, nil
+}
+
+// secretMapping binds a config key to a secret name.
+type secretMapping struct {
+	key  string
+	name string
+}
+
+// SecretLoader fills config keys with values fetched from a SecretProvider.
+// Secrets are fetched on every Load, so reloading the config picks up rotated secrets.
+type SecretLoader struct {
+	provider SecretProvider
+	secrets  []secretMapping
+}
+
+// NewSecretLoader creates a SecretLoader fetching from provider.
+// Map config keys to secrets with WithSecret.
+//
+// Example:
+//
+//	secrets := loader.NewSecretLoader(loader.NewEnvSecretProvider("APP")).
+//	    WithSecret("database.password", "db_password") // APP_DB_PASSWORD
+//
+//	cfg := config.New[AppConfig](fileLoader, secrets)
+//	// on rotation
+//	cfg.Load() // Database.Password holds the new value
+func NewSecretLoader(provider SecretProvider) *SecretLoader {
+	return &SecretLoader{provider: provider}
+}
+
+// WithSecret maps the config key (dotted path, e.g. "database.password") to the named secret.
+// Returns *SecretLoader to support method chaining.
+func (s *SecretLoader) WithSecret(key, name string) *SecretLoader {
+	s.secrets = append(s.secrets, secretMapping{key: key, name: name})
+	return s
+}
+
+// Load fetches every mapped secret and unmarshals them into dst.
+// Keys without a mapped secret are left untouched.
+//
+// Returns error if:
+//   - A secret cannot be fetched (errors.Is(err, ErrSecretNotFound) if it does not exist)
+//   - A value cannot be decoded into its field
+func (s *SecretLoader) Load(dst interface{}) error {
+	v := viper.New()
+	for _, secret := range s.secrets {
+		value, err := s.provider.GetSecret(secret.name)
+		if err != nil {
+			return fmt.Errorf("failed to fetch secret %s for %s: %w", secret.name, secret.key, err)
+		}
+		v.Set(secret.key, value)
+	}
+
+	if err := v.Unmarshal(dst); err != nil {
+		return fmt.Errorf("failed to unmarshal secrets: %w", err)
+	}
+	return nil
+}
+
+// String describes the loader in error messages.
+// Example: "secrets(database.password, redis.password)"
+func (s *SecretLoader) String() string {
+	keys := make([]string, len(s.secrets))
+	for i, secret := range s.secrets {
+		keys[i] = secret.key
+	}
+	return fmt.Sprintf("secrets(%s)", strings.Join(keys, ", "))
+}
+
+// Name implements core.NamedLoader.
+func (s *SecretLoader) Name() string {
+	return "SecretLoader"
+}
@@ -0,0 +1,127 @@
+package loader
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
+)
+
+type SecretConfig struct {
+	Database struct {
+		Host     string `mapstructure:"host"`
+		Password string `mapstructure:"password" secret:"true"`
+	} `mapstructure:"database"`
+}
+
+// mapSecretProvider is an in-memory secret store whose values can be rotated
+type mapSecretProvider struct {
+	mu      sync.Mutex
+	secrets map[string]string
+}
+
+func (m *mapSecretProvider) GetSecret(name string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value, ok := m.secrets[name]
+	if !ok {
+		return "", ErrSecretNotFound
+	}
+	return value, nil
+}
+
+func (m *mapSecretProvider) rotate(name, value string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.secrets[name] = value
+}
+
+// typedSecretLoader adapts SecretLoader to core.Loader[*SecretConfig]
+type typedSecretLoader struct {
+	*SecretLoader
+}
+
+func (l typedSecretLoader) Load(dst *SecretConfig) error {
+	return l.SecretLoader.Load(dst)
+}
+
+// hostLoader sets the non-secret part of SecretConfig
+type hostLoader struct{}
+
+func (hostLoader) Load(dst *SecretConfig) error {
+	dst.Database.Host = "db.internal"
+	return nil
+}
+
+func TestSecretLoader_ReloadPicksUpRotation(t *testing.T) {
+	provider := &mapSecretProvider{secrets: map[string]string{"db_password": "v1"}}
+	secrets := NewSecretLoader(provider).WithSecret("database.password", "db_password")
+	cfg := core.New[SecretConfig](hostLoader{}, typedSecretLoader{secrets})
+
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got := cfg.Get().Database.Password; got != "v1" {
+		t.Fatalf("Expected password v1, got %q", got)
+	}
+
+	provider.rotate("db_password", "v2")
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if got := cfg.Get(); got.Database.Password != "v2" || got.Database.Host != "db.internal" {
+		t.Errorf("Expected rotated password v2 and host kept, got %+v", got)
+	}
+}
+
+func TestSecretLoader_MissingSecret(t *testing.T) {
+	provider := &mapSecretProvider{secrets: map[string]string{}}
+	secrets := NewSecretLoader(provider).WithSecret("database.password", "db_password")
+
+	err := secrets.Load(&SecretConfig{})
+	if !errors.Is(err, ErrSecretNotFound) {
+		t.Fatalf("Expected ErrSecretNotFound, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "db_password for database.password") {
+		t.Errorf("Expected error to name the secret and key, got: %v", err)
+	}
+}
+
+func TestEnvSecretProvider_Rotation(t *testing.T) {
+	os.Setenv("APP_DB_PASSWORD", "v1")
+	defer os.Unsetenv("APP_DB_PASSWORD")
+
+	secrets := NewSecretLoader(NewEnvSecretProvider("APP")).WithSecret("database.password", "db_password")
+	cfg := core.New[SecretConfig](typedSecretLoader{secrets})
+
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	os.Setenv("APP_DB_PASSWORD", "v2")
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Reload failed: %v", err)
+	}
+	if got := cfg.Get().Database.Password; got != "v2" {
+		t.Errorf("Expected rotated password v2, got %q", got)
+	}
+
+	if _, err := NewEnvSecretProvider("").GetSecret("missing.secret"); !errors.Is(err, ErrSecretNotFound) || !strings.Contains(err.Error(), "MISSING_SECRET") {
+		t.Errorf("Expected ErrSecretNotFound naming MISSING_SECRET, got %v", err)
+	}
+}
+
+func TestSecretLoader_StringAndFunc(t *testing.T) {
+	provider := SecretProviderFunc(func(name string) (string, error) { return "s3cr3t-" + name, nil })
+	secrets := NewSecretLoader(provider).WithSecret("database.password", "db")
+
+	var cfg SecretConfig
+	if err := secrets.Load(&cfg); err != nil || cfg.Database.Password != "s3cr3t-db" {
+		t.Errorf("Expected s3cr3t-db, got %q (err=%v)", cfg.Database.Password, err)
+	}
+	if got := secrets.String(); got != "secrets(database.password)" {
+		t.Errorf("Expected secrets(database.password), got %s", got)
+	}
+}
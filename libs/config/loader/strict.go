@@ -0,0 +1,45 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-viper/mapstructure/v2"
+	"github.com/spf13/viper"
+)
+
+// ErrUnknownKeys is returned by loaders in strict mode (see FileLoader.WithStrict and
+// EnvLoader.WithStrict) when the source has keys that match no field of the target struct.
+var ErrUnknownKeys = errors.New("unknown config keys")
+
+// unmarshal decodes v into dst and returns the keys that match no field, sorted.
+// In strict mode, such keys fail the load with an error wrapping ErrUnknownKeys
+// and listing them, e.g. "serevr.port".
+func unmarshal(v *viper.Viper, dst interface{}, strict bool, opts ...viper.DecoderConfigOption) ([]string, error) {
+	var metadata mapstructure.Metadata
+	opts = append(opts, func(c *mapstructure.DecoderConfig) {
+		c.Metadata = &metadata
+	})
+
+	if err := v.Unmarshal(dst, opts...); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	sort.Strings(metadata.Unused)
+	if strict {
+		return metadata.Unused, unknownKeysError(metadata.Unused)
+	}
+	return metadata.Unused, nil
+}
+
+// unknownKeysError returns an error wrapping ErrUnknownKeys listing keys (sorted), or nil if there are none.
+func unknownKeysError(keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	sorted := append([]string(nil), keys...)
+	sort.Strings(sorted)
+	return fmt.Errorf("%w: %s", ErrUnknownKeys, strings.Join(sorted, ", "))
+}
@@ -0,0 +1,88 @@
+package loader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type StrictConfig struct {
+	Server struct {
+		Host string `mapstructure:"host"`
+		Port int    `mapstructure:"port"`
+	} `mapstructure:"server"`
+	Extras map[string]any `mapstructure:"extras"`
+}
+
+func TestFileLoader_Strict(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", `
+server:
+  host: localhost
+  prot: 9090
+serevr:
+  port: 8080
+extras:
+  anything: goes
+`)
+
+	// Lenient (default): unknown keys are ignored
+	var lenient StrictConfig
+	if err := NewFileLoader(path, "yaml").Load(&lenient); err != nil {
+		t.Fatalf("Expected lenient load to succeed, got: %v", err)
+	}
+	if lenient.Server.Host != "localhost" {
+		t.Errorf("Expected host localhost, got %q", lenient.Server.Host)
+	}
+
+	var strict StrictConfig
+	err := NewFileLoader(path, "yaml").WithStrict().Load(&strict)
+	if !errors.Is(err, ErrUnknownKeys) {
+		t.Fatalf("Expected ErrUnknownKeys, got: %v", err)
+	}
+	// Keys inside maps are never unknown
+	if !strings.Contains(err.Error(), "serevr, server.prot") || strings.Contains(err.Error(), "anything") {
+		t.Errorf("Expected the error to list serevr and server.prot only, got: %v", err)
+	}
+}
+
+func TestFileLoader_StrictWithKnownKeys(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", "server:\n  host: localhost\n  port: 8080\n")
+
+	var cfg StrictConfig
+	if err := NewFileLoader(path, "yaml", WithFileStrict()).Load(&cfg); err != nil {
+		t.Fatalf("Expected strict load of known keys to succeed, got: %v", err)
+	}
+	if cfg.Server.Port != 8080 {
+		t.Errorf("Expected port 8080, got %d", cfg.Server.Port)
+	}
+}
+
+func TestEnvLoader_Strict(t *testing.T) {
+	t.Setenv("APP_SERVER_HOST", "localhost")
+	t.Setenv("APP_SERVR_PORT", "9090")
+
+	var lenient StrictConfig
+	if err := NewEnvLoader("APP").WithAutoKeys(StrictConfig{}).Load(&lenient); err != nil {
+		t.Fatalf("Expected lenient load to succeed, got: %v", err)
+	}
+
+	var strict StrictConfig
+	err := NewEnvLoader("APP", WithEnvAutoKeys(StrictConfig{}), WithEnvStrict()).Load(&strict)
+	if !errors.Is(err, ErrUnknownKeys) {
+		t.Fatalf("Expected ErrUnknownKeys, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "APP_SERVR_PORT") || !strings.Contains(err.Error(), "did you mean server.port?") {
+		t.Errorf("Expected the error to name the variable with a suggestion, got: %v", err)
+	}
+}
+
+func TestEnvLoader_StrictUnknownBoundKey(t *testing.T) {
+	t.Setenv("APP_SERVER_HOST", "localhost")
+	t.Setenv("APP_SERVER_TIMEOUT", "5s")
+
+	var cfg StrictConfig
+	err := NewEnvLoader("APP").WithKeys("server.host", "server.timeout").WithStrict().Load(&cfg)
+	if !errors.Is(err, ErrUnknownKeys) || !strings.Contains(err.Error(), "server.timeout") {
+		t.Errorf("Expected ErrUnknownKeys listing server.timeout, got: %v", err)
+	}
+}
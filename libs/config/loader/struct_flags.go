@@ -0,0 +1,299 @@
+package loader
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+var (
+	durationType = reflect.TypeOf(time.Duration(0))
+
+	// errUnsupportedFlagType is returned by RegisterFlags for a field type without a matching pflag type.
+	errUnsupportedFlagType = errors.New("unsupported type")
+)
+
+// RegisterFlags defines a pflag for every leaf key of example, so FlagLoader can load the struct
+// without declaring each flag by hand. It is the inverse of ExtractKeysFromType.
+//
+// Struct tags:
+//   - mapstructure: flag name segment (default: lowercased field name, "-" skips the field)
+//   - default: default value, in the same syntax as on the command line
+//   - usage: help text
+//
+// Supported leaf types: string, bool, ints, uints, floats, time.Duration,
+// []string, []int, []bool, []float64, []time.Duration and map[string]string,
+// plus custom types whose pointer implements pflag.Value, or that implement
+// encoding.TextMarshaler and encoding.TextUnmarshaler (e.g. a log level).
+// Nothing is registered when a field has an unsupported type, an invalid default,
+// or a name that is already defined in fs.
+//
+// Example:
+//
+//	type Config struct {
+//	    Server struct {
+//	        Host string `mapstructure:"host" default:"localhost" usage:"Server host"`
+//	        Port int    `mapstructure:"port" default:"8080" usage:"Server port"`
+//	    } `mapstructure:"server"`
+//	}
+//
+//	if err := loader.RegisterFlags(pflag.CommandLine, Config{}); err != nil {
+//	    log.Fatal(err)
+//	}
+//	pflag.Parse() // --server.host, --server.port
+//	flagLoader := loader.NewFlagLoader(nil)
+func RegisterFlags(fs *pflag.FlagSet, example any) error {
+	scratch := pflag.NewFlagSet(fs.Name(), pflag.ContinueOnError)
+	if err := registerStructFlags(scratch, reflect.TypeOf(example), ""); err != nil {
+		return err
+	}
+
+	var conflicts []string
+	scratch.VisitAll(func(flag *pflag.Flag) {
+		if existing := fs.Lookup(flag.Name); existing != nil {
+			conflicts = append(conflicts, fmt.Sprintf("--%s already defined by %s", flag.Name, flagOwner(existing)))
+		}
+	})
+	if len(conflicts) > 0 {
+		return fmt.Errorf("flag redefined: %s", strings.Join(conflicts, "; "))
+	}
+
+	fs.AddFlagSet(scratch)
+	return nil
+}
+
+// registerStructFlags walks t like extractStructKeys and defines a flag for each leaf key.
+func registerStructFlags(fs *pflag.FlagSet, t reflect.Type, prefix string) error {
+	if t == nil {
+		return nil
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(field.Tag.Get("mapstructure"), ",")
+		if tag == "" {
+			tag = strings.ToLower(field.Name)
+		}
+		if tag == "-" {
+			continue
+		}
+
+		name := tag
+		if prefix != "" {
+			name = prefix + "." + tag
+		}
+
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if fieldType.Kind() == reflect.Struct && !isCustomFlagType(fieldType) {
+			if err := registerStructFlags(fs, fieldType, name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := defineFlag(fs, name, fieldType, field.Tag.Get("default"), field.Tag.Get("usage")); err != nil {
+			return fmt.Errorf("flag --%s (%s): %w", name, fieldType, err)
+		}
+	}
+	return nil
+}
+
+// isCustomFlagType reports whether t is set through its own flag value (see newCustomFlagValue).
+func isCustomFlagType(t reflect.Type) bool {
+	_, ok := newCustomFlagValue(t)
+	return ok
+}
+
+// defineFlag defines a flag of the pflag type matching t, parsing def as its default.
+func defineFlag(fs *pflag.FlagSet, name string, t reflect.Type, def, usage string) error {
+	if value, ok := newCustomFlagValue(t); ok {
+		if def != "" {
+			if err := value.Set(def); err != nil {
+				return fmt.Errorf("invalid default %q: %w", def, err)
+			}
+		}
+		fs.Var(value, name, usage)
+		return nil
+	}
+
+	if t == durationType {
+		d, err := parseDefault(def, time.ParseDuration)
+		if err != nil {
+			return err
+		}
+		fs.Duration(name, d, usage)
+		return nil
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		fs.String(name, def, usage)
+	case reflect.Bool:
+		b, err := parseDefault(def, strconv.ParseBool)
+		if err != nil {
+			return err
+		}
+		fs.Bool(name, b, usage)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := parseDefault(def, func(s string) (int64, error) { return strconv.ParseInt(s, 0, t.Bits()) })
+		if err != nil {
+			return err
+		}
+		switch t.Kind() {
+		case reflect.Int8:
+			fs.Int8(name, int8(n), usage)
+		case reflect.Int16:
+			fs.Int16(name, int16(n), usage)
+		case reflect.Int32:
+			fs.Int32(name, int32(n), usage)
+		case reflect.Int64:
+			fs.Int64(name, n, usage)
+		default:
+			fs.Int(name, int(n), usage)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := parseDefault(def, func(s string) (uint64, error) { return strconv.ParseUint(s, 0, t.Bits()) })
+		if err != nil {
+			return err
+		}
+		switch t.Kind() {
+		case reflect.Uint8:
+			fs.Uint8(name, uint8(n), usage)
+		case reflect.Uint16:
+			fs.Uint16(name, uint16(n), usage)
+		case reflect.Uint32:
+			fs.Uint32(name, uint32(n), usage)
+		case reflect.Uint64:
+			fs.Uint64(name, n, usage)
+		default:
+			fs.Uint(name, uint(n), usage)
+		}
+	case reflect.Float32:
+		f, err := parseDefault(def, func(s string) (float64, error) { return strconv.ParseFloat(s, 32) })
+		if err != nil {
+			return err
+		}
+		fs.Float32(name, float32(f), usage)
+	case reflect.Float64:
+		f, err := parseDefault(def, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+		if err != nil {
+			return err
+		}
+		fs.Float64(name, f, usage)
+	case reflect.Slice:
+		return defineSliceFlag(fs, name, t.Elem(), def, usage)
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String || t.Elem().Kind() != reflect.String {
+			return errUnsupportedFlagType
+		}
+		m, err := parseDefault(def, parseKeyValues)
+		if err != nil {
+			return err
+		}
+		fs.StringToString(name, m, usage)
+	default:
+		return errUnsupportedFlagType
+	}
+	return nil
+}
+
+// defineSliceFlag defines a slice flag; def is a comma-separated list.
+func defineSliceFlag(fs *pflag.FlagSet, name string, elem reflect.Type, def, usage string) error {
+	if elem == durationType {
+		d, err := parseList(def, time.ParseDuration)
+		if err != nil {
+			return err
+		}
+		fs.DurationSlice(name, d, usage)
+		return nil
+	}
+
+	switch elem.Kind() {
+	case reflect.String:
+		s, _ := parseList(def, func(s string) (string, error) { return s, nil })
+		fs.StringSlice(name, s, usage)
+	case reflect.Int:
+		n, err := parseList(def, strconv.Atoi)
+		if err != nil {
+			return err
+		}
+		fs.IntSlice(name, n, usage)
+	case reflect.Bool:
+		b, err := parseList(def, strconv.ParseBool)
+		if err != nil {
+			return err
+		}
+		fs.BoolSlice(name, b, usage)
+	case reflect.Float64:
+		f, err := parseList(def, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+		if err != nil {
+			return err
+		}
+		fs.Float64Slice(name, f, usage)
+	default:
+		return errUnsupportedFlagType
+	}
+	return nil
+}
+
+// parseDefault parses a default tag, returning the zero value for an empty tag.
+func parseDefault[T any](def string, parse func(string) (T, error)) (T, error) {
+	var zero T
+	if def == "" {
+		return zero, nil
+	}
+	v, err := parse(def)
+	if err != nil {
+		return zero, fmt.Errorf("invalid default %q: %w", def, err)
+	}
+	return v, nil
+}
+
+// parseList parses a comma-separated default tag; an empty tag is an empty list.
+func parseList[T any](def string, parse func(string) (T, error)) ([]T, error) {
+	if def == "" {
+		return nil, nil
+	}
+	parts := strings.Split(def, ",")
+	out := make([]T, 0, len(parts))
+	for _, part := range parts {
+		v, err := parse(strings.TrimSpace(part))
+		if err != nil {
+			return nil, fmt.Errorf("invalid default %q: %w", def, err)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+// parseKeyValues parses "k=v,k2=v2", the StringToString flag syntax.
+func parseKeyValues(def string) (map[string]string, error) {
+	out := make(map[string]string)
+	for _, pair := range strings.Split(def, ",") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not in key=value form", pair)
+		}
+		out[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	return out, nil
+}
@@ -0,0 +1,126 @@
+package loader
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+type RegisterFlagsConfig struct {
+	Server struct {
+		Host string `mapstructure:"host" default:"localhost" usage:"Server host"`
+		Port int    `mapstructure:"port" default:"8080" usage:"Server port"`
+	} `mapstructure:"server"`
+	Timeout time.Duration     `mapstructure:"timeout" default:"5s"`
+	Tags    []string          `mapstructure:"tags" default:"a,b"`
+	Labels  map[string]string `mapstructure:"labels"`
+	Debug   bool
+	Ignored string `mapstructure:"-"`
+}
+
+func TestRegisterFlags_NestedConfig(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	if err := RegisterFlags(flags, RegisterFlagsConfig{}); err != nil {
+		t.Fatalf("RegisterFlags failed: %v", err)
+	}
+
+	tests := []struct {
+		name, typ, def, usage string
+	}{
+		{"server.host", "string", "localhost", "Server host"},
+		{"server.port", "int", "8080", "Server port"},
+		{"timeout", "duration", "5s", ""},
+		{"tags", "stringSlice", "[a,b]", ""},
+		{"labels", "stringToString", "[]", ""},
+		{"debug", "bool", "false", ""},
+	}
+	for _, tt := range tests {
+		flag := flags.Lookup(tt.name)
+		if flag == nil {
+			t.Errorf("Expected flag --%s to be defined", tt.name)
+			continue
+		}
+		if flag.Value.Type() != tt.typ || flag.DefValue != tt.def || flag.Usage != tt.usage {
+			t.Errorf("--%s: expected %s %q %q, got %s %q %q",
+				tt.name, tt.typ, tt.def, tt.usage, flag.Value.Type(), flag.DefValue, flag.Usage)
+		}
+	}
+	if flags.Lookup("ignored") != nil {
+		t.Error("Expected field tagged \"-\" to be skipped")
+	}
+}
+
+func TestRegisterFlags_FeedsFlagLoader(t *testing.T) {
+	flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+	if err := RegisterFlags(flags, &RegisterFlagsConfig{}); err != nil {
+		t.Fatalf("RegisterFlags failed: %v", err)
+	}
+	if err := flags.Parse([]string{"--server.port=9090", "--tags=x"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	cfg := &RegisterFlagsConfig{}
+	if err := NewFlagLoader(flags).Load(cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if cfg.Server.Host != "localhost" || cfg.Server.Port != 9090 {
+		t.Errorf("Expected localhost:9090, got %s:%d", cfg.Server.Host, cfg.Server.Port)
+	}
+	if cfg.Timeout != 5*time.Second {
+		t.Errorf("Expected default timeout 5s, got %v", cfg.Timeout)
+	}
+	if !reflect.DeepEqual(cfg.Tags, []string{"x"}) {
+		t.Errorf("Expected command line to replace default tags, got %v", cfg.Tags)
+	}
+}
+
+func TestRegisterFlags_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		example any
+		want    string
+	}{
+		{
+			name: "invalid default",
+			example: struct {
+				Port int `mapstructure:"port" default:"http"`
+			}{},
+			want: `flag --port (int): invalid default "http"`,
+		},
+		{
+			name: "unsupported type",
+			example: struct {
+				Ch chan int `mapstructure:"ch"`
+			}{},
+			want: "flag --ch (chan int): unsupported type",
+		},
+		{
+			name: "already defined",
+			example: struct {
+				Host string `mapstructure:"host"`
+			}{},
+			want: "--host already defined by another flag definition",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			flags := pflag.NewFlagSet("app", pflag.ContinueOnError)
+			flags.String("host", "", "")
+
+			err := RegisterFlags(flags, tt.example)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("Expected error containing %q, got: %v", tt.want, err)
+			}
+			defined := 0
+			flags.VisitAll(func(*pflag.Flag) { defined++ })
+			if defined != 1 {
+				t.Errorf("Expected nothing registered besides --host, got %d flags", defined)
+			}
+		})
+	}
+}
@@ -20,16 +20,26 @@ import (
 //	keys := extractStructKeys(reflect.TypeOf(Config{}), "")
 //	// Returns: ["server.host", "server.port"]
 func extractStructKeys(t reflect.Type, prefix string) []string {
+	var keys []string
+	walkStructKeys(t, prefix, func(key string, _ reflect.StructField) {
+		keys = append(keys, key)
+	})
+	return keys
+}
+
+// walkStructKeys calls fn with the dotted key and field of every leaf of t, in field order.
+func walkStructKeys(t reflect.Type, prefix string, fn func(key string, field reflect.StructField)) {
+	if t == nil {
+		return
+	}
 	if t.Kind() == reflect.Ptr {
 		t = t.Elem()
 	}
 
 	if t.Kind() != reflect.Struct {
-		return nil
+		return
 	}
 
-	var keys []string
-
 	for i := 0; i < t.NumField(); i++ {
 		field := t.Field(i)
 
@@ -59,14 +69,11 @@ func extractStructKeys(t reflect.Type, prefix string) []string {
 		}
 
 		if fieldType.Kind() == reflect.Struct {
-			nestedKeys := extractStructKeys(fieldType, fullKey)
-			keys = append(keys, nestedKeys...)
+			walkStructKeys(fieldType, fullKey, fn)
 		} else {
-			keys = append(keys, fullKey)
+			fn(fullKey, field)
 		}
 	}
-
-	return keys
 }
 
 // ExtractKeysFromType extracts all config keys from a struct type.
@@ -75,3 +82,26 @@ func ExtractKeysFromType(example interface{}) []string {
 	t := reflect.TypeOf(example)
 	return extractStructKeys(t, "")
 }
+
+// ExtractEnvNamesFromType returns the env var bound to each key of a struct type by an `env` tag.
+// Keys without the tag are left out: they use the prefix and dot-to-underscore convention.
+//
+// Example:
+//
+//	type Config struct {
+//	    Database struct {
+//	        URL string `mapstructure:"url" env:"DATABASE_URL"`
+//	    } `mapstructure:"database"`
+//	}
+//
+//	names := loader.ExtractEnvNamesFromType(Config{})
+//	// Returns: {"database.url": "DATABASE_URL"}
+func ExtractEnvNamesFromType(example interface{}) map[string]string {
+	names := make(map[string]string)
+	walkStructKeys(reflect.TypeOf(example), "", func(key string, field reflect.StructField) {
+		if name := field.Tag.Get("env"); name != "" && name != "-" {
+			names[key] = name
+		}
+	})
+	return names
+}
@@ -0,0 +1,56 @@
+package loader
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
+)
+
+type ChildProcessConfig struct {
+	Server struct {
+		Host    string        `mapstructure:"host"`
+		Port    int           `mapstructure:"port"`
+		Timeout time.Duration `mapstructure:"timeout"`
+		TLS     bool          `mapstructure:"tls"`
+	} `mapstructure:"server"`
+	Tags      []string `mapstructure:"tags"`
+	Databases map[string]struct {
+		DSN      string `mapstructure:"dsn"`
+		MaxConns int    `mapstructure:"max_conns"`
+	} `mapstructure:"databases"`
+	Workers *int `mapstructure:"workers"`
+}
+
+func TestToEnv_RoundTripsThroughEnvLoader(t *testing.T) {
+	var parent ChildProcessConfig
+	parent.Server.Host = "localhost"
+	parent.Server.Port = 8080
+	parent.Server.Timeout = 90 * time.Second
+	parent.Server.TLS = true
+	parent.Tags = []string{"blue", "canary"}
+	parent.Databases = map[string]struct {
+		DSN      string `mapstructure:"dsn"`
+		MaxConns int    `mapstructure:"max_conns"`
+	}{
+		"primary": {DSN: "postgres://primary", MaxConns: 10},
+	}
+	parent.Workers = core.Ptr(4)
+
+	env := core.ToEnv(&parent, "app")
+	for _, kv := range env {
+		name, value, _ := strings.Cut(kv, "=")
+		t.Setenv(name, value)
+	}
+
+	var child ChildProcessConfig
+	if err := NewEnvLoader("APP").WithAutoKeys(ChildProcessConfig{}).Load(&child); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	if !reflect.DeepEqual(child, parent) {
+		t.Errorf("Expected child config %+v, got %+v (env %v)", parent, child, env)
+	}
+}
@@ -0,0 +1,73 @@
+package loader
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/core"
+)
+
+// strictFileLoader adapts FileLoader to core.Loader[*StrictConfig], keeping UnusedKeys
+type strictFileLoader struct {
+	*FileLoader
+}
+
+func (l strictFileLoader) Load(dst *StrictConfig) error {
+	return l.FileLoader.Load(dst)
+}
+
+func TestFileLoader_UnusedKeys(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", `
+server:
+  host: localhost
+  prot: 9090
+legacy_timeout: 30
+extras:
+  anything: goes
+`)
+
+	fileLoader := NewFileLoader(path, "yaml")
+	var cfg StrictConfig
+	if err := fileLoader.Load(&cfg); err != nil {
+		t.Fatalf("Expected load to succeed, got: %v", err)
+	}
+
+	// Keys inside maps are never unused
+	want := []string{"legacy_timeout", "server.prot"}
+	if got := fileLoader.UnusedKeys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected unused keys %v, got %v", want, got)
+	}
+}
+
+func TestConfig_UnusedKeys(t *testing.T) {
+	base := writeConfigFile(t, "config.yaml", "server:\n  host: localhost\nlegacy_timeout: 30\n")
+	override := writeConfigFile(t, "config.local.yaml", "server:\n  port: 9090\nlegacy_timeout: 60\ndebug: true\n")
+
+	cfg := core.New[StrictConfig](
+		strictFileLoader{NewFileLoader(base, "yaml")},
+		strictFileLoader{NewFileLoader(override, "yaml")},
+	)
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Expected unused keys not to fail Load, got: %v", err)
+	}
+	if got := cfg.Get().Server; got.Host != "localhost" || got.Port != 9090 {
+		t.Errorf("Expected localhost:9090, got %s:%d", got.Host, got.Port)
+	}
+
+	want := []string{"debug", "legacy_timeout"}
+	if got := cfg.UnusedKeys(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected unused keys %v, got %v", want, got)
+	}
+}
+
+func TestConfig_UnusedKeysNoneUnused(t *testing.T) {
+	path := writeConfigFile(t, "config.yaml", "server:\n  host: localhost\n  port: 8080\n")
+
+	cfg := core.New[StrictConfig](strictFileLoader{NewFileLoader(path, "yaml")})
+	if err := cfg.Load(); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got := cfg.UnusedKeys(); got != nil {
+		t.Errorf("Expected no unused keys, got %v", got)
+	}
+}
@@ -0,0 +1,66 @@
+// Package watch provides helpers for reacting to config source changes.
+package watch
+
+import (
+	"sync"
+	"time"
+)
+
+// Debouncer coalesces bursts of triggers into a single call.
+// Create with Debounce.
+type Debouncer struct {
+	delay time.Duration
+	fn    func()
+
+	mu      sync.Mutex
+	timer   *time.Timer
+	stopped bool
+}
+
+// Debounce returns a Debouncer that calls fn once d has passed without another Trigger.
+// Each Trigger restarts the quiet window, so only the last change in a burst causes a call.
+// fn runs on its own goroutine; calls never overlap as long as fn returns within d.
+//
+// Example:
+//
+//	reload := watch.Debounce(200*time.Millisecond, func() {
+//	    if err := cfg.Load(); err != nil {
+//	        log.Printf("reload failed: %v", err)
+//	    }
+//	})
+//	defer reload.Stop()
+//
+//	for range fileEvents {
+//	    reload.Trigger()
+//	}
+func Debounce(d time.Duration, fn func()) *Debouncer {
+	return &Debouncer{delay: d, fn: fn}
+}
+
+// Trigger records a change and restarts the quiet window.
+// Triggers after Stop are ignored.
+func (d *Debouncer) Trigger() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.stopped {
+		return
+	}
+	if d.timer != nil {
+		d.timer.Stop()
+	}
+	d.timer = time.AfterFunc(d.delay, d.fn)
+}
+
+// Stop cancels a pending call and ignores future triggers.
+// Returns true if a pending call was cancelled.
+func (d *Debouncer) Stop() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.stopped = true
+	if d.timer == nil {
+		return false
+	}
+	return d.timer.Stop()
+}
@@ -0,0 +1,57 @@
+package watch
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDebounce_CoalescesBurst(t *testing.T) {
+	var calls atomic.Int32
+	d := Debounce(50*time.Millisecond, func() { calls.Add(1) })
+
+	// Three changes within the window
+	for i := 0; i < 3; i++ {
+		d.Trigger()
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if n := calls.Load(); n != 0 {
+		t.Fatalf("Expected no call before the quiet window ends, got %d", n)
+	}
+
+	time.Sleep(100 * time.Millisecond)
+	if n := calls.Load(); n != 1 {
+		t.Errorf("Expected exactly 1 call after the debounce interval, got %d", n)
+	}
+}
+
+func TestDebounce_SeparateBursts(t *testing.T) {
+	var calls atomic.Int32
+	d := Debounce(20*time.Millisecond, func() { calls.Add(1) })
+
+	d.Trigger()
+	time.Sleep(60 * time.Millisecond)
+	d.Trigger()
+	time.Sleep(60 * time.Millisecond)
+
+	if n := calls.Load(); n != 2 {
+		t.Errorf("Expected 1 call per burst (2 total), got %d", n)
+	}
+}
+
+func TestDebounce_StopCancelsPending(t *testing.T) {
+	var calls atomic.Int32
+	d := Debounce(20*time.Millisecond, func() { calls.Add(1) })
+
+	d.Trigger()
+	if !d.Stop() {
+		t.Error("Expected Stop to cancel the pending call")
+	}
+	d.Trigger() // ignored after Stop
+
+	time.Sleep(60 * time.Millisecond)
+	if n := calls.Load(); n != 0 {
+		t.Errorf("Expected no calls after Stop, got %d", n)
+	}
+}
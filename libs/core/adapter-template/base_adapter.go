@@ -13,14 +13,16 @@ type AdapterLifecycle interface {
 }
 
 // BaseTemplate đăng ký OnStart/OnStop với Fx lifecycle
+// Panic trong OnStart/OnStop được chuyển thành PanicError (tắt bằng WithoutPanicRecovery)
 //
 // Parameters:
 //   - lc: Fx lifecycle để đăng ký hooks
 //   - impl: Implementation của AdapterLifecycle interface
+//   - opts: LifecycleOption, ví dụ WithoutPanicRecovery, WithEnabled, WithMetrics
 //
 // Panics:
 //   - Nếu lc hoặc impl là nil
-func BaseTemplate(lc fx.Lifecycle, impl AdapterLifecycle) {
+func BaseTemplate(lc fx.Lifecycle, impl AdapterLifecycle, opts ...LifecycleOption) {
 	if lc == nil {
 		panic("fx.Lifecycle cannot be nil")
 	}
@@ -28,6 +30,12 @@ func BaseTemplate(lc fx.Lifecycle, impl AdapterLifecycle) {
 		panic("AdapterLifecycle implementation cannot be nil")
 	}
 
+	o := newLifecycleOptions(opts)
+	appendHooks(lc, gateEnabled(instrument(recoverPanics(impl, o), o.adapterName(impl), o.metrics), o.enabled))
+}
+
+// appendHooks thêm OnStart/OnStop của impl vào lc
+func appendHooks(lc fx.Lifecycle, impl AdapterLifecycle) {
 	lc.Append(fx.Hook{
 		OnStart: impl.OnStart,
 		OnStop:  impl.OnStop,
@@ -35,19 +43,102 @@ func BaseTemplate(lc fx.Lifecycle, impl AdapterLifecycle) {
 }
 
 // BaseAdapter generic: gom Config + hỗ trợ lifecycle chung
+// Embed ShutdownStack: dùng Defer trong OnStart và RunShutdown trong OnStop
+// State() trả về trạng thái lifecycle, được cập nhật bởi hooks của RegisterLifecycle/TrackLifecycle
 type BaseAdapter[T any] struct {
 	Config T
+	ShutdownStack
+
+	// Pipeline (optional): interceptor pipeline gắn vào ctx khi RegisterControllers
+	// Tạo bằng WithInterceptors
+	Pipeline RoutePipeline
+
+	lifecycle lifecycleState
+
+	// metrics và metricsName được đặt bởi RegisterLifecycle với WithMetrics
+	metrics     LifecycleMetrics
+	metricsName string
+}
+
+// State trả về trạng thái lifecycle hiện tại (StateCreated trước lần start đầu tiên)
+// An toàn khi gọi đồng thời, ví dụ từ health check
+func (b *BaseAdapter[T]) State() AdapterState {
+	return b.lifecycle.State()
+}
+
+// TrackLifecycle bọc impl để OnStart/OnStop cập nhật State()
+// RegisterLifecycle dùng sẵn; gọi trực tiếp khi chạy adapter bằng Runner
+//
+// Behavior:
+//   - OnStart khi đang Starting/Started/Stopping trả về ErrAlreadyStarted, không gọi impl
+//   - OnStop khi chưa Started trả về ErrNotStarted, không gọi impl
+//   - impl trả về error → StateFailed
+//
+// Panics:
+//   - Nếu impl là nil
+//
+// Example:
+//
+//	runner := NewRunner(httpAdapter.TrackLifecycle(httpAdapter))
+func (b *BaseAdapter[T]) TrackLifecycle(impl AdapterLifecycle) AdapterLifecycle {
+	if impl == nil {
+		panic("AdapterLifecycle implementation cannot be nil")
+	}
+	return &trackedLifecycle{state: &b.lifecycle, impl: impl}
+}
+
+// RegisterControllers register controllers với ctx mang theo Pipeline (nếu có)
+// Controllers lấy lại pipeline bằng PipelineFromContext để bọc handlers
+// Khi RegisterLifecycle có WithMetrics, kết quả từng controller được ghi vào metrics
+//
+// Returns:
+//   - error: Error ngay khi có controller bị lỗi (fail-fast), giống RegisterRouters
+//
+// Example:
+//
+//	func (h *HttpAdapter) OnStart(ctx context.Context) error {
+//	    return h.RegisterControllers(ctx, h.Config.Controllers)
+//	}
+func (b *BaseAdapter[T]) RegisterControllers(ctx context.Context, controllers []ICoreController) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if b.Pipeline != nil {
+		ctx = b.Pipeline.AttachTo(ctx)
+	}
+
+	var observe RegistrationObserver
+	if b.metrics != nil {
+		observe = MetricsObserver(b.metrics, b.metricsName)
+	}
+	return RegisterRoutersObserved(controllers, ctx, observe)
 }
 
 // RegisterLifecycle đăng ký adapter lifecycle với Fx
-// Method này add validation layer trên BaseTemplate
+// Method này add validation layer trên BaseTemplate và cập nhật State() (xem TrackLifecycle)
+// Panic trong OnStart/OnStop thành PanicError mang tên type của impl, State() chuyển sang StateFailed
+// Adapter bị tắt bằng WithEnabled: State() là StateSkipped, OnStart/OnStop của impl không được gọi
+// WithMetrics: ghi start/stop của impl và các controllers đăng ký qua RegisterControllers
 //
 // Parameters:
 //   - lc: Fx lifecycle để đăng ký hooks
 //   - impl: Implementation của AdapterLifecycle interface
+//   - opts: LifecycleOption, ví dụ WithoutPanicRecovery, WithEnabled, WithMetrics
 //
 // Panics:
 //   - Nếu lc hoặc impl là nil
-func (b *BaseAdapter[T]) RegisterLifecycle(lc fx.Lifecycle, impl AdapterLifecycle) {
-	BaseTemplate(lc, impl)
+func (b *BaseAdapter[T]) RegisterLifecycle(lc fx.Lifecycle, impl AdapterLifecycle, opts ...LifecycleOption) {
+	if lc == nil {
+		panic("fx.Lifecycle cannot be nil")
+	}
+	if impl == nil {
+		panic("AdapterLifecycle implementation cannot be nil")
+	}
+	o := newLifecycleOptions(opts)
+	b.metrics, b.metricsName = o.metrics, o.adapterName(impl)
+	appendHooks(lc, &trackedLifecycle{
+		state:   &b.lifecycle,
+		impl:    instrument(recoverPanics(impl, o), b.metricsName, o.metrics),
+		enabled: o.enabled,
+	})
 }
@@ -0,0 +1,27 @@
+module github.com/phongthien99/monorepo-lib/libs/core/adapter-template/contrib/prometheus
+
+go 1.24.2
+
+require (
+	github.com/phongthien99/monorepo-lib/libs/core v0.0.0
+	github.com/prometheus/client_golang v1.23.2
+	go.uber.org/fx v1.23.0
+)
+
+require (
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/kylelemons/godebug v1.1.0 // indirect
+	github.com/munnerz/goautoneg v0.0.0-20191010083416-a7dc8b61c822 // indirect
+	github.com/prometheus/client_model v0.6.2 // indirect
+	github.com/prometheus/common v0.66.1 // indirect
+	github.com/prometheus/procfs v0.16.1 // indirect
+	go.uber.org/dig v1.18.0 // indirect
+	go.uber.org/multierr v1.10.0 // indirect
+	go.uber.org/zap v1.27.0 // indirect
+	go.yaml.in/yaml/v2 v2.4.2 // indirect
+	golang.org/x/sys v0.35.0 // indirect
+	google.golang.org/protobuf v1.36.8 // indirect
+)
+
+replace github.com/phongthien99/monorepo-lib/libs/core => ../../../
@@ -0,0 +1,170 @@
+// Package prometheus export metrics lifecycle của adapter-template sang Prometheus
+//
+// Package nằm ở module riêng để adapter-template không phụ thuộc Prometheus client
+// Provide Metrics vào Fx dưới dạng adaptertemplate.LifecycleMetrics, các adapter dùng MetricsParams sẽ tự ghi metrics
+package prometheus
+
+import (
+	"errors"
+	"time"
+
+	promclient "github.com/prometheus/client_golang/prometheus"
+	"go.uber.org/fx"
+
+	adaptertemplate "github.com/phongthien99/monorepo-lib/libs/core/adapter-template"
+)
+
+// Tên các metrics được đăng ký bởi New
+const (
+	AdapterUp                 = "adapter_up"
+	AdapterStartDuration      = "adapter_start_duration_seconds"
+	AdapterStopDuration       = "adapter_stop_duration_seconds"
+	ControllersRegistered     = "controllers_registered_total"
+	RegistrationFailuresTotal = "registration_failures_total"
+)
+
+// Giá trị của label "result"
+const (
+	ResultOK    = "ok"
+	ResultError = "error"
+)
+
+// Metrics là adaptertemplate.LifecycleMetrics ghi vào Prometheus collectors
+type Metrics struct {
+	up            *promclient.GaugeVec
+	startDuration *promclient.HistogramVec
+	stopDuration  *promclient.HistogramVec
+	registered    *promclient.CounterVec
+	failures      *promclient.CounterVec
+}
+
+var _ adaptertemplate.LifecycleMetrics = (*Metrics)(nil)
+
+// New đăng ký các collectors với reg và trả về Metrics ghi vào chúng
+// Collector giống hệt đã được đăng ký (ví dụ Metrics thứ hai trong cùng process) sẽ được dùng lại
+//
+// Example:
+//
+//	metrics, err := adapterprom.New(prometheus.DefaultRegisterer)
+//	if err != nil {
+//	    return err
+//	}
+//	adapter.RegisterLifecycle(lc, adapter, adaptertemplate.WithMetrics(metrics))
+func New(reg promclient.Registerer) (*Metrics, error) {
+	buckets := []float64{.001, .005, .01, .05, .1, .5, 1, 2.5, 5, 10, 30}
+
+	up, err := register(reg, promclient.NewGaugeVec(promclient.GaugeOpts{
+		Name: AdapterUp,
+		Help: "1 nếu adapter đã start thành công và chưa stop.",
+	}, []string{"adapter"}))
+	if err != nil {
+		return nil, err
+	}
+	startDuration, err := register(reg, promclient.NewHistogramVec(promclient.HistogramOpts{
+		Name:    AdapterStartDuration,
+		Help:    "Thời gian OnStart của adapter.",
+		Buckets: buckets,
+	}, []string{"adapter", "result"}))
+	if err != nil {
+		return nil, err
+	}
+	stopDuration, err := register(reg, promclient.NewHistogramVec(promclient.HistogramOpts{
+		Name:    AdapterStopDuration,
+		Help:    "Thời gian OnStop của adapter.",
+		Buckets: buckets,
+	}, []string{"adapter", "result"}))
+	if err != nil {
+		return nil, err
+	}
+	registered, err := register(reg, promclient.NewCounterVec(promclient.CounterOpts{
+		Name: ControllersRegistered,
+		Help: "Số controllers được register thành công.",
+	}, []string{"adapter", "controller"}))
+	if err != nil {
+		return nil, err
+	}
+	failures, err := register(reg, promclient.NewCounterVec(promclient.CounterOpts{
+		Name: RegistrationFailuresTotal,
+		Help: "Số controllers register thất bại.",
+	}, []string{"adapter", "controller"}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Metrics{
+		up:            up,
+		startDuration: startDuration,
+		stopDuration:  stopDuration,
+		registered:    registered,
+		failures:      failures,
+	}, nil
+}
+
+// Module provide Metrics (đăng ký với prometheus.DefaultRegisterer) dưới dạng adaptertemplate.LifecycleMetrics
+//
+// Example:
+//
+//	fx.New(
+//	    adapterprom.Module,
+//	    examples.ForRoot("printer", ""), // dùng MetricsParams, tự ghi metrics
+//	)
+var Module = fx.Module("adapter-metrics",
+	fx.Provide(
+		fx.Annotate(
+			func() (*Metrics, error) { return New(promclient.DefaultRegisterer) },
+			fx.As(new(adaptertemplate.LifecycleMetrics)),
+		),
+	),
+)
+
+// register đăng ký c với reg, dùng lại collector đã có nếu trùng
+func register[C promclient.Collector](reg promclient.Registerer, c C) (C, error) {
+	if err := reg.Register(c); err != nil {
+		var already promclient.AlreadyRegisteredError
+		if !errors.As(err, &already) {
+			return c, err
+		}
+		existing, ok := already.ExistingCollector.(C)
+		if !ok {
+			return c, err
+		}
+		return existing, nil
+	}
+	return c, nil
+}
+
+// SetAdapterUp implements adaptertemplate.LifecycleMetrics
+func (m *Metrics) SetAdapterUp(adapter string, up bool) {
+	value := 0.0
+	if up {
+		value = 1
+	}
+	m.up.WithLabelValues(adapter).Set(value)
+}
+
+// ObserveStart implements adaptertemplate.LifecycleMetrics
+func (m *Metrics) ObserveStart(adapter string, d time.Duration, err error) {
+	m.startDuration.WithLabelValues(adapter, result(err)).Observe(d.Seconds())
+}
+
+// ObserveStop implements adaptertemplate.LifecycleMetrics
+func (m *Metrics) ObserveStop(adapter string, d time.Duration, err error) {
+	m.stopDuration.WithLabelValues(adapter, result(err)).Observe(d.Seconds())
+}
+
+// ControllerRegistered implements adaptertemplate.LifecycleMetrics
+func (m *Metrics) ControllerRegistered(adapter, controller string) {
+	m.registered.WithLabelValues(adapter, controller).Inc()
+}
+
+// RegistrationFailed implements adaptertemplate.LifecycleMetrics
+func (m *Metrics) RegistrationFailed(adapter, controller string, err error) {
+	m.failures.WithLabelValues(adapter, controller).Inc()
+}
+
+func result(err error) string {
+	if err != nil {
+		return ResultError
+	}
+	return ResultOK
+}
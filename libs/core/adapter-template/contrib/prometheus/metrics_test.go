@@ -0,0 +1,131 @@
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	promclient "github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+
+	adaptertemplate "github.com/phongthien99/monorepo-lib/libs/core/adapter-template"
+)
+
+type okController struct{}
+
+func (c *okController) GetUsers(ctx context.Context) {}
+
+type brokenController struct{}
+
+func (c *brokenController) GetOrders(ctx context.Context) {
+	panic("db not ready")
+}
+
+type httpAdapter struct {
+	adaptertemplate.BaseAdapter[struct{}]
+	controllers []adaptertemplate.ICoreController
+}
+
+func (h *httpAdapter) OnStart(ctx context.Context) error {
+	return h.RegisterControllers(ctx, h.controllers)
+}
+
+func (h *httpAdapter) OnStop(ctx context.Context) error {
+	return nil
+}
+
+func TestMetrics_AdapterLifecycle(t *testing.T) {
+	reg := promclient.NewRegistry()
+	metrics, err := New(reg)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	adapter := &httpAdapter{controllers: []adaptertemplate.ICoreController{&okController{}, &okController{}}}
+	app := fxtest.New(t,
+		fx.Supply(fx.Annotate(metrics, fx.As(new(adaptertemplate.LifecycleMetrics)))),
+		fx.Invoke(func(lc fx.Lifecycle, p adaptertemplate.MetricsParams) {
+			adapter.RegisterLifecycle(lc, adapter, adaptertemplate.WithMetrics(p.Metrics), adaptertemplate.WithAdapterName("http"))
+		}),
+	)
+	app.RequireStart()
+
+	want := `
+# HELP adapter_up 1 nếu adapter đã start thành công và chưa stop.
+# TYPE adapter_up gauge
+adapter_up{adapter="http"} 1
+# HELP controllers_registered_total Số controllers được register thành công.
+# TYPE controllers_registered_total counter
+controllers_registered_total{adapter="http",controller="*prometheus.okController"} 2
+`
+	if err := testutil.GatherAndCompare(reg, strings.NewReader(want), AdapterUp, ControllersRegistered); err != nil {
+		t.Error(err)
+	}
+
+	app.RequireStop()
+	if got := testutil.ToFloat64(metrics.up.WithLabelValues("http")); got != 0 {
+		t.Errorf("Expected adapter_up 0 after stop, got %v", got)
+	}
+	// Bucket placement phụ thuộc timing, chỉ kiểm tra số label sets
+	if got := testutil.CollectAndCount(metrics.startDuration, AdapterStartDuration); got != 1 {
+		t.Errorf("Expected 1 start label set, got %d", got)
+	}
+	if got := testutil.CollectAndCount(metrics.stopDuration, AdapterStopDuration); got != 1 {
+		t.Errorf("Expected 1 stop label set, got %d", got)
+	}
+}
+
+func TestMetrics_RegistrationFailure(t *testing.T) {
+	reg := promclient.NewRegistry()
+	metrics, err := New(reg)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+
+	adapter := &httpAdapter{controllers: []adaptertemplate.ICoreController{&okController{}, &brokenController{}}}
+	app := fxtest.New(t,
+		fx.Invoke(func(lc fx.Lifecycle) {
+			adapter.RegisterLifecycle(lc, adapter, adaptertemplate.WithMetrics(metrics), adaptertemplate.WithAdapterName("http"))
+		}),
+	)
+	if err := app.Start(context.Background()); err == nil {
+		t.Fatal("Expected start to fail")
+	}
+
+	if got := testutil.ToFloat64(metrics.failures.WithLabelValues("http", "*prometheus.brokenController")); got != 1 {
+		t.Errorf("Expected 1 registration failure, got %v", got)
+	}
+	if got := testutil.ToFloat64(metrics.up.WithLabelValues("http")); got != 0 {
+		t.Errorf("Expected adapter_up 0 after a failed start, got %v", got)
+	}
+	if got := testutil.CollectAndCount(metrics.startDuration, AdapterStartDuration); got != 1 {
+		t.Errorf("Expected 1 start label set, got %d", got)
+	}
+	metrics.ObserveStart("http", time.Millisecond, errors.New("boom"))
+	if got := testutil.CollectAndCount(metrics.startDuration, AdapterStartDuration); got != 1 {
+		t.Errorf("Expected start errors to share the result=error label set, got %d", got)
+	}
+}
+
+func TestNew_ReusesRegisteredCollectors(t *testing.T) {
+	reg := promclient.NewRegistry()
+	first, err := New(reg)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	second, err := New(reg)
+	if err != nil {
+		t.Fatalf("Expected second New to reuse the collectors, got: %v", err)
+	}
+
+	first.ControllerRegistered("http", "*api.UserController")
+	second.ControllerRegistered("http", "*api.UserController")
+
+	if got := testutil.ToFloat64(first.registered.WithLabelValues("http", "*api.UserController")); got != 2 {
+		t.Errorf("Expected both instances to share one counter, got %v", got)
+	}
+}
@@ -0,0 +1,80 @@
+package adaptertemplate
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+)
+
+// EnabledFunc quyết định adapter có chạy hay không, được gọi mỗi lần OnStart
+type EnabledFunc func(ctx context.Context) (bool, error)
+
+// WithEnabled bật/tắt adapter theo enabled, ví dụ từ config `adapters: {grpc: {enabled: false}}`
+// Fx providers của adapter vẫn được tạo bình thường, nên các dependents không bị lỗi
+//
+// Behavior:
+//   - enabled trả về false: OnStart/OnStop của impl không được gọi, State() là StateSkipped
+//   - enabled trả về error: OnStart fail với error đó, State() là StateFailed
+//   - Mỗi lần start lại, enabled được gọi lại
+//
+// Example:
+//
+//	a.RegisterLifecycle(lc, a, adaptertemplate.WithEnabled(func(ctx context.Context) (bool, error) {
+//	    return flags.IsEnabled(ctx, "grpc-adapter")
+//	}))
+func WithEnabled(enabled EnabledFunc) LifecycleOption {
+	return func(o *lifecycleOptions) {
+		o.enabled = enabled
+	}
+}
+
+// WithEnabledFlag là WithEnabled đọc một bool từ config accessor
+// flag được gọi lúc OnStart, nên config reload trước khi start vẫn có hiệu lực
+//
+// Example:
+//
+//	a.RegisterLifecycle(lc, a, adaptertemplate.WithEnabledFlag(func() bool {
+//	    return cfg.Adapters.GRPC.Enabled
+//	}))
+func WithEnabledFlag(flag func() bool) LifecycleOption {
+	return WithEnabled(func(context.Context) (bool, error) {
+		return flag(), nil
+	})
+}
+
+// gateEnabled bọc impl để bỏ qua OnStart/OnStop khi enabled trả về false
+// Dùng cho BaseTemplate (không có State()); trả về impl nguyên vẹn khi enabled là nil
+func gateEnabled(impl AdapterLifecycle, enabled EnabledFunc) AdapterLifecycle {
+	if enabled == nil {
+		return impl
+	}
+	return &gatedLifecycle{impl: impl, enabled: enabled}
+}
+
+// gatedLifecycle gọi impl chỉ khi adapter được bật lúc OnStart
+type gatedLifecycle struct {
+	impl    AdapterLifecycle
+	enabled EnabledFunc
+	skipped atomic.Bool
+}
+
+// OnStart implements AdapterLifecycle
+func (g *gatedLifecycle) OnStart(ctx context.Context) error {
+	enabled, err := g.enabled(ctx)
+	if err != nil {
+		return fmt.Errorf("adapter enabled check: %w", err)
+	}
+	g.skipped.Store(!enabled)
+	if !enabled {
+		return nil
+	}
+	return g.impl.OnStart(ctx)
+}
+
+// OnStop implements AdapterLifecycle
+func (g *gatedLifecycle) OnStop(ctx context.Context) error {
+	if g.skipped.Load() {
+		return nil
+	}
+	return g.impl.OnStop(ctx)
+}
@@ -0,0 +1,135 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+// adaptersConfig mô phỏng config `adapters: {http: {enabled: true}, grpc: {enabled: false}}`
+type adaptersConfig struct {
+	HTTP struct{ Enabled bool }
+	GRPC struct{ Enabled bool }
+}
+
+func TestRegisterLifecycle_DisabledByConfig(t *testing.T) {
+	var cfg adaptersConfig
+	cfg.HTTP.Enabled = true
+
+	httpAdapter, grpcAdapter := &stateAdapter{}, &stateAdapter{}
+	app := fxtest.New(t,
+		fx.Supply(cfg),
+		fx.Invoke(func(lc fx.Lifecycle, cfg adaptersConfig) {
+			httpAdapter.RegisterLifecycle(lc, httpAdapter, WithEnabledFlag(func() bool { return cfg.HTTP.Enabled }))
+			grpcAdapter.RegisterLifecycle(lc, grpcAdapter, WithEnabledFlag(func() bool { return cfg.GRPC.Enabled }))
+		}),
+	)
+
+	app.RequireStart()
+	if httpAdapter.starts != 1 || httpAdapter.State() != StateStarted {
+		t.Errorf("Expected enabled adapter to start, got %d starts, state %s", httpAdapter.starts, httpAdapter.State())
+	}
+	if grpcAdapter.starts != 0 || grpcAdapter.State() != StateSkipped {
+		t.Errorf("Expected disabled adapter to be skipped, got %d starts, state %s", grpcAdapter.starts, grpcAdapter.State())
+	}
+
+	// Verify: adapter bị tắt không Ready nhưng vẫn Healthy
+	if state := grpcAdapter.State(); state.Ready() || !state.Healthy() {
+		t.Errorf("Expected skipped adapter to be healthy but not ready, got ready=%v healthy=%v", state.Ready(), state.Healthy())
+	}
+
+	app.RequireStop()
+	if len(grpcAdapter.observed) != 0 {
+		t.Errorf("Expected disabled adapter hooks never to run, observed %v", grpcAdapter.observed)
+	}
+	if grpcAdapter.State() != StateSkipped {
+		t.Errorf("Expected disabled adapter to stay skipped after stop, got %s", grpcAdapter.State())
+	}
+	if httpAdapter.State() != StateStopped {
+		t.Errorf("Expected enabled adapter to stop, got %s", httpAdapter.State())
+	}
+}
+
+func TestRegisterLifecycle_EnabledCheckedOnEveryStart(t *testing.T) {
+	adapter := &stateAdapter{}
+	enabled := false
+	tracked := &trackedLifecycle{state: &adapter.lifecycle, impl: adapter, enabled: func(context.Context) (bool, error) {
+		return enabled, nil
+	}}
+	runner := NewRunner(tracked)
+
+	if err := runner.Start(context.Background()); err != nil {
+		t.Fatalf("Expected skipped start to succeed, got: %v", err)
+	}
+	if err := runner.Stop(context.Background()); err != nil {
+		t.Fatalf("Expected skipped stop to succeed, got: %v", err)
+	}
+
+	enabled = true
+	if err := runner.Start(context.Background()); err != nil {
+		t.Fatalf("Start failed: %v", err)
+	}
+	if adapter.starts != 1 || adapter.State() != StateStarted {
+		t.Errorf("Expected adapter to start once enabled, got %d starts, state %s", adapter.starts, adapter.State())
+	}
+}
+
+func TestRegisterLifecycle_EnabledError(t *testing.T) {
+	checkErr := errors.New("flag service unavailable")
+	adapter := &stateAdapter{}
+	app := fxtest.New(t,
+		fx.Invoke(func(lc fx.Lifecycle) {
+			adapter.RegisterLifecycle(lc, adapter, WithEnabled(func(context.Context) (bool, error) {
+				return false, checkErr
+			}))
+		}),
+	)
+
+	if err := app.Start(context.Background()); !errors.Is(err, checkErr) {
+		t.Fatalf("Expected enabled check error, got: %v", err)
+	}
+	if adapter.starts != 0 || adapter.State() != StateFailed {
+		t.Errorf("Expected adapter not started and failed, got %d starts, state %s", adapter.starts, adapter.State())
+	}
+	if adapter.State().Healthy() {
+		t.Error("Expected failed adapter to be unhealthy")
+	}
+}
+
+func TestBaseTemplate_WithEnabled(t *testing.T) {
+	disabled, enabled := &countingAdapter{}, &countingAdapter{}
+	app := fxtest.New(t,
+		fx.Invoke(func(lc fx.Lifecycle) {
+			BaseTemplate(lc, disabled, WithEnabledFlag(func() bool { return false }))
+			BaseTemplate(lc, enabled, WithEnabledFlag(func() bool { return true }))
+		}),
+	)
+
+	app.RequireStart()
+	app.RequireStop()
+
+	if disabled.starts != 0 || disabled.stops != 0 {
+		t.Errorf("Expected disabled adapter hooks not to run, got %d starts, %d stops", disabled.starts, disabled.stops)
+	}
+	if enabled.starts != 1 || enabled.stops != 1 {
+		t.Errorf("Expected enabled adapter to start and stop once, got %d starts, %d stops", enabled.starts, enabled.stops)
+	}
+}
+
+// countingAdapter đếm số lần OnStart/OnStop được gọi
+type countingAdapter struct {
+	starts, stops int
+}
+
+func (c *countingAdapter) OnStart(ctx context.Context) error {
+	c.starts++
+	return nil
+}
+
+func (c *countingAdapter) OnStop(ctx context.Context) error {
+	c.stops++
+	return nil
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/phongthien99/monorepo-lib/libs/config"
+	"github.com/phongthien99/monorepo-lib/libs/config/loader"
+	"github.com/spf13/pflag"
+)
+
+// AppConfig is the whole application configuration.
+// Fields carry no `default` tags: defaults come from defaultConfig, so unset flags
+// (which bind as zero values) never override the file or the environment.
+type AppConfig struct {
+	Service ServiceConfig `mapstructure:"service"`
+	Server  ServerConfig  `mapstructure:"server"`
+	Log     LogConfig     `mapstructure:"log"`
+	Auth    AuthConfig    `mapstructure:"auth"`
+}
+
+// ServiceConfig identifies the running service; its values are added to every log entry
+type ServiceConfig struct {
+	Name    string `mapstructure:"name" usage:"service name logged on every entry"`
+	Version string `mapstructure:"version" usage:"service version logged on every entry"`
+	Env     string `mapstructure:"env" usage:"deployment environment logged on every entry"`
+}
+
+// ServerConfig configures the HTTP adapter
+type ServerConfig struct {
+	Addr string `mapstructure:"addr" usage:"HTTP listen address (\":0\" picks a free port)"`
+}
+
+// LogConfig configures the zap logger
+type LogConfig struct {
+	Level    string `mapstructure:"level" usage:"log level: debug, info, warn, error"`
+	Encoding string `mapstructure:"encoding" usage:"log encoding: json or console"`
+}
+
+// AuthConfig maps bearer tokens to user IDs
+type AuthConfig struct {
+	Tokens map[string]string `mapstructure:"tokens" usage:"bearer tokens to user IDs, e.g. secret=alice"`
+}
+
+// defaultConfig is the lowest-priority source
+func defaultConfig() AppConfig {
+	return AppConfig{
+		Service: ServiceConfig{Name: "fullapp"},
+		Server:  ServerConfig{Addr: ":8080"},
+		Log:     LogConfig{Level: "info", Encoding: "json"},
+	}
+}
+
+// LoadConfig loads AppConfig from, in increasing priority: defaults, the YAML file at path
+// (skipped when path is empty), FULLAPP_* environment variables and the flags in args.
+//
+// Example:
+//
+//	FULLAPP_LOG_LEVEL=debug fullapp --server.addr=:9090 --auth.tokens=secret=alice
+func LoadConfig(path string, args []string) (AppConfig, error) {
+	flags := pflag.NewFlagSet("fullapp", pflag.ContinueOnError)
+	if err := loader.RegisterFlags(flags, AppConfig{}); err != nil {
+		return AppConfig{}, err
+	}
+	if err := flags.Parse(args); err != nil {
+		return AppConfig{}, err
+	}
+
+	loaders := []config.Loader[*AppConfig]{
+		loaderFunc[AppConfig](func(dst *AppConfig) error {
+			*dst = defaultConfig()
+			return nil
+		}),
+	}
+	if path != "" {
+		loaders = append(loaders, typed[AppConfig](loader.NewFileLoader(path, "yaml")))
+	}
+	loaders = append(loaders,
+		typed[AppConfig](loader.NewEnvLoader("FULLAPP").WithAutoKeys(AppConfig{})),
+		typed[AppConfig](loader.NewFlagLoader(flags)),
+	)
+
+	cfg := config.New[AppConfig](loaders...)
+	if err := cfg.Load(); err != nil {
+		return AppConfig{}, fmt.Errorf("load config: %w", err)
+	}
+	return cfg.Get(), nil
+}
+
+// untypedLoader is the Load signature of the loaders in libs/config/loader
+type untypedLoader interface {
+	Load(dst interface{}) error
+}
+
+// typed adapts a loader from libs/config/loader to config.Loader[*T]
+func typed[T any](l untypedLoader) config.Loader[*T] {
+	return loaderFunc[T](func(dst *T) error { return l.Load(dst) })
+}
+
+// loaderFunc is a function adapter for config.Loader[*T]
+type loaderFunc[T any] func(dst *T) error
+
+// Load implements config.Loader
+func (f loaderFunc[T]) Load(dst *T) error {
+	return f(dst)
+}
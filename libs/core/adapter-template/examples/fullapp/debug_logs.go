@@ -0,0 +1,30 @@
+package main
+
+import (
+	"context"
+
+	adaptertemplate "github.com/phongthien99/monorepo-lib/libs/core/adapter-template"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/stdhttp"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// DebugLogsController registers GET /debug/logs, serving the recent entries of the ring buffer
+// (Debug included, whatever the configured level) as JSON. Auth applies (see NewResolver)
+type DebugLogsController struct {
+	ring *core.RingBuffer
+}
+
+var _ adaptertemplate.ICoreController = (*DebugLogsController)(nil)
+
+// NewDebugLogsController creates a controller serving ring
+func NewDebugLogsController(ring *core.RingBuffer) *DebugLogsController {
+	return &DebugLogsController{ring: ring}
+}
+
+// DebugLogs will be auto-called by RegisterRouter
+func (d *DebugLogsController) DebugLogs(ctx context.Context) {
+	registerHandler(ctx, "GET /debug/logs", func(*interceptor.UniversalContext[*stdhttp.HTTPMeta]) (any, error) {
+		return d.ring.Entries(), nil
+	})
+}
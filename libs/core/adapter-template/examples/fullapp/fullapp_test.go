@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/config/loader"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/stdhttp"
+	"github.com/phongthien99/monorepo-lib/libs/log/adapter/zap"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"github.com/spf13/pflag"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+// syncBuffer is a bytes.Buffer safe for the server goroutines writing log entries
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// entries parses the captured JSON log lines
+func (b *syncBuffer) entries(t *testing.T) []map[string]any {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var entries []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid JSON log line %q: %v", line, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// startApp loads config from a file, env and flags, boots Module on a random port
+// and returns the base URL and the captured logs
+func startApp(t *testing.T) (string, *syncBuffer) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte("service:\n  env: test\nlog:\n  level: warn\n  encoding: json\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("FULLAPP_LOG_LEVEL", "info") // env overrides the file
+	cfg, err := LoadConfig(path, []string{"--server.addr=127.0.0.1:0", "--auth.tokens=secret=alice"})
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	logs := &syncBuffer{}
+	var adapter *HTTPAdapter
+	app := fxtest.New(t,
+		fx.Supply(cfg),
+		Module,
+		fx.Provide(fx.Annotate(func() zap.Option { return zap.WithWriter(logs) }, fx.ResultTags(`group:"logOptions"`))),
+		fx.Populate(&adapter),
+	)
+	app.RequireStart()
+	t.Cleanup(app.RequireStop)
+
+	return "http://" + adapter.Addr(), logs
+}
+
+func get(t *testing.T, url, token string) (int, string) {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET %s: %v", url, err)
+	}
+	defer resp.Body.Close()
+	body, _ := io.ReadAll(resp.Body)
+	return resp.StatusCode, string(body)
+}
+
+func TestFullApp_ServesRoutesThroughInterceptors(t *testing.T) {
+	baseURL, logs := startApp(t)
+
+	if code, body := get(t, baseURL+"/healthz", ""); code != http.StatusOK || !strings.Contains(body, `"status":"ok"`) {
+		t.Errorf("Expected 200 from /healthz without credentials, got %d %q", code, body)
+	}
+	if code, _ := get(t, baseURL+"/users", ""); code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 from /users without credentials, got %d", code)
+	}
+	if code, _ := get(t, baseURL+"/users", "wrong"); code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 from /users with an unknown token, got %d", code)
+	}
+	code, body := get(t, baseURL+"/users", "secret")
+	if code != http.StatusOK || !strings.Contains(body, `"caller":"alice"`) || !strings.Contains(body, `"Alice"`) {
+		t.Errorf("Expected 200 with users for alice, got %d %q", code, body)
+	}
+	if code, _ := get(t, baseURL+"/orders", "secret"); code != http.StatusNotFound {
+		t.Errorf("Expected 404 from a key with no registered handler, got %d", code)
+	}
+
+	// Verify: request logger saw every request, at the level loaded from env (info, not the file's warn)
+	var requests, failures []string
+	for _, entry := range logs.entries(t) {
+		if entry["service"] != "fullapp" || entry["env"] != "test" {
+			t.Errorf("Expected service=fullapp env=test on every entry, got %v", entry)
+		}
+		if _, ok := entry["version"]; ok {
+			t.Errorf("Expected no version field when the config leaves it empty, got %v", entry)
+		}
+		switch entry["msg"] {
+		case "request":
+			requests = append(requests, entry["method"].(string))
+		case "request failed":
+			failures = append(failures, entry["method"].(string))
+		}
+	}
+	if want := []string{"GET /healthz", "GET /users"}; strings.Join(requests, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected request entries %v, got %v", want, requests)
+	}
+	if len(failures) != 2 || failures[0] != "GET /users" {
+		t.Errorf("Expected 2 failed /users entries, got %v", failures)
+	}
+}
+
+func TestLoadConfig_Priority(t *testing.T) {
+	t.Setenv("FULLAPP_SERVER_ADDR", ":9000")
+
+	cfg, err := LoadConfig("", []string{"--log.level=debug"})
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if cfg.Server.Addr != ":9000" {
+		t.Errorf("Expected env addr :9000, got %q", cfg.Server.Addr)
+	}
+	if cfg.Log.Level != "debug" || cfg.Log.Encoding != "json" {
+		t.Errorf("Expected flag level debug and default encoding json, got %+v", cfg.Log)
+	}
+}
+
+func TestFlagLoader_LogLevelValue(t *testing.T) {
+	type Config struct {
+		LogLevel core.Level `mapstructure:"log-level"`
+	}
+
+	level := core.InfoLevel
+	flags := pflag.NewFlagSet("fullapp", pflag.ContinueOnError)
+	flags.Var((*core.LevelValue)(&level), "log-level", "log level: debug, info, warn, error")
+	if err := flags.Parse([]string{"--log-level=warn"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	var cfg Config
+	if err := loader.NewFlagLoader(flags).Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.LogLevel != core.WarnLevel {
+		t.Errorf("Expected core.WarnLevel, got %v", cfg.LogLevel)
+	}
+
+	// RegisterFlags picks up core.Level fields too, through its text marshaling
+	generated := pflag.NewFlagSet("fullapp", pflag.ContinueOnError)
+	if err := loader.RegisterFlags(generated, Config{}); err != nil {
+		t.Fatalf("RegisterFlags failed: %v", err)
+	}
+	if err := generated.Parse([]string{"--log-level=error"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if err := loader.NewFlagLoader(generated).Load(&cfg); err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.LogLevel != core.ErrorLevel {
+		t.Errorf("Expected core.ErrorLevel, got %v", cfg.LogLevel)
+	}
+}
+
+func TestHTTPAdapter_OnStopDrainsInFlightRequests(t *testing.T) {
+	cfg, err := LoadConfig("", []string{"--server.addr=127.0.0.1:0"})
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	var adapter *HTTPAdapter
+	app := fxtest.New(t,
+		fx.Supply(cfg),
+		Module,
+		fx.Provide(fx.Annotate(func() zap.Option { return zap.WithWriter(io.Discard) }, fx.ResultTags(`group:"logOptions"`))),
+		fx.Populate(&adapter),
+	)
+	app.RequireStart()
+	t.Cleanup(app.RequireStop)
+	baseURL := "http://" + adapter.Addr()
+
+	release := make(chan struct{})
+	adapter.pipeline.Register("GET /slow", func(ctx *interceptor.UniversalContext[*stdhttp.HTTPMeta]) (any, error) {
+		<-release
+		return map[string]string{"status": "done"}, nil
+	})
+
+	// Start slow requests and wait until they are in flight
+	const slow = 3
+	codes := make(chan int, slow)
+	for i := 0; i < slow; i++ {
+		go func() {
+			resp, err := http.Get(baseURL + "/slow")
+			if err != nil {
+				codes <- 0
+				return
+			}
+			resp.Body.Close()
+			codes <- resp.StatusCode
+		}()
+	}
+	waitFor(t, "slow requests in flight", func() bool { return adapter.drainer.InFlight() == slow })
+
+	stopped := make(chan error, 1)
+	go func() { stopped <- adapter.OnStop(context.Background()) }()
+	waitFor(t, "drain to begin", adapter.drainer.Draining)
+
+	// New requests are rejected while the slow ones finish
+	if code, _ := get(t, baseURL+"/healthz", ""); code != http.StatusServiceUnavailable {
+		t.Errorf("Expected 503 while draining, got %d", code)
+	}
+	select {
+	case err := <-stopped:
+		t.Fatalf("Expected OnStop to wait for in-flight requests, returned %v", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	for i := 0; i < slow; i++ {
+		if code := <-codes; code != http.StatusOK {
+			t.Errorf("Expected in-flight request to finish with 200, got %d", code)
+		}
+	}
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Errorf("Expected OnStop to succeed, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected OnStop to return after in-flight requests finished")
+	}
+}
+
+func TestHTTPAdapter_RestartsAfterStop(t *testing.T) {
+	cfg, err := LoadConfig("", []string{"--server.addr=127.0.0.1:0"})
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+
+	var adapter *HTTPAdapter
+	app := fxtest.New(t,
+		fx.Supply(cfg),
+		Module,
+		fx.Provide(fx.Annotate(func() zap.Option { return zap.WithWriter(io.Discard) }, fx.ResultTags(`group:"logOptions"`))),
+		fx.Populate(&adapter),
+	)
+	app.RequireStart()
+	t.Cleanup(app.RequireStop)
+
+	// Stop and start again: routes stay registered once and the drained pipeline accepts requests
+	if err := adapter.OnStop(context.Background()); err != nil {
+		t.Fatalf("OnStop() error = %v", err)
+	}
+	if err := adapter.OnStart(context.Background()); err != nil {
+		t.Fatalf("OnStart() after OnStop error = %v", err)
+	}
+
+	if code, body := get(t, "http://"+adapter.Addr()+"/healthz", ""); code != http.StatusOK {
+		t.Errorf("Expected 200 after restart, got %d %q", code, body)
+	}
+}
+
+// waitFor polls cond until it holds, failing the test after a second
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFullApp_DebugLogs(t *testing.T) {
+	baseURL, _ := startApp(t)
+
+	get(t, baseURL+"/healthz", "")
+	if code, _ := get(t, baseURL+"/debug/logs", ""); code != http.StatusUnauthorized {
+		t.Errorf("Expected 401 from /debug/logs without credentials, got %d", code)
+	}
+
+	code, body := get(t, baseURL+"/debug/logs", "secret")
+	if code != http.StatusOK {
+		t.Fatalf("Expected 200 from /debug/logs, got %d %q", code, body)
+	}
+	var entries []core.Entry
+	if err := json.Unmarshal([]byte(body), &entries); err != nil {
+		t.Fatalf("Expected a JSON array of entries, got %q: %v", body, err)
+	}
+
+	found := false
+	for _, entry := range entries {
+		if entry.Message == "request" && entry.Level == core.InfoLevel {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected the request log entries in /debug/logs, got %s", body)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+
+	adaptertemplate "github.com/phongthien99/monorepo-lib/libs/core/adapter-template"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/stdhttp"
+)
+
+// HTTPConfig holds configuration for HTTPAdapter
+type HTTPConfig struct {
+	Addr        string
+	Controllers []adaptertemplate.ICoreController
+}
+
+// HTTPAdapter serves the handlers controllers register in its pipeline with net/http.
+// Requests are dispatched by "METHOD /path" and every one runs through the interceptor pipeline;
+// unregistered keys get 404. OnStop drains the pipeline before shutting the server down;
+// the adapter can be started again after a stop.
+type HTTPAdapter struct {
+	adaptertemplate.BaseAdapter[HTTPConfig]
+
+	pipeline   *adaptertemplate.Pipeline[*stdhttp.HTTPMeta, *stdhttp.HTTPMeta]
+	drainer    *interceptor.Drainer[*stdhttp.HTTPMeta]
+	mux        *http.ServeMux
+	mu         sync.Mutex
+	addr       string // actual listen address, set by OnStart
+	registered bool   // controllers registered by the first OnStart
+}
+
+// NewHTTPAdapter creates an adapter serving controllers on addr through resolver's interceptors.
+// drainer must be one of those interceptors (see NewResolver)
+func NewHTTPAdapter(addr string, mux *http.ServeMux, controllers []adaptertemplate.ICoreController, resolver interceptor.InterceptorResolver[*stdhttp.HTTPMeta], drainer *interceptor.Drainer[*stdhttp.HTTPMeta]) *HTTPAdapter {
+	pipeline := adaptertemplate.WithInterceptors[*stdhttp.HTTPMeta, *stdhttp.HTTPMeta](resolver, NewHTTPBridge())
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		pipeline.Dispatch(&stdhttp.HTTPMeta{Writer: w, Request: r}, r.Method+" "+r.URL.Path)
+	})
+	return &HTTPAdapter{
+		BaseAdapter: adaptertemplate.BaseAdapter[HTTPConfig]{
+			Config:   HTTPConfig{Addr: addr, Controllers: controllers},
+			Pipeline: pipeline,
+		},
+		pipeline: pipeline,
+		drainer:  drainer,
+		mux:      mux,
+	}
+}
+
+// NewHTTPBridge extends stdhttp's bridge with responses: results are written as JSON,
+// ErrUnauthorized becomes 401, interceptor.ErrNoHandler 404, interceptor.ErrDraining 503
+// and any other error 500
+func NewHTTPBridge() interceptor.Bridge[*stdhttp.HTTPMeta, *stdhttp.HTTPMeta] {
+	bridge := stdhttp.NewBridge()
+	bridge.OnSuccessFn = func(m *stdhttp.HTTPMeta, result any) {
+		m.Writer.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(m.Writer).Encode(result)
+	}
+	bridge.OnErrorFn = func(m *stdhttp.HTTPMeta, err error) {
+		switch {
+		case errors.Is(err, ErrUnauthorized):
+			http.Error(m.Writer, err.Error(), http.StatusUnauthorized)
+			return
+		case errors.Is(err, interceptor.ErrNoHandler):
+			http.NotFound(m.Writer, m.Request)
+			return
+		case errors.Is(err, interceptor.ErrDraining):
+			http.Error(m.Writer, err.Error(), http.StatusServiceUnavailable)
+			return
+		}
+		http.Error(m.Writer, "internal server error", http.StatusInternalServerError)
+	}
+	return bridge
+}
+
+// OnStart implements AdapterLifecycle.OnStart: registers handlers on the first start,
+// accepts requests again after a previous OnStop, then starts serving
+func (a *HTTPAdapter) OnStart(ctx context.Context) error {
+	if !a.registered {
+		if err := a.RegisterControllers(ctx, a.Config.Controllers); err != nil {
+			return fmt.Errorf("failed to register controllers: %w", err)
+		}
+		a.registered = true
+	}
+	a.drainer.Resume()
+
+	ln, err := net.Listen("tcp", a.Config.Addr)
+	if err != nil {
+		return fmt.Errorf("listen %s: %w", a.Config.Addr, err)
+	}
+	a.mu.Lock()
+	a.addr = ln.Addr().String()
+	a.mu.Unlock()
+
+	server := &http.Server{Handler: a.mux}
+	go server.Serve(ln)
+	a.Defer("http-server", server.Shutdown)
+	return nil
+}
+
+// OnStop implements AdapterLifecycle.OnStop: rejects new requests with 503, waits for
+// in-flight ones until ctx is done, then shuts the server down gracefully
+func (a *HTTPAdapter) OnStop(ctx context.Context) error {
+	var drainErr error
+	if remaining, err := a.drainer.BeginDrain(ctx); err != nil {
+		drainErr = fmt.Errorf("drain: %d requests still in flight: %w", remaining, err)
+	}
+	return errors.Join(drainErr, a.RunShutdown(ctx))
+}
+
+// Addr returns the address the adapter listens on, "" before OnStart
+func (a *HTTPAdapter) Addr() string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.addr
+}
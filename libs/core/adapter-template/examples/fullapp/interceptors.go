@@ -0,0 +1,71 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/stdhttp"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// ErrUnauthorized is returned by AuthInterceptor; the bridge maps it to 401
+var ErrUnauthorized = errors.New("unauthorized")
+
+// userKey is the context key AuthInterceptor stores the user ID under
+type userKey struct{}
+
+// RecoveryInterceptor turns a panic in the rest of the chain into an error (500)
+func RecoveryInterceptor(logger core.ISugaredLogger) interceptor.Interceptor[*stdhttp.HTTPMeta] {
+	return interceptor.InterceptorFunc[*stdhttp.HTTPMeta](func(ctx *interceptor.UniversalContext[*stdhttp.HTTPMeta], next interceptor.NextFunc[*stdhttp.HTTPMeta]) (result any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Errorw("handler panicked", "method", ctx.Method, "panic", r)
+				result, err = nil, fmt.Errorf("panic: %v", r)
+			}
+		}()
+		return next(ctx)
+	})
+}
+
+// RequestLoggerInterceptor logs every request with its outcome and duration
+func RequestLoggerInterceptor(logger core.ISugaredLogger) interceptor.Interceptor[*stdhttp.HTTPMeta] {
+	return interceptor.InterceptorFunc[*stdhttp.HTTPMeta](func(ctx *interceptor.UniversalContext[*stdhttp.HTTPMeta], next interceptor.NextFunc[*stdhttp.HTTPMeta]) (any, error) {
+		start := time.Now()
+		result, err := next(ctx)
+
+		fields := []any{"method", ctx.Method, "duration", time.Since(start)}
+		if err != nil {
+			logger.Warnw("request failed", append(fields, "error", err)...)
+			return nil, err
+		}
+		logger.Infow("request", fields...)
+		return result, nil
+	})
+}
+
+// AuthInterceptor resolves "Authorization: Bearer <token>" to a user ID, rejecting unknown tokens
+func AuthInterceptor(tokens map[string]string) interceptor.Interceptor[*stdhttp.HTTPMeta] {
+	return interceptor.InterceptorFunc[*stdhttp.HTTPMeta](func(ctx *interceptor.UniversalContext[*stdhttp.HTTPMeta], next interceptor.NextFunc[*stdhttp.HTTPMeta]) (any, error) {
+		token, ok := strings.CutPrefix(ctx.Meta.Request.Header.Get("Authorization"), "Bearer ")
+		userID, known := tokens[token]
+		if !ok || !known {
+			return nil, interceptor.NewInterceptorError("auth", ErrUnauthorized)
+		}
+		ctx.Context = context.WithValue(ctx.Context, userKey{}, userID)
+		return next(ctx)
+	})
+}
+
+// NewResolver registers the interceptor chain: draining, recovery and request logging on every route,
+// auth only on /users and /debug/logs. The drainer comes first so its in-flight count covers the whole chain
+func NewResolver(cfg AppConfig, logger core.ISugaredLogger, drainer *interceptor.Drainer[*stdhttp.HTTPMeta]) *interceptor.Registry[*stdhttp.HTTPMeta] {
+	return interceptor.NewRegistry[*stdhttp.HTTPMeta]().
+		Register("drain", drainer).
+		Register("recovery", RecoveryInterceptor(logger)).
+		Register("request-logger", RequestLoggerInterceptor(logger)).
+		Register("auth", AuthInterceptor(cfg.Auth.Tokens), interceptor.OnPatterns("GET /users", "GET /debug/logs"))
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/adapter/zap"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/fx"
+)
+
+// debugLogsCapacity is how many recent entries NewRingBuffer keeps for /debug/logs
+const debugLogsCapacity = 500
+
+// LogModule provides a core.ISugaredLogger configured from AppConfig.Log, recording recent entries
+// (Debug included) into a *core.RingBuffer. Every entry carries the AppConfig.Service fields
+// (see WithServiceFields). Extra zap options can be contributed to the
+// "logOptions" group, e.g. zap.WithWriter in tests.
+var LogModule = fx.Module("log",
+	fx.Provide(
+		NewRingBuffer,
+		fx.Annotate(NewLogger, fx.ParamTags(``, ``, `group:"logOptions"`)),
+	),
+)
+
+// NewRingBuffer keeps the last debugLogsCapacity entries at any level
+func NewRingBuffer() *core.RingBuffer {
+	return core.NewRingBuffer(debugLogsCapacity, core.DebugLevel)
+}
+
+// NewLogger builds the zap logger wrapped by ring and WithServiceFields; opts are applied after the config
+func NewLogger(cfg AppConfig, ring *core.RingBuffer, opts []zap.Option) (core.ISugaredLogger, error) {
+	level, err := parseLevel(cfg.Log.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	all := append([]zap.Option{
+		zap.WithLevel(level),
+		zap.WithEncoding(cfg.Log.Encoding),
+	}, opts...)
+	logger, err := zap.NewWithOptions(all...)
+	if err != nil {
+		return nil, err
+	}
+	return WithServiceFields(cfg, ring.Wrap(logger)), nil
+}
+
+// WithServiceFields decorates logger with the service, version and env from AppConfig.Service;
+// empty values are left out
+func WithServiceFields(cfg AppConfig, logger core.ISugaredLogger) core.ISugaredLogger {
+	return core.WithStaticFields(logger,
+		"service", cfg.Service.Name,
+		"version", cfg.Service.Version,
+		"env", cfg.Service.Env,
+	)
+}
+
+// parseLevel maps a config level name to core.Level
+func parseLevel(name string) (core.Level, error) {
+	switch strings.ToLower(name) {
+	case "debug":
+		return core.DebugLevel, nil
+	case "", "info":
+		return core.InfoLevel, nil
+	case "warn":
+		return core.WarnLevel, nil
+	case "error":
+		return core.ErrorLevel, nil
+	default:
+		return 0, fmt.Errorf("invalid log level: %s", name)
+	}
+}
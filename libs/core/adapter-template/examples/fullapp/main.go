@@ -0,0 +1,24 @@
+// Command fullapp is a reference application composing the monorepo libraries:
+// config (defaults, file, env, flags), log (zap), interceptor (recovery, request logging,
+// auth scoped to /users and /debug/logs) and an adapter-template HTTP adapter, wired with fx.
+//
+//	go run . --server.addr=:8080 --auth.tokens=secret=alice
+//	curl -H "Authorization: Bearer secret" localhost:8080/users
+//	curl -H "Authorization: Bearer secret" localhost:8080/debug/logs # recent log entries
+package main
+
+import (
+	"log"
+	"os"
+
+	"go.uber.org/fx"
+)
+
+func main() {
+	cfg, err := LoadConfig(os.Getenv("FULLAPP_CONFIG"), os.Args[1:])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fx.New(fx.Supply(cfg), Module).Run()
+}
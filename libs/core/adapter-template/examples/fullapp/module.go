@@ -0,0 +1,38 @@
+package main
+
+import (
+	"net/http"
+
+	adaptertemplate "github.com/phongthien99/monorepo-lib/libs/core/adapter-template"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/stdhttp"
+	"go.uber.org/fx"
+)
+
+// HTTPModule wires the mux, the controllers, the interceptor chain and the HTTP adapter.
+// Requires AppConfig and a core.ISugaredLogger (see LogModule). Lifecycle metrics are
+// recorded when an adaptertemplate.LifecycleMetrics is provided.
+var HTTPModule = fx.Module("http",
+	fx.Provide(
+		http.NewServeMux,
+		interceptor.NewDrainer[*stdhttp.HTTPMeta],
+		fx.Annotate(NewResolver, fx.As(new(interceptor.InterceptorResolver[*stdhttp.HTTPMeta]))),
+		adaptertemplate.AsRoute(NewUserController, "httpControllers"),
+		adaptertemplate.AsRoute(NewDebugLogsController, "httpControllers"),
+		fx.Annotate(
+			func(cfg AppConfig, mux *http.ServeMux, controllers []adaptertemplate.ICoreController, resolver interceptor.InterceptorResolver[*stdhttp.HTTPMeta], drainer *interceptor.Drainer[*stdhttp.HTTPMeta]) *HTTPAdapter {
+				return NewHTTPAdapter(cfg.Server.Addr, mux, controllers, resolver, drainer)
+			},
+			fx.ParamTags(``, ``, `group:"httpControllers"`),
+		),
+	),
+	fx.Invoke(func(lc fx.Lifecycle, adapter *HTTPAdapter, p adaptertemplate.MetricsParams) {
+		adapter.RegisterLifecycle(lc, adapter,
+			adaptertemplate.WithMetrics(p.Metrics),
+			adaptertemplate.WithAdapterName("http"),
+		)
+	}),
+)
+
+// Module is the whole application, given an AppConfig
+var Module = fx.Options(LogModule, HTTPModule)
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"log"
+
+	adaptertemplate "github.com/phongthien99/monorepo-lib/libs/core/adapter-template"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/stdhttp"
+)
+
+// User is the resource served by UserController
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// UserController registers the GET /users and GET /healthz handlers in the adapter's pipeline
+type UserController struct {
+	users []User
+}
+
+var _ adaptertemplate.ICoreController = (*UserController)(nil)
+
+// NewUserController creates a controller serving a fixed user list
+func NewUserController() *UserController {
+	return &UserController{
+		users: []User{{ID: "1", Name: "Alice"}, {ID: "2", Name: "Bob"}},
+	}
+}
+
+// Users will be auto-called by RegisterRouter
+func (u *UserController) Users(ctx context.Context) {
+	registerHandler(ctx, "GET /users", u.listUsers)
+}
+
+// Healthz will be auto-called by RegisterRouter
+func (u *UserController) Healthz(ctx context.Context) {
+	registerHandler(ctx, "GET /healthz", func(*interceptor.UniversalContext[*stdhttp.HTTPMeta]) (any, error) {
+		return map[string]string{"status": "ok"}, nil
+	})
+}
+
+// registerHandler registers handler under key ("METHOD /path") in the pipeline attached to ctx;
+// the adapter dispatches requests to it by key
+func registerHandler(ctx context.Context, key string, handler interceptor.NextFunc[*stdhttp.HTTPMeta]) {
+	pipeline, ok := adaptertemplate.PipelineFromContext[*stdhttp.HTTPMeta, *stdhttp.HTTPMeta](ctx)
+	if !ok {
+		log.Printf("no pipeline attached, %s not registered", key)
+		return
+	}
+	if err := pipeline.Register(key, handler); err != nil {
+		log.Printf("%s: %v", key, err)
+	}
+}
+
+// listUsers is the business handler; auth has already stored the caller's user ID
+func (u *UserController) listUsers(ctx *interceptor.UniversalContext[*stdhttp.HTTPMeta]) (any, error) {
+	return map[string]any{"caller": ctx.Value(userKey{}), "users": u.users}, nil
+}
@@ -0,0 +1,228 @@
+package examples
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	adaptertemplate "github.com/phongthien99/monorepo-lib/libs/core/adapter-template"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"go.uber.org/fx"
+)
+
+// Example 3: Adapter with an Interceptor Pipeline
+// This example shows how controllers register handlers in the adapter's pipeline and
+// requests are dispatched to them by route through interceptor.ExecuteRegistered,
+// with auth and logging interceptors around the business handler.
+// Router and Request stand in for a real framework (Gin, Echo, ...).
+
+// ErrUnauthorized is returned by AuthInterceptor for unknown tokens
+var ErrUnauthorized = errors.New("unauthorized")
+
+// Request is the native context of the example framework
+type Request struct {
+	Route  string
+	Token  string
+	Status int
+	Body   any
+}
+
+// Router maps routes to handlers, like a minimal HTTP framework
+type Router struct {
+	routes   map[string]func(*Request)
+	fallback func(*Request)
+}
+
+// NewRouter creates an empty router
+func NewRouter() *Router {
+	return &Router{routes: make(map[string]func(*Request))}
+}
+
+// Handle registers a handler for route
+func (r *Router) Handle(route string, handler func(*Request)) {
+	r.routes[route] = handler
+}
+
+// HandleFallback registers a handler for requests matching no route
+func (r *Router) HandleFallback(handler func(*Request)) {
+	r.fallback = handler
+}
+
+// Serve dispatches req to its route, then to the fallback, responding 404 if neither exists
+func (r *Router) Serve(req *Request) {
+	if handler, ok := r.routes[req.Route]; ok {
+		handler(req)
+		return
+	}
+	if r.fallback != nil {
+		r.fallback(req)
+		return
+	}
+	req.Status, req.Body = 404, "not found"
+}
+
+// RequestMeta is the metadata interceptors see for each Request
+type RequestMeta struct {
+	Token  string
+	UserID string // set by AuthInterceptor
+}
+
+// NewRequestBridge connects Router requests to the interceptor pipeline:
+// results become 200 responses, ErrUnauthorized a 401, interceptor.ErrNoHandler a 404 and other errors a 500.
+func NewRequestBridge() interceptor.Bridge[RequestMeta, *Request] {
+	return &interceptor.BaseBridge[RequestMeta, *Request]{
+		Protocol:      "http",
+		ExtractMetaFn: func(req *Request) RequestMeta { return RequestMeta{Token: req.Token} },
+		GetMethodFn:   func(req *Request) string { return req.Route },
+		OnSuccessFn: func(req *Request, result any) {
+			req.Status, req.Body = 200, result
+		},
+		OnErrorFn: func(req *Request, err error) {
+			switch {
+			case errors.Is(err, ErrUnauthorized):
+				req.Status, req.Body = 401, err.Error()
+			case errors.Is(err, interceptor.ErrNoHandler):
+				req.Status, req.Body = 404, "not found"
+			default:
+				req.Status, req.Body = 500, err.Error()
+			}
+		},
+	}
+}
+
+// LoggingInterceptor logs every request and its outcome with logf
+func LoggingInterceptor(logf func(format string, args ...any)) interceptor.Interceptor[RequestMeta] {
+	return interceptor.InterceptorFunc[RequestMeta](func(ctx *interceptor.UniversalContext[RequestMeta], next interceptor.NextFunc[RequestMeta]) (any, error) {
+		logf("→ %s", ctx.Method)
+		result, err := next(ctx)
+		if err != nil {
+			logf("← %s failed: %v", ctx.Method, err)
+			return nil, err
+		}
+		logf("← %s ok", ctx.Method)
+		return result, nil
+	})
+}
+
+// AuthInterceptor resolves the request token to a user ID, rejecting unknown tokens
+func AuthInterceptor(users map[string]string) interceptor.Interceptor[RequestMeta] {
+	return interceptor.InterceptorFunc[RequestMeta](func(ctx *interceptor.UniversalContext[RequestMeta], next interceptor.NextFunc[RequestMeta]) (any, error) {
+		userID, ok := users[ctx.Meta.Token]
+		if !ok {
+			return nil, interceptor.NewInterceptorError("auth", ErrUnauthorized)
+		}
+		ctx.Meta.UserID = userID
+		return next(ctx)
+	})
+}
+
+// InterceptedConfig holds configuration for the intercepted adapter
+type InterceptedConfig struct {
+	Controllers []adaptertemplate.ICoreController
+}
+
+// InterceptedAdapter registers controllers with a pipeline attached to ctx
+// and dispatches router requests to the handlers they registered
+type InterceptedAdapter struct {
+	adaptertemplate.BaseAdapter[InterceptedConfig]
+
+	router   *Router
+	pipeline *adaptertemplate.Pipeline[RequestMeta, *Request]
+}
+
+// NewInterceptedAdapter creates an adapter serving router requests through
+// the given interceptors (first interceptor runs first)
+func NewInterceptedAdapter(router *Router, controllers []adaptertemplate.ICoreController, interceptors ...interceptor.Interceptor[RequestMeta]) *InterceptedAdapter {
+	pipeline := adaptertemplate.WithInterceptors[RequestMeta, *Request](
+		interceptor.NewSimpleResolver(interceptors...),
+		NewRequestBridge(),
+	)
+	return &InterceptedAdapter{
+		BaseAdapter: adaptertemplate.BaseAdapter[InterceptedConfig]{
+			Config:   InterceptedConfig{Controllers: controllers},
+			Pipeline: pipeline,
+		},
+		router:   router,
+		pipeline: pipeline,
+	}
+}
+
+// OnStart implements AdapterLifecycle.OnStart
+func (a *InterceptedAdapter) OnStart(ctx context.Context) error {
+	// RegisterControllers attaches the pipeline to ctx before calling each route method
+	if err := a.RegisterControllers(ctx, a.Config.Controllers); err != nil {
+		return fmt.Errorf("failed to register controllers: %w", err)
+	}
+	// Every request is looked up by route in the handlers the controllers registered
+	a.router.HandleFallback(func(req *Request) { _, _ = a.pipeline.Dispatch(req, req.Route) })
+	return nil
+}
+
+// OnStop implements AdapterLifecycle.OnStop
+func (a *InterceptedAdapter) OnStop(ctx context.Context) error {
+	return a.RunShutdown(ctx)
+}
+
+// GreetingController registers its handlers in the adapter's pipeline
+type GreetingController struct{}
+
+var _ adaptertemplate.ICoreController = (*GreetingController)(nil)
+
+// NewGreetingController creates a new greeting controller
+func NewGreetingController() adaptertemplate.ICoreController {
+	return &GreetingController{}
+}
+
+// Greet will be auto-called by RegisterRouter
+func (g *GreetingController) Greet(ctx context.Context) {
+	pipeline, ok := adaptertemplate.PipelineFromContext[RequestMeta, *Request](ctx)
+	if !ok {
+		log.Printf("greeting: no pipeline attached, route not registered")
+		return
+	}
+	if err := pipeline.Register("GET /greet", g.greet); err != nil {
+		log.Printf("greeting: %v", err)
+	}
+}
+
+// greet is the business handler; auth has already set the user ID
+func (g *GreetingController) greet(ctx *interceptor.UniversalContext[RequestMeta]) (any, error) {
+	return "hello " + ctx.Meta.UserID, nil
+}
+
+// InterceptedModule wires the router, the greeting controller and the adapter with Fx
+var InterceptedModule = fx.Module("intercepted-adapter",
+	fx.Provide(
+		NewRouter,
+		fx.Annotate(NewGreetingController, fx.ResultTags(`group:"interceptedControllers"`)),
+		fx.Annotate(
+			func(router *Router, controllers []adaptertemplate.ICoreController) *InterceptedAdapter {
+				return NewInterceptedAdapter(router, controllers,
+					LoggingInterceptor(log.Printf),
+					AuthInterceptor(map[string]string{"secret-token": "alice"}),
+				)
+			},
+			fx.ParamTags(``, `group:"interceptedControllers"`),
+		),
+	),
+	fx.Invoke(func(lc fx.Lifecycle, adapter *InterceptedAdapter, p adaptertemplate.MetricsParams) {
+		adapter.RegisterLifecycle(lc, adapter,
+			adaptertemplate.WithMetrics(p.Metrics),
+			adaptertemplate.WithAdapterName("intercepted"),
+		)
+	}),
+)
+
+// Usage example:
+//
+//	func main() {
+//	    var router *Router
+//	    app := fx.New(InterceptedModule, fx.Populate(&router))
+//	    if err := app.Start(context.Background()); err != nil {
+//	        log.Fatal(err)
+//	    }
+//
+//	    req := &Request{Route: "GET /greet", Token: "secret-token"}
+//	    router.Serve(req) // req.Status == 200, req.Body == "hello alice"
+//	}
@@ -0,0 +1,87 @@
+package examples
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	adaptertemplate "github.com/phongthien99/monorepo-lib/libs/core/adapter-template"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+// recordingController registers a greeting handler that records when it runs
+type recordingController struct {
+	steps *[]string
+}
+
+func (r *recordingController) Greet(ctx context.Context) {
+	pipeline, _ := adaptertemplate.PipelineFromContext[RequestMeta, *Request](ctx)
+	pipeline.Register("GET /greet", func(ctx *interceptor.UniversalContext[RequestMeta]) (any, error) {
+		*r.steps = append(*r.steps, "handler")
+		return "hello " + ctx.Meta.UserID, nil
+	})
+}
+
+func TestInterceptedAdapter_RouteRunsChainThenHandler(t *testing.T) {
+	var steps []string
+	router := NewRouter()
+	logf := func(format string, args ...any) { steps = append(steps, fmt.Sprintf(format, args...)) }
+
+	adapter := NewInterceptedAdapter(router,
+		[]adaptertemplate.ICoreController{&recordingController{steps: &steps}},
+		LoggingInterceptor(logf),
+		AuthInterceptor(map[string]string{"secret-token": "alice"}),
+	)
+	if err := adapter.OnStart(context.Background()); err != nil {
+		t.Fatalf("OnStart failed: %v", err)
+	}
+
+	req := &Request{Route: "GET /greet", Token: "secret-token"}
+	router.Serve(req)
+
+	if req.Status != 200 || req.Body != "hello alice" {
+		t.Errorf("Expected 200 'hello alice', got %d %v", req.Status, req.Body)
+	}
+	want := []string{"→ GET /greet", "handler", "← GET /greet ok"}
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("Expected steps %v, got %v", want, steps)
+	}
+
+	// Verify: Auth rejects before the handler runs, logging still sees the failure
+	steps = nil
+	req = &Request{Route: "GET /greet", Token: "wrong"}
+	router.Serve(req)
+
+	if req.Status != 401 {
+		t.Errorf("Expected 401, got %d %v", req.Status, req.Body)
+	}
+	want = []string{"→ GET /greet", "← GET /greet failed: interceptor[auth]: unauthorized"}
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("Expected steps %v, got %v", want, steps)
+	}
+}
+
+func TestInterceptedModule_ServesGreeting(t *testing.T) {
+	var router *Router
+	app := fxtest.New(t, InterceptedModule, fx.Populate(&router))
+	app.RequireStart()
+	defer app.RequireStop()
+
+	req := &Request{Route: "GET /greet", Token: "secret-token"}
+	router.Serve(req)
+
+	if req.Status != 200 || req.Body != "hello alice" {
+		t.Errorf("Expected 200 'hello alice', got %d %v", req.Status, req.Body)
+	}
+
+	// Verify: a route with no registered handler is a 404 from ErrNoHandler
+	req = &Request{Route: "GET /unknown", Token: "secret-token"}
+	router.Serve(req)
+
+	if req.Status != 404 {
+		t.Errorf("Expected 404, got %d %v", req.Status, req.Body)
+	}
+}
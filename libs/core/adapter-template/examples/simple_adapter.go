@@ -40,10 +40,16 @@ func (s *SimpleAdapter) OnStart(ctx context.Context) error {
 	log.Printf("🚀 Starting %s adapter", s.Config.Name)
 
 	// Register all dynamic controllers
-	if err := adaptertemplate.RegisterRouters(s.Config.Controllers, ctx); err != nil {
+	if err := s.RegisterControllers(ctx, s.Config.Controllers); err != nil {
 		return fmt.Errorf("failed to register controllers: %w", err)
 	}
 
+	// Record cleanup steps; they run in reverse order on stop
+	s.Defer("controllers", func(ctx context.Context) error {
+		log.Printf("🧹 %s: releasing controllers", s.Config.Name)
+		return nil
+	})
+
 	log.Printf("✅ %s adapter started successfully", s.Config.Name)
 	return nil
 }
@@ -51,7 +57,12 @@ func (s *SimpleAdapter) OnStart(ctx context.Context) error {
 // OnStop implements AdapterLifecycle.OnStop
 func (s *SimpleAdapter) OnStop(ctx context.Context) error {
 	log.Printf("🧹 Stopping %s adapter", s.Config.Name)
-	// Cleanup logic here
+
+	// Run cleanup steps recorded in OnStart (LIFO)
+	if err := s.RunShutdown(ctx); err != nil {
+		return fmt.Errorf("shutdown failed: %w", err)
+	}
+
 	log.Printf("✅ %s adapter stopped successfully", s.Config.Name)
 	return nil
 }
@@ -70,8 +81,12 @@ func ForRoot(name string, controllerGroup string) fx.Option {
 				fx.ParamTags(``, fmt.Sprintf(`group:"%s"`, controllerGroup)),
 			),
 		),
-		fx.Invoke(func(lc fx.Lifecycle, adapter *SimpleAdapter) {
-			adapter.RegisterLifecycle(lc, adapter)
+		// Metrics are recorded when a LifecycleMetrics is provided, e.g. by adapterprom.Module
+		fx.Invoke(func(lc fx.Lifecycle, adapter *SimpleAdapter, p adaptertemplate.MetricsParams) {
+			adapter.RegisterLifecycle(lc, adapter,
+				adaptertemplate.WithMetrics(p.Metrics),
+				adaptertemplate.WithAdapterName(name),
+			)
 		}),
 	)
 }
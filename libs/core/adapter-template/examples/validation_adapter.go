@@ -78,11 +78,21 @@ func (v *ValidatedAdapter) OnStart(ctx context.Context) error {
 		return fmt.Errorf("config validation failed: %w", err)
 	}
 
-	// Register controllers with fail-fast
-	if err := adaptertemplate.RegisterRouters(v.Config.Controllers, ctx); err != nil {
+	// Register controllers with fail-fast (recorded in metrics when a sink is provided)
+	if err := v.RegisterControllers(ctx, v.Config.Controllers); err != nil {
 		return fmt.Errorf("controller registration failed: %w", err)
 	}
 
+	// Sub-resources opened here are torn down in reverse order by RunShutdown
+	v.Defer("retry-pool", func(ctx context.Context) error {
+		log.Printf("🧹 %s: draining retry pool (max %d)", v.Config.ServiceName, v.Config.MaxRetries)
+		return nil
+	})
+	v.Defer("listener", func(ctx context.Context) error {
+		log.Printf("🧹 %s: closing listener on port %d", v.Config.ServiceName, v.Config.Port)
+		return nil
+	})
+
 	log.Printf("✅ %s started successfully", v.Config.ServiceName)
 	return nil
 }
@@ -99,6 +109,11 @@ func (v *ValidatedAdapter) OnStop(ctx context.Context) error {
 	default:
 	}
 
+	// listener → retry-pool, each step gets a slice of the remaining budget
+	if err := v.RunShutdown(ctx); err != nil {
+		return fmt.Errorf("shutdown failed: %w", err)
+	}
+
 	log.Printf("✅ %s stopped successfully", v.Config.ServiceName)
 	return nil
 }
@@ -119,11 +134,14 @@ func ForRootValidated(port int, serviceName string, maxRetries int, controllerGr
 				fx.ParamTags(``, ``, ``, fmt.Sprintf(`group:"%s"`, controllerGroup)),
 			),
 		),
-		fx.Invoke(func(lc fx.Lifecycle, adapter *ValidatedAdapter, err error) error {
+		fx.Invoke(func(lc fx.Lifecycle, adapter *ValidatedAdapter, err error, p adaptertemplate.MetricsParams) error {
 			if err != nil {
 				return fmt.Errorf("adapter creation failed: %w", err)
 			}
-			adapter.RegisterLifecycle(lc, adapter)
+			adapter.RegisterLifecycle(lc, adapter,
+				adaptertemplate.WithMetrics(p.Metrics),
+				adaptertemplate.WithAdapterName(serviceName),
+			)
 			return nil
 		}),
 	)
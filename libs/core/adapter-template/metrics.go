@@ -0,0 +1,134 @@
+package adaptertemplate
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// LifecycleMetrics nhận metrics chuẩn của adapter lifecycle và controller registration
+// Interface nhỏ, không phụ thuộc Prometheus; implementation Prometheus nằm ở module contrib/prometheus
+//
+// Series tương ứng:
+//   - SetAdapterUp: adapter_up{adapter} (1 sau khi start thành công, 0 sau khi start fail hoặc stop)
+//   - ObserveStart/ObserveStop: adapter_start_duration_seconds, adapter_stop_duration_seconds
+//   - ControllerRegistered: controllers_registered_total{adapter, controller}
+//   - RegistrationFailed: registration_failures_total{adapter, controller}
+type LifecycleMetrics interface {
+	SetAdapterUp(adapter string, up bool)
+	ObserveStart(adapter string, d time.Duration, err error)
+	ObserveStop(adapter string, d time.Duration, err error)
+	ControllerRegistered(adapter, controller string)
+	RegistrationFailed(adapter, controller string, err error)
+}
+
+// NopLifecycleMetrics bỏ qua mọi metrics, dùng khi không có sink
+type NopLifecycleMetrics struct{}
+
+var _ LifecycleMetrics = NopLifecycleMetrics{}
+
+// SetAdapterUp implements LifecycleMetrics
+func (NopLifecycleMetrics) SetAdapterUp(string, bool) {}
+
+// ObserveStart implements LifecycleMetrics
+func (NopLifecycleMetrics) ObserveStart(string, time.Duration, error) {}
+
+// ObserveStop implements LifecycleMetrics
+func (NopLifecycleMetrics) ObserveStop(string, time.Duration, error) {}
+
+// ControllerRegistered implements LifecycleMetrics
+func (NopLifecycleMetrics) ControllerRegistered(string, string) {}
+
+// RegistrationFailed implements LifecycleMetrics
+func (NopLifecycleMetrics) RegistrationFailed(string, string, error) {}
+
+// MetricsParams lấy LifecycleMetrics từ Fx nếu đã được provide, dùng trong fx.Invoke của adapter
+// Không có sink: Metrics là nil và WithMetrics không làm gì
+//
+// Example:
+//
+//	fx.Invoke(func(lc fx.Lifecycle, adapter *HTTPAdapter, p adaptertemplate.MetricsParams) {
+//	    adapter.RegisterLifecycle(lc, adapter, adaptertemplate.WithMetrics(p.Metrics), adaptertemplate.WithAdapterName("http"))
+//	})
+type MetricsParams struct {
+	fx.In
+
+	Metrics LifecycleMetrics `optional:"true"`
+}
+
+// WithMetrics ghi thời gian start/stop, adapter_up và kết quả register controllers vào metrics
+// metrics là nil: không ghi gì (NopLifecycleMetrics)
+//
+// Behavior:
+//   - Panic trong OnStart/OnStop được tính là start/stop fail
+//   - Adapter bị tắt bằng WithEnabled: không ghi start/stop
+//   - RegisterControllers của BaseAdapter ghi từng controller (xem MetricsObserver)
+func WithMetrics(metrics LifecycleMetrics) LifecycleOption {
+	return func(o *lifecycleOptions) {
+		o.metrics = metrics
+	}
+}
+
+// WithAdapterName đặt label adapter cho metrics, mặc định là tên type của impl (ví dụ "*examples.HTTPAdapter")
+func WithAdapterName(name string) LifecycleOption {
+	return func(o *lifecycleOptions) {
+		o.name = name
+	}
+}
+
+// MetricsObserver trả về RegistrationObserver ghi kết quả từng controller vào metrics
+// Label controller là tên type của controller, ví dụ "*fullapp.UserController"
+func MetricsObserver(metrics LifecycleMetrics, adapter string) RegistrationObserver {
+	return func(controller ICoreController, err error) {
+		name := fmt.Sprintf("%T", controller)
+		if err != nil {
+			metrics.RegistrationFailed(adapter, name, err)
+			return
+		}
+		metrics.ControllerRegistered(adapter, name)
+	}
+}
+
+// adapterName trả về WithAdapterName, hoặc tên type của impl
+func (o lifecycleOptions) adapterName(impl AdapterLifecycle) string {
+	if o.name != "" {
+		return o.name
+	}
+	return fmt.Sprintf("%T", impl)
+}
+
+// instrument bọc impl để ghi metrics quanh OnStart/OnStop
+// Trả về impl nguyên vẹn khi không có WithMetrics
+func instrument(impl AdapterLifecycle, name string, metrics LifecycleMetrics) AdapterLifecycle {
+	if metrics == nil {
+		return impl
+	}
+	return &instrumentedLifecycle{impl: impl, name: name, metrics: metrics}
+}
+
+// instrumentedLifecycle ghi thời gian và kết quả OnStart/OnStop của impl
+type instrumentedLifecycle struct {
+	impl    AdapterLifecycle
+	name    string
+	metrics LifecycleMetrics
+}
+
+// OnStart implements AdapterLifecycle
+func (i *instrumentedLifecycle) OnStart(ctx context.Context) error {
+	start := time.Now()
+	err := i.impl.OnStart(ctx)
+	i.metrics.ObserveStart(i.name, time.Since(start), err)
+	i.metrics.SetAdapterUp(i.name, err == nil)
+	return err
+}
+
+// OnStop implements AdapterLifecycle
+func (i *instrumentedLifecycle) OnStop(ctx context.Context) error {
+	start := time.Now()
+	err := i.impl.OnStop(ctx)
+	i.metrics.ObserveStop(i.name, time.Since(start), err)
+	i.metrics.SetAdapterUp(i.name, false)
+	return err
+}
@@ -0,0 +1,189 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+// fakeMetrics ghi lại các metrics calls dưới dạng string
+type fakeMetrics struct {
+	mu        sync.Mutex
+	events    []string
+	durations []time.Duration
+}
+
+func (f *fakeMetrics) record(event string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.events = append(f.events, event)
+}
+
+func (f *fakeMetrics) SetAdapterUp(adapter string, up bool) {
+	if up {
+		f.record("up:" + adapter)
+		return
+	}
+	f.record("down:" + adapter)
+}
+
+func (f *fakeMetrics) ObserveStart(adapter string, d time.Duration, err error) {
+	f.mu.Lock()
+	f.durations = append(f.durations, d)
+	f.mu.Unlock()
+	f.record("start:" + adapter + ":" + resultLabel(err))
+}
+
+func (f *fakeMetrics) ObserveStop(adapter string, d time.Duration, err error) {
+	f.record("stop:" + adapter + ":" + resultLabel(err))
+}
+
+func (f *fakeMetrics) ControllerRegistered(adapter, controller string) {
+	f.record("registered:" + adapter + ":" + controller)
+}
+
+func (f *fakeMetrics) RegistrationFailed(adapter, controller string, err error) {
+	f.record("registration_failed:" + adapter + ":" + controller)
+}
+
+func (f *fakeMetrics) Events() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.events...)
+}
+
+func resultLabel(err error) string {
+	if err != nil {
+		return "error"
+	}
+	return "ok"
+}
+
+// metricsAdapter register controllers trong OnStart
+type metricsAdapter struct {
+	BaseAdapter[struct{}]
+	controllers []ICoreController
+	startErr    error
+}
+
+func (m *metricsAdapter) OnStart(ctx context.Context) error {
+	if err := m.RegisterControllers(ctx, m.controllers); err != nil {
+		return err
+	}
+	return m.startErr
+}
+
+func (m *metricsAdapter) OnStop(ctx context.Context) error {
+	return nil
+}
+
+func TestWithMetrics_SuccessfulStart(t *testing.T) {
+	metrics := &fakeMetrics{}
+	adapter := &metricsAdapter{controllers: []ICoreController{&testController{}, &testController{}}}
+
+	app := fxtest.New(t,
+		fx.Invoke(func(lc fx.Lifecycle) {
+			adapter.RegisterLifecycle(lc, adapter, WithMetrics(metrics), WithAdapterName("http"))
+		}),
+	)
+	app.RequireStart().RequireStop()
+
+	want := []string{
+		"registered:http:*adaptertemplate.testController",
+		"registered:http:*adaptertemplate.testController",
+		"start:http:ok",
+		"up:http",
+		"stop:http:ok",
+		"down:http",
+	}
+	if got := metrics.Events(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected events %v, got %v", want, got)
+	}
+	if len(metrics.durations) != 1 || metrics.durations[0] < 0 {
+		t.Errorf("Expected one start duration, got %v", metrics.durations)
+	}
+}
+
+func TestWithMetrics_FailedStart(t *testing.T) {
+	metrics := &fakeMetrics{}
+	startErr := errors.New("port in use")
+	adapter := &metricsAdapter{startErr: startErr}
+
+	app := fxtest.New(t,
+		fx.Invoke(func(lc fx.Lifecycle) {
+			adapter.RegisterLifecycle(lc, adapter, WithMetrics(metrics))
+		}),
+	)
+
+	if err := app.Start(context.Background()); !errors.Is(err, startErr) {
+		t.Fatalf("Expected start error, got: %v", err)
+	}
+
+	// Label mặc định là tên type của impl
+	want := []string{"start:*adaptertemplate.metricsAdapter:error", "down:*adaptertemplate.metricsAdapter"}
+	if got := metrics.Events(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected events %v, got %v", want, got)
+	}
+}
+
+func TestWithMetrics_RegistrationFailure(t *testing.T) {
+	metrics := &fakeMetrics{}
+	adapter := &metricsAdapter{controllers: []ICoreController{
+		&testController{},
+		&testController{shouldPanic: true},
+		&testController{},
+	}}
+
+	app := fxtest.New(t,
+		fx.Invoke(func(lc fx.Lifecycle) {
+			adapter.RegisterLifecycle(lc, adapter, WithMetrics(metrics), WithAdapterName("http"))
+		}),
+	)
+
+	if err := app.Start(context.Background()); err == nil {
+		t.Fatal("Expected registration error")
+	}
+
+	// Fail-fast: controller thứ 3 không được register
+	want := []string{
+		"registered:http:*adaptertemplate.testController",
+		"registration_failed:http:*adaptertemplate.testController",
+		"start:http:error",
+		"down:http",
+	}
+	if got := metrics.Events(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected events %v, got %v", want, got)
+	}
+}
+
+func TestWithMetrics_DisabledAdapterNotObserved(t *testing.T) {
+	metrics := &fakeMetrics{}
+	adapter := &metricsAdapter{}
+
+	app := fxtest.New(t,
+		fx.Invoke(func(lc fx.Lifecycle) {
+			adapter.RegisterLifecycle(lc, adapter, WithMetrics(metrics), WithEnabledFlag(func() bool { return false }))
+		}),
+	)
+	app.RequireStart().RequireStop()
+
+	if got := metrics.Events(); len(got) != 0 {
+		t.Errorf("Expected no events for a disabled adapter, got %v", got)
+	}
+}
+
+func TestRegisterRoutersObserved_NilObserver(t *testing.T) {
+	controller := &testController{}
+	if err := RegisterRoutersObserved([]ICoreController{controller}, context.Background(), nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !controller.getMethodCalled {
+		t.Error("Expected controller to be registered")
+	}
+}
@@ -0,0 +1,98 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+)
+
+// ErrAdapterPanic được match (errors.Is) bởi mọi PanicError
+var ErrAdapterPanic = errors.New("adapter panicked")
+
+// PanicError thay cho panic trong OnStart/OnStop, để Fx báo lỗi lifecycle bình thường
+// và vẫn rollback (gọi OnStop của các adapters đã start)
+type PanicError struct {
+	Adapter string // Tên type cụ thể của adapter, ví dụ "*examples.HTTPAdapter"
+	Phase   string // "start" hoặc "stop"
+	Value   any    // Giá trị đã panic
+	Stack   []byte // Stack trace tại thời điểm panic
+}
+
+// Error implements error interface
+// Ví dụ: "adapter *examples.HTTPAdapter start panicked: nil map"
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("adapter %s %s panicked: %v", e.Adapter, e.Phase, e.Value)
+}
+
+// Is để errors.Is(err, ErrAdapterPanic) match
+func (e *PanicError) Is(target error) bool {
+	return target == ErrAdapterPanic
+}
+
+// Unwrap trả về Value nếu panic với một error
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// LifecycleOption cấu hình BaseTemplate/RegisterLifecycle
+type LifecycleOption func(*lifecycleOptions)
+
+type lifecycleOptions struct {
+	noRecover bool
+	enabled   EnabledFunc
+	metrics   LifecycleMetrics
+	name      string // label adapter cho metrics (WithAdapterName)
+}
+
+// newLifecycleOptions áp dụng opts lên lifecycleOptions mặc định
+func newLifecycleOptions(opts []LifecycleOption) lifecycleOptions {
+	var o lifecycleOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// WithoutPanicRecovery tắt recovery: panic trong OnStart/OnStop lan thẳng qua Fx như trước
+// Dành cho teams muốn crash ngay
+func WithoutPanicRecovery() LifecycleOption {
+	return func(o *lifecycleOptions) {
+		o.noRecover = true
+	}
+}
+
+// recoverPanics bọc impl để panic trong OnStart/OnStop thành PanicError
+// Trả về impl nguyên vẹn khi có WithoutPanicRecovery
+func recoverPanics(impl AdapterLifecycle, o lifecycleOptions) AdapterLifecycle {
+	if o.noRecover {
+		return impl
+	}
+	return &recoveredLifecycle{impl: impl, name: fmt.Sprintf("%T", impl)}
+}
+
+// recoveredLifecycle chuyển panic của impl thành PanicError
+type recoveredLifecycle struct {
+	impl AdapterLifecycle
+	name string
+}
+
+// OnStart implements AdapterLifecycle
+func (r *recoveredLifecycle) OnStart(ctx context.Context) (err error) {
+	defer r.recover("start", &err)
+	return r.impl.OnStart(ctx)
+}
+
+// OnStop implements AdapterLifecycle
+func (r *recoveredLifecycle) OnStop(ctx context.Context) (err error) {
+	defer r.recover("stop", &err)
+	return r.impl.OnStop(ctx)
+}
+
+// recover gán PanicError vào *err nếu đang panic; phải được gọi trực tiếp bằng defer
+func (r *recoveredLifecycle) recover(phase string, err *error) {
+	if v := recover(); v != nil {
+		*err = &PanicError{Adapter: r.name, Phase: phase, Value: v, Stack: debug.Stack()}
+	}
+}
@@ -0,0 +1,120 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+// panickingAdapter panic trong OnStart
+type panickingAdapter struct {
+	BaseAdapter[struct{}]
+}
+
+func (p *panickingAdapter) OnStart(ctx context.Context) error {
+	var routes map[string]string
+	routes["/"] = "index" // assignment to entry in nil map
+	return nil
+}
+
+func (p *panickingAdapter) OnStop(ctx context.Context) error {
+	return nil
+}
+
+func TestRegisterLifecycle_PanicBecomesStartError(t *testing.T) {
+	var calls []string
+	first := &recordingAdapter{name: "db", calls: &calls}
+	broken := &panickingAdapter{}
+
+	app := fxtest.New(t,
+		fx.Invoke(func(lc fx.Lifecycle) {
+			BaseTemplate(lc, first)
+			broken.RegisterLifecycle(lc, broken)
+		}),
+	)
+
+	err := app.Start(context.Background())
+	if !errors.Is(err, ErrAdapterPanic) {
+		t.Fatalf("Expected ErrAdapterPanic, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "*adaptertemplate.panickingAdapter start panicked") {
+		t.Errorf("Expected error to name the adapter type and phase, got: %v", err)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || len(panicErr.Stack) == 0 {
+		t.Errorf("Expected PanicError with a stack, got: %v", err)
+	}
+
+	// Rollback: adapter đã start trước đó vẫn được stop
+	if want := []string{"start:db", "stop:db"}; strings.Join(calls, ",") != strings.Join(want, ",") {
+		t.Errorf("Expected calls %v, got %v", want, calls)
+	}
+	if got := broken.State(); got != StateFailed {
+		t.Errorf("Expected failed state, got %s", got)
+	}
+}
+
+func TestBaseTemplate_PanicInStop(t *testing.T) {
+	adapter := &lifecycleFuncs{stop: func() { panic(errors.New("close on closed channel")) }}
+
+	app := fxtest.New(t, fx.Invoke(func(lc fx.Lifecycle) {
+		BaseTemplate(lc, adapter)
+	}))
+	app.RequireStart()
+
+	err := app.Stop(context.Background())
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) || panicErr.Phase != "stop" {
+		t.Fatalf("Expected stop PanicError, got: %v", err)
+	}
+	if panicErr.Unwrap() == nil || panicErr.Unwrap().Error() != "close on closed channel" {
+		t.Errorf("Expected Unwrap to return the panicked error, got: %v", panicErr.Unwrap())
+	}
+}
+
+// hookRecorder là fx.Lifecycle giữ lại hooks để gọi trực tiếp
+// (Fx chạy OnStart trong goroutine riêng, panic ở đó không recover được trong test)
+type hookRecorder struct {
+	hooks []fx.Hook
+}
+
+func (h *hookRecorder) Append(hook fx.Hook) {
+	h.hooks = append(h.hooks, hook)
+}
+
+func TestWithoutPanicRecovery(t *testing.T) {
+	broken := &panickingAdapter{}
+	lc := &hookRecorder{}
+	broken.RegisterLifecycle(lc, broken, WithoutPanicRecovery())
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected the panic to propagate")
+		}
+	}()
+	lc.hooks[0].OnStart(context.Background())
+}
+
+// lifecycleFuncs chạy start/stop nếu có
+type lifecycleFuncs struct {
+	start, stop func()
+}
+
+func (l *lifecycleFuncs) OnStart(ctx context.Context) error {
+	if l.start != nil {
+		l.start()
+	}
+	return nil
+}
+
+func (l *lifecycleFuncs) OnStop(ctx context.Context) error {
+	if l.stop != nil {
+		l.stop()
+	}
+	return nil
+}
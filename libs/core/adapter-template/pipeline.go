@@ -0,0 +1,134 @@
+package adaptertemplate
+
+import (
+	"context"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+// RoutePipeline là pipeline được gắn vào context khi register controllers
+// Non-generic để BaseAdapter không phụ thuộc vào Meta/NativeCtx của bridge cụ thể
+type RoutePipeline interface {
+	// AttachTo trả về ctx mới mang theo pipeline
+	AttachTo(ctx context.Context) context.Context
+}
+
+// Pipeline gom resolver + bridge để mọi request của controller đi qua interceptor.ExecutePipeline
+// Kèm HandlerRegistry: controller Register handler theo key, adapter Dispatch theo key
+// Tạo bằng WithInterceptors
+type Pipeline[M any, NativeCtx any] struct {
+	resolver interceptor.InterceptorResolver[M]
+	bridge   interceptor.Bridge[M, NativeCtx]
+	handlers *interceptor.HandlerRegistry[M]
+}
+
+// pipelineKey là context key (theo cặp Meta/NativeCtx) của Pipeline
+type pipelineKey[M any, NativeCtx any] struct{}
+
+var _ RoutePipeline = (*Pipeline[struct{}, struct{}])(nil)
+
+// WithInterceptors tạo Pipeline từ resolver và bridge
+// Adapter-template không biết bridge cụ thể (Gin, gRPC, ...), chỉ nhận qua interface
+//
+// Panics:
+//   - Nếu resolver hoặc bridge là nil
+//
+// Example:
+//
+//	adapter.Pipeline = adaptertemplate.WithInterceptors[GinMeta, *gin.Context](
+//	    interceptor.NewSimpleResolver[GinMeta](loggingInterceptor, authInterceptor),
+//	    ginBridge,
+//	)
+func WithInterceptors[M any, NativeCtx any](
+	resolver interceptor.InterceptorResolver[M],
+	bridge interceptor.Bridge[M, NativeCtx],
+) *Pipeline[M, NativeCtx] {
+	if resolver == nil {
+		panic("interceptor resolver cannot be nil")
+	}
+	if bridge == nil {
+		panic("interceptor bridge cannot be nil")
+	}
+
+	return &Pipeline[M, NativeCtx]{
+		resolver: resolver,
+		bridge:   bridge,
+		handlers: interceptor.NewHandlerRegistry[M](),
+	}
+}
+
+// AttachTo implements RoutePipeline
+func (p *Pipeline[M, NativeCtx]) AttachTo(ctx context.Context) context.Context {
+	return context.WithValue(ctx, pipelineKey[M, NativeCtx]{}, p)
+}
+
+// Handle bọc business handler thành handler nhận native context
+// Mỗi lần gọi chạy: Bridge → Gate → Resolve(handlerKey) → Chain → handler → OnSuccess/OnError
+//
+// Example:
+//
+//	router.GET("/users", func(c *gin.Context) {
+//	    pipeline.Handle("GET /users", listUsers)(c)
+//	})
+func (p *Pipeline[M, NativeCtx]) Handle(handlerKey string, handler interceptor.NextFunc[M]) func(nativeCtx NativeCtx) (any, error) {
+	return func(nativeCtx NativeCtx) (any, error) {
+		return interceptor.ExecutePipeline(p.bridge, p.resolver, nativeCtx, handlerKey, handler)
+	}
+}
+
+// Register đăng ký handler cho handlerKey vào HandlerRegistry của pipeline
+// Dùng trong các method register route của controller; adapter gọi Dispatch khi có request/message
+//
+// Returns:
+//   - error: khớp interceptor.ErrHandlerExists nếu handlerKey đã có handler
+//
+// Example:
+//
+//	func (c *OrderController) OrderCreated(ctx context.Context) {
+//	    p, _ := adaptertemplate.PipelineFromContext[KafkaMeta, *kafka.Message](ctx)
+//	    p.Register("orders.created", c.onOrderCreated)
+//	}
+func (p *Pipeline[M, NativeCtx]) Register(handlerKey string, handler interceptor.NextFunc[M]) error {
+	return p.handlers.Register(handlerKey, handler)
+}
+
+// Dispatch chạy handler đã đăng ký cho handlerKey qua interceptor.ExecuteRegistered
+// Không có handler (và không có wildcard) → interceptor.NoHandlerError qua bridge.OnError
+//
+// Example:
+//
+//	for msg := range consumer.Messages() {
+//	    pipeline.Dispatch(msg, msg.Topic)
+//	}
+func (p *Pipeline[M, NativeCtx]) Dispatch(nativeCtx NativeCtx, handlerKey string) (any, error) {
+	return interceptor.ExecuteRegistered(p.bridge, p.resolver, p.handlers, nativeCtx, handlerKey)
+}
+
+// Handlers trả về HandlerRegistry của pipeline (ví dụ để Deregister hoặc liệt kê Keys)
+func (p *Pipeline[M, NativeCtx]) Handlers() *interceptor.HandlerRegistry[M] {
+	return p.handlers
+}
+
+// PipelineFromContext lấy Pipeline đã được BaseAdapter.RegisterControllers gắn vào ctx
+// Dùng trong các method register route của controller
+//
+// Returns:
+//   - *Pipeline: Pipeline khớp Meta/NativeCtx, nil nếu không có
+//   - bool: true nếu adapter có cấu hình pipeline cho cặp type này
+//
+// Example:
+//
+//	func (u *UserController) GetUsers(ctx context.Context) {
+//	    handler := u.listUsers
+//	    if p, ok := adaptertemplate.PipelineFromContext[GinMeta, *gin.Context](ctx); ok {
+//	        wrapped := p.Handle("GET /users", handler)
+//	        u.router.GET("/users", func(c *gin.Context) { wrapped(c) })
+//	    }
+//	}
+func PipelineFromContext[M any, NativeCtx any](ctx context.Context) (*Pipeline[M, NativeCtx], bool) {
+	if ctx == nil {
+		return nil, false
+	}
+	p, ok := ctx.Value(pipelineKey[M, NativeCtx]{}).(*Pipeline[M, NativeCtx])
+	return p, ok
+}
@@ -0,0 +1,221 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+// fakeRequest là native context của router giả lập
+type fakeRequest struct {
+	Path   string
+	UserID string
+	Status int
+	Body   any
+}
+
+// fakeRouter giả lập 1 framework HTTP: map path → handler
+type fakeRouter struct {
+	routes map[string]func(*fakeRequest)
+}
+
+func (r *fakeRouter) Handle(path string, handler func(*fakeRequest)) {
+	r.routes[path] = handler
+}
+
+func (r *fakeRouter) Serve(req *fakeRequest) {
+	r.routes[req.Path](req)
+}
+
+type fakeMeta struct {
+	UserID string
+}
+
+func newFakeBridge() *interceptor.BaseBridge[fakeMeta, *fakeRequest] {
+	return &interceptor.BaseBridge[fakeMeta, *fakeRequest]{
+		Protocol:      "http",
+		ExtractMetaFn: func(req *fakeRequest) fakeMeta { return fakeMeta{UserID: req.UserID} },
+		GetMethodFn:   func(req *fakeRequest) string { return req.Path },
+		OnSuccessFn: func(req *fakeRequest, result any) {
+			req.Status, req.Body = 200, result
+		},
+		OnErrorFn: func(req *fakeRequest, err error) {
+			req.Status, req.Body = 500, err.Error()
+		},
+	}
+}
+
+// userController register route qua pipeline lấy từ ctx
+type userController struct {
+	router *fakeRouter
+}
+
+func (u *userController) GetUser(ctx context.Context) {
+	p, ok := PipelineFromContext[fakeMeta, *fakeRequest](ctx)
+	if !ok {
+		panic("pipeline not attached")
+	}
+
+	handle := p.Handle("GET /user", func(ctx *interceptor.UniversalContext[fakeMeta]) (any, error) {
+		return "hello " + ctx.Meta.UserID, nil
+	})
+	u.router.Handle("/user", func(req *fakeRequest) { _, _ = handle(req) })
+}
+
+// pipelineAdapter là adapter tối giản dùng BaseAdapter.RegisterControllers
+type pipelineAdapter struct {
+	BaseAdapter[[]ICoreController]
+}
+
+func (a *pipelineAdapter) OnStart(ctx context.Context) error {
+	return a.RegisterControllers(ctx, a.Config)
+}
+
+func (a *pipelineAdapter) OnStop(ctx context.Context) error {
+	return a.RunShutdown(ctx)
+}
+
+func TestPipeline_FxEndToEnd(t *testing.T) {
+	var observed []string
+	logging := interceptor.InterceptorFunc[fakeMeta](func(ctx *interceptor.UniversalContext[fakeMeta], next interceptor.NextFunc[fakeMeta]) (any, error) {
+		observed = append(observed, ctx.Protocol+" "+ctx.Method+" "+ctx.Meta.UserID)
+		return next(ctx)
+	})
+
+	var router *fakeRouter
+	app := fxtest.New(t,
+		fx.Provide(
+			func() *fakeRouter { return &fakeRouter{routes: make(map[string]func(*fakeRequest))} },
+			AsRoute(func(r *fakeRouter) *userController { return &userController{router: r} }, "controllers"),
+			fx.Annotate(func(controllers []ICoreController) *pipelineAdapter {
+				adapter := &pipelineAdapter{}
+				adapter.Config = controllers
+				adapter.Pipeline = WithInterceptors[fakeMeta, *fakeRequest](
+					interceptor.NewSimpleResolver[fakeMeta](logging),
+					newFakeBridge(),
+				)
+				return adapter
+			}, fx.ParamTags(`group:"controllers"`)),
+		),
+		fx.Invoke(func(lc fx.Lifecycle, adapter *pipelineAdapter) {
+			adapter.RegisterLifecycle(lc, adapter)
+		}),
+		fx.Populate(&router),
+	)
+	app.RequireStart()
+	defer app.RequireStop()
+
+	req := &fakeRequest{Path: "/user", UserID: "u1"}
+	router.Serve(req)
+
+	// Verify: Interceptor thấy request, bridge ghi response
+	if len(observed) != 1 || observed[0] != "http /user u1" {
+		t.Errorf("Expected interceptor to observe [http /user u1], got %v", observed)
+	}
+	if req.Status != 200 || req.Body != "hello u1" {
+		t.Errorf("Expected 200 hello u1, got %d %v", req.Status, req.Body)
+	}
+}
+
+func TestPipeline_HandleRoutesErrorsToBridge(t *testing.T) {
+	denied := errors.New("denied")
+	p := WithInterceptors[fakeMeta, *fakeRequest](
+		interceptor.NewSimpleResolver[fakeMeta](interceptor.InterceptorFunc[fakeMeta](
+			func(ctx *interceptor.UniversalContext[fakeMeta], next interceptor.NextFunc[fakeMeta]) (any, error) {
+				return nil, denied
+			},
+		)),
+		newFakeBridge(),
+	)
+
+	called := false
+	req := &fakeRequest{Path: "/user"}
+	_, err := p.Handle("GET /user", func(ctx *interceptor.UniversalContext[fakeMeta]) (any, error) {
+		called = true
+		return nil, nil
+	})(req)
+
+	if !errors.Is(err, denied) {
+		t.Errorf("Expected denied error, got %v", err)
+	}
+	if called {
+		t.Error("Expected handler not to run when interceptor rejects")
+	}
+	if req.Status != 500 {
+		t.Errorf("Expected bridge OnError to set 500, got %d", req.Status)
+	}
+}
+
+func TestRegisterControllers_WithoutPipeline(t *testing.T) {
+	var attached bool
+	controller := &probeController{probe: func(ctx context.Context) {
+		_, attached = PipelineFromContext[fakeMeta, *fakeRequest](ctx)
+	}}
+
+	adapter := &BaseAdapter[struct{}]{}
+	if err := adapter.RegisterControllers(context.Background(), []ICoreController{controller}); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if attached {
+		t.Error("Expected no pipeline in ctx when adapter has none")
+	}
+}
+
+type probeController struct {
+	probe func(ctx context.Context)
+}
+
+func (p *probeController) Register(ctx context.Context) {
+	p.probe(ctx)
+}
+
+func TestWithInterceptors_PanicsOnNil(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for nil bridge")
+		}
+	}()
+	WithInterceptors[fakeMeta, *fakeRequest](interceptor.NewSimpleResolver[fakeMeta](), nil)
+}
+
+func TestPipeline_RegisterAndDispatch(t *testing.T) {
+	p := WithInterceptors[fakeMeta, *fakeRequest](interceptor.NewSimpleResolver[fakeMeta](), newFakeBridge())
+
+	// Controller đăng ký handler một lần, adapter dispatch theo key
+	if err := p.Register("orders.created", func(ctx *interceptor.UniversalContext[fakeMeta]) (any, error) {
+		return "created by " + ctx.Meta.UserID, nil
+	}); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	req := &fakeRequest{Path: "orders.created", UserID: "u1"}
+	if _, err := p.Dispatch(req, "orders.created"); err != nil {
+		t.Fatalf("Dispatch failed: %v", err)
+	}
+	if req.Status != 200 || req.Body != "created by u1" {
+		t.Errorf("Expected 200 'created by u1', got %d %v", req.Status, req.Body)
+	}
+
+	// Verify: đăng ký trùng key bị từ chối
+	if err := p.Register("orders.created", func(*interceptor.UniversalContext[fakeMeta]) (any, error) { return nil, nil }); !errors.Is(err, interceptor.ErrHandlerExists) {
+		t.Errorf("Expected ErrHandlerExists, got %v", err)
+	}
+
+	// Verify: key không có handler → ErrNoHandler qua bridge.OnError
+	req = &fakeRequest{Path: "orders.deleted"}
+	if _, err := p.Dispatch(req, "orders.deleted"); !errors.Is(err, interceptor.ErrNoHandler) {
+		t.Errorf("Expected ErrNoHandler, got %v", err)
+	}
+	if req.Status != 500 {
+		t.Errorf("Expected bridge OnError to set 500, got %d", req.Status)
+	}
+
+	if keys := p.Handlers().Keys(); len(keys) != 1 || keys[0] != "orders.created" {
+		t.Errorf("Expected [orders.created], got %v", keys)
+	}
+}
@@ -0,0 +1,68 @@
+package adaptertemplate
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// RouteInfo mô tả 1 controller và các methods RegisterRouter sẽ gọi
+type RouteInfo struct {
+	Controller string   `json:"controller"` // Tên type cụ thể, ví dụ "*examples.UserController"
+	Methods    []string `json:"methods"`    // Methods có signature func(context.Context), theo thứ tự gọi
+}
+
+// RouteManifest là document JSON trả về bởi RouteManifestJSON
+type RouteManifest struct {
+	Controllers []RouteInfo `json:"controllers"`
+}
+
+// ListRoutes liệt kê methods của từng controller mà RegisterRouter sẽ gọi, không gọi method nào
+// Controllers nil bị bỏ qua, giống RegisterRouter
+//
+// Example:
+//
+//	for _, r := range ListRoutes(controllers) {
+//	    log.Printf("%s: %v", r.Controller, r.Methods) // *examples.UserController: [CreateUser GetUsers]
+//	}
+func ListRoutes(controllers []ICoreController) []RouteInfo {
+	routes := make([]RouteInfo, 0, len(controllers))
+	for _, controller := range controllers {
+		if controller == nil {
+			continue
+		}
+
+		valueType := reflect.TypeOf(controller)
+		methods := make([]string, 0, valueType.NumMethod())
+		for i := 0; i < valueType.NumMethod(); i++ {
+			method := valueType.Method(i)
+			// method.Type có receiver ở vị trí đầu, dùng method value type để validate như RegisterRouter
+			if isValidDynamicMethod(reflect.ValueOf(controller).Method(i).Type()) {
+				methods = append(methods, method.Name)
+			}
+		}
+
+		routes = append(routes, RouteInfo{Controller: valueType.String(), Methods: methods})
+	}
+	return routes
+}
+
+// RouteManifestJSON trả về ListRoutes dưới dạng JSON, cho tooling/docs hoặc admin endpoint /routes
+//
+// Format:
+//
+//	{"controllers":[{"controller":"*examples.UserController","methods":["CreateUser","GetUsers"]}]}
+//
+// Example:
+//
+//	mux.HandleFunc("/routes", func(w http.ResponseWriter, r *http.Request) {
+//	    manifest, err := adaptertemplate.RouteManifestJSON(controllers)
+//	    if err != nil {
+//	        http.Error(w, err.Error(), http.StatusInternalServerError)
+//	        return
+//	    }
+//	    w.Header().Set("Content-Type", "application/json")
+//	    w.Write(manifest)
+//	})
+func RouteManifestJSON(controllers []ICoreController) ([]byte, error) {
+	return json.Marshal(RouteManifest{Controllers: ListRoutes(controllers)})
+}
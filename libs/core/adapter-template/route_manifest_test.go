@@ -0,0 +1,63 @@
+package adaptertemplate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestListRoutes(t *testing.T) {
+	controller := &testController{}
+	routes := ListRoutes([]ICoreController{controller, nil})
+
+	expected := []RouteInfo{{
+		Controller: "*adaptertemplate.testController",
+		Methods:    []string{"CreateUser", "GetUsers"},
+	}}
+	if !reflect.DeepEqual(routes, expected) {
+		t.Errorf("Expected %v, got %v", expected, routes)
+	}
+
+	// ListRoutes không gọi methods
+	if controller.getMethodCalled || controller.postMethodCalled {
+		t.Error("ListRoutes should not call controller methods")
+	}
+}
+
+func TestRouteManifestJSON(t *testing.T) {
+	data, err := RouteManifestJSON([]ICoreController{&testController{}})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var manifest struct {
+		Controllers []struct {
+			Controller string   `json:"controller"`
+			Methods    []string `json:"methods"`
+		} `json:"controllers"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("Expected valid JSON, got %s: %v", data, err)
+	}
+
+	if len(manifest.Controllers) != 1 {
+		t.Fatalf("Expected 1 controller, got %s", data)
+	}
+	got := manifest.Controllers[0]
+	if got.Controller != "*adaptertemplate.testController" {
+		t.Errorf("Expected controller type name, got %q", got.Controller)
+	}
+	if !reflect.DeepEqual(got.Methods, []string{"CreateUser", "GetUsers"}) {
+		t.Errorf("Expected CreateUser and GetUsers, got %v", got.Methods)
+	}
+}
+
+func TestRouteManifestJSON_Empty(t *testing.T) {
+	data, err := RouteManifestJSON(nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if string(data) != `{"controllers":[]}` {
+		t.Errorf("Expected empty controllers array, got %s", data)
+	}
+}
@@ -118,13 +118,30 @@ func RegisterRouter(controller ICoreController, ctx context.Context) error {
 //	    return nil
 //	}
 func RegisterRouters(controllers []ICoreController, ctx context.Context) error {
+	return RegisterRoutersObserved(controllers, ctx, nil)
+}
+
+// RegistrationObserver được gọi sau mỗi controller được register, err là nil nếu thành công
+type RegistrationObserver func(controller ICoreController, err error)
+
+// RegisterRoutersObserved giống RegisterRouters, gọi observe sau mỗi controller (kể cả controller fail)
+// observe là nil: giống hệt RegisterRouters
+//
+// Example:
+//
+//	err := RegisterRoutersObserved(controllers, ctx, MetricsObserver(metrics, "http"))
+func RegisterRoutersObserved(controllers []ICoreController, ctx context.Context, observe RegistrationObserver) error {
 	// Sử dụng context.Background() nếu ctx nil
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
 	for i, controller := range controllers {
-		if err := RegisterRouter(controller, ctx); err != nil {
+		err := RegisterRouter(controller, ctx)
+		if observe != nil && controller != nil {
+			observe(controller, err)
+		}
+		if err != nil {
 			// Fail-fast: dừng ngay và return error với controller index
 			return fmt.Errorf("controller[%d]: %w", i, err)
 		}
@@ -0,0 +1,98 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Runner chạy lifecycle của nhiều adapters mà không cần Fx
+// Dùng trực tiếp trong main() hoặc trong tests
+//
+// Behavior:
+//   - Start() gọi OnStart theo thứ tự đăng ký
+//   - Stop() gọi OnStop theo thứ tự NGƯỢC LẠI (giống Fx)
+//   - Nếu 1 adapter start fail, các adapter đã start sẽ được stop (rollback)
+//
+// Example:
+//
+//	runner := NewRunner(dbAdapter, httpAdapter)
+//	if err := runner.Start(ctx); err != nil {
+//	    log.Fatalf("start failed: %v", err)
+//	}
+//	defer runner.Stop(context.Background())
+type Runner struct {
+	mu       sync.Mutex
+	adapters []AdapterLifecycle
+	started  int // Số adapters đã start thành công
+}
+
+// NewRunner tạo Runner với danh sách adapters theo thứ tự start
+//
+// Panics:
+//   - Nếu có adapter là nil
+func NewRunner(adapters ...AdapterLifecycle) *Runner {
+	for i, adapter := range adapters {
+		if adapter == nil {
+			panic(fmt.Sprintf("adapter[%d] cannot be nil", i))
+		}
+	}
+	return &Runner{adapters: adapters}
+}
+
+// Start gọi OnStart của từng adapter theo thứ tự
+//
+// Returns:
+//   - error: Error của adapter đầu tiên fail (fail-fast), kèm error rollback nếu có
+func (r *Runner) Start(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := r.started; i < len(r.adapters); i++ {
+		if err := r.adapters[i].OnStart(ctx); err != nil {
+			startErr := fmt.Errorf("adapter[%d] start: %w", i, err)
+			// Rollback: stop các adapters đã start
+			if stopErr := r.stopLocked(ctx); stopErr != nil {
+				return errors.Join(startErr, stopErr)
+			}
+			return startErr
+		}
+		r.started = i + 1
+	}
+
+	return nil
+}
+
+// Stop gọi OnStop của các adapters đã start theo thứ tự ngược lại
+// Tiếp tục stop các adapters còn lại kể cả khi có adapter fail
+//
+// Returns:
+//   - error: Tất cả errors gộp bằng errors.Join, nil nếu không có lỗi
+func (r *Runner) Stop(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.stopLocked(ctx)
+}
+
+// stopLocked stop các adapters đã start theo thứ tự ngược lại (caller giữ lock)
+func (r *Runner) stopLocked(ctx context.Context) error {
+	var errs []error
+	for i := r.started - 1; i >= 0; i-- {
+		if err := r.adapters[i].OnStop(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("adapter[%d] stop: %w", i, err))
+		}
+	}
+	r.started = 0
+
+	return errors.Join(errs...)
+}
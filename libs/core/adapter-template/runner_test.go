@@ -0,0 +1,113 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// Mock adapter ghi lại thứ tự các lifecycle calls
+type recordingAdapter struct {
+	name     string
+	calls    *[]string
+	startErr error
+	stopErr  error
+}
+
+func (r *recordingAdapter) OnStart(ctx context.Context) error {
+	*r.calls = append(*r.calls, "start:"+r.name)
+	return r.startErr
+}
+
+func (r *recordingAdapter) OnStop(ctx context.Context) error {
+	*r.calls = append(*r.calls, "stop:"+r.name)
+	return r.stopErr
+}
+
+func TestRunner_StartStopOrder(t *testing.T) {
+	var calls []string
+	runner := NewRunner(
+		&recordingAdapter{name: "db", calls: &calls},
+		&recordingAdapter{name: "http", calls: &calls},
+	)
+
+	if err := runner.Start(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if err := runner.Stop(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Verify: Start theo thứ tự, Stop ngược lại
+	expected := []string{"start:db", "start:http", "stop:http", "stop:db"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestRunner_StartFailureRollsBack(t *testing.T) {
+	var calls []string
+	startErr := errors.New("port in use")
+	runner := NewRunner(
+		&recordingAdapter{name: "db", calls: &calls},
+		&recordingAdapter{name: "http", calls: &calls, startErr: startErr},
+		&recordingAdapter{name: "worker", calls: &calls},
+	)
+
+	err := runner.Start(context.Background())
+	if !errors.Is(err, startErr) {
+		t.Fatalf("Expected start error, got: %v", err)
+	}
+
+	// Verify: worker không được start, db được rollback
+	expected := []string{"start:db", "start:http", "stop:db"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestRunner_StopContinuesOnError(t *testing.T) {
+	var calls []string
+	stopErr := errors.New("flush failed")
+	runner := NewRunner(
+		&recordingAdapter{name: "db", calls: &calls},
+		&recordingAdapter{name: "http", calls: &calls, stopErr: stopErr},
+	)
+
+	runner.Start(nil)
+	err := runner.Stop(nil)
+
+	if !errors.Is(err, stopErr) {
+		t.Errorf("Expected stop error, got: %v", err)
+	}
+
+	expected := []string{"start:db", "start:http", "stop:http", "stop:db"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestRunner_StopIsIdempotent(t *testing.T) {
+	var calls []string
+	runner := NewRunner(&recordingAdapter{name: "db", calls: &calls})
+
+	runner.Start(context.Background())
+	runner.Stop(context.Background())
+	runner.Stop(context.Background())
+
+	expected := []string{"start:db", "stop:db"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
+
+func TestNewRunner_NilAdapter(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for nil adapter")
+		}
+	}()
+
+	NewRunner(nil)
+}
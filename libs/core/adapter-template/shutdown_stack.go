@@ -0,0 +1,98 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ShutdownFunc là 1 bước cleanup được đăng ký qua Defer
+type ShutdownFunc func(ctx context.Context) error
+
+// shutdownStep là 1 bước cleanup có tên
+type shutdownStep struct {
+	name string
+	fn   ShutdownFunc
+}
+
+// ShutdownStack ghi lại các bước cleanup trong OnStart và chạy chúng theo thứ tự LIFO trong OnStop
+// Zero value sẵn sàng sử dụng, an toàn khi dùng đồng thời
+//
+// Example:
+//
+//	func (h *HttpAdapter) OnStart(ctx context.Context) error {
+//	    ln, _ := net.Listen("tcp", h.Config.Addr)
+//	    h.Defer("listener", func(ctx context.Context) error { return ln.Close() })
+//
+//	    pool := db.Open(h.Config.DSN)
+//	    h.Defer("db-pool", func(ctx context.Context) error { return pool.Close() })
+//	    return nil
+//	}
+//
+//	func (h *HttpAdapter) OnStop(ctx context.Context) error {
+//	    return h.RunShutdown(ctx) // db-pool → listener
+//	}
+type ShutdownStack struct {
+	mu    sync.Mutex
+	steps []shutdownStep
+}
+
+// Defer đăng ký 1 bước cleanup, sẽ chạy theo thứ tự ngược lại khi RunShutdown
+func (s *ShutdownStack) Defer(name string, fn ShutdownFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.steps = append(s.steps, shutdownStep{name: name, fn: fn})
+}
+
+// RunShutdown chạy tất cả các bước cleanup theo thứ tự LIFO
+//
+// Behavior:
+//   - Nếu ctx có deadline, mỗi bước nhận 1 phần đều của thời gian còn lại
+//     (remaining / số bước còn lại), bước chạy nhanh nhường thời gian cho bước sau
+//   - Tiếp tục chạy các bước còn lại kể cả khi 1 bước fail
+//   - Khi ctx hết hạn, các bước còn lại bị skip và được báo lỗi
+//   - Stack được làm rỗng sau khi chạy
+//
+// Returns:
+//   - error: Tất cả errors (kèm tên bước) gộp bằng errors.Join, nil nếu không có lỗi
+func (s *ShutdownStack) RunShutdown(ctx context.Context) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	s.mu.Lock()
+	steps := s.steps
+	s.steps = nil
+	s.mu.Unlock()
+
+	var errs []error
+	for i := len(steps) - 1; i >= 0; i-- {
+		step := steps[i]
+
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: skipped: %w", step.name, err))
+			continue
+		}
+
+		if err := runStep(ctx, step, i+1); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", step.name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// runStep chạy 1 bước với timeout = thời gian còn lại / số bước còn lại
+func runStep(ctx context.Context, step shutdownStep, remainingSteps int) error {
+	if deadline, ok := ctx.Deadline(); ok {
+		slice := time.Until(deadline) / time.Duration(remainingSteps)
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, slice)
+		defer cancel()
+	}
+
+	return step.fn(ctx)
+}
@@ -0,0 +1,150 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestShutdownStack_LIFOOrder(t *testing.T) {
+	var calls []string
+	var stack ShutdownStack
+
+	for _, name := range []string{"listener", "pool", "consumer"} {
+		stack.Defer(name, func(ctx context.Context) error {
+			calls = append(calls, name)
+			return nil
+		})
+	}
+
+	if err := stack.RunShutdown(context.Background()); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// Verify: Chạy ngược thứ tự đăng ký
+	expected := []string{"consumer", "pool", "listener"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+
+	// Verify: Stack rỗng sau khi chạy
+	calls = nil
+	stack.RunShutdown(context.Background())
+	if len(calls) != 0 {
+		t.Errorf("Expected empty stack after RunShutdown, got calls %v", calls)
+	}
+}
+
+func TestShutdownStack_ErrorAggregation(t *testing.T) {
+	var calls []string
+	var stack ShutdownStack
+	poolErr := errors.New("pool busy")
+	listenerErr := errors.New("already closed")
+
+	stack.Defer("listener", func(ctx context.Context) error {
+		calls = append(calls, "listener")
+		return listenerErr
+	})
+	stack.Defer("pool", func(ctx context.Context) error {
+		calls = append(calls, "pool")
+		return poolErr
+	})
+
+	err := stack.RunShutdown(context.Background())
+
+	// Verify: Các bước vẫn chạy khi có lỗi
+	if len(calls) != 2 {
+		t.Errorf("Expected all steps to run, got %v", calls)
+	}
+	if !errors.Is(err, poolErr) || !errors.Is(err, listenerErr) {
+		t.Errorf("Expected both errors joined, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "pool: pool busy") || !strings.Contains(err.Error(), "listener: already closed") {
+		t.Errorf("Expected errors to carry step names, got: %v", err)
+	}
+}
+
+func TestShutdownStack_BudgetSlices(t *testing.T) {
+	var stack ShutdownStack
+	var budgets []time.Duration
+
+	for _, name := range []string{"a", "b"} {
+		stack.Defer(name, func(ctx context.Context) error {
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Errorf("Expected step %s to have a deadline", name)
+			}
+			budgets = append(budgets, time.Until(deadline))
+			return nil
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	stack.RunShutdown(ctx)
+
+	// Verify: Bước đầu nhận ~1/2 budget, bước cuối nhận phần còn lại
+	if budgets[0] > 110*time.Millisecond {
+		t.Errorf("Expected first step to get about half the budget, got %v", budgets[0])
+	}
+	if budgets[1] < 150*time.Millisecond {
+		t.Errorf("Expected last step to get the remaining budget, got %v", budgets[1])
+	}
+}
+
+func TestShutdownStack_BudgetExhausted(t *testing.T) {
+	var calls []string
+	var stack ShutdownStack
+
+	stack.Defer("listener", func(ctx context.Context) error {
+		calls = append(calls, "listener")
+		return nil
+	})
+	stack.Defer("slow-consumer", func(ctx context.Context) error {
+		calls = append(calls, "slow-consumer")
+		<-ctx.Done()
+		time.Sleep(30 * time.Millisecond) // Vượt quá tổng budget
+		return ctx.Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 40*time.Millisecond)
+	defer cancel()
+	err := stack.RunShutdown(ctx)
+
+	// Verify: listener bị skip vì hết budget
+	if !reflect.DeepEqual(calls, []string{"slow-consumer"}) {
+		t.Errorf("Expected only slow-consumer to run, got %v", calls)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline exceeded, got: %v", err)
+	}
+	if !strings.Contains(err.Error(), "listener: skipped") {
+		t.Errorf("Expected skipped step to be reported, got: %v", err)
+	}
+}
+
+func TestBaseAdapter_ShutdownStack(t *testing.T) {
+	var calls []string
+	adapter := &BaseAdapter[string]{Config: "test"}
+
+	adapter.Defer("first", func(ctx context.Context) error {
+		calls = append(calls, "first")
+		return nil
+	})
+	adapter.Defer("second", func(ctx context.Context) error {
+		calls = append(calls, "second")
+		return nil
+	})
+
+	if err := adapter.RunShutdown(nil); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := []string{"second", "first"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected calls %v, got %v", expected, calls)
+	}
+}
@@ -0,0 +1,160 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// AdapterState là trạng thái lifecycle của adapter
+//
+// Chuyển trạng thái:
+//
+//	Created → Starting → Started → Stopping → Stopped
+//	              ↓                    ↓
+//	       Failed / Skipped          Failed
+//
+// Skipped: adapter bị tắt bằng WithEnabled, OnStart/OnStop của impl không được gọi
+type AdapterState int32
+
+const (
+	// StateCreated: adapter chưa start lần nào (zero value)
+	StateCreated AdapterState = iota
+	// StateStarting: OnStart đang chạy
+	StateStarting
+	// StateStarted: OnStart thành công
+	StateStarted
+	// StateStopping: OnStop đang chạy
+	StateStopping
+	// StateStopped: OnStop thành công, có thể start lại
+	StateStopped
+	// StateFailed: OnStart hoặc OnStop trả về error, có thể start lại
+	StateFailed
+	// StateSkipped: adapter bị tắt (xem WithEnabled), OnStart/OnStop là no-op; start lại sẽ kiểm tra lại
+	StateSkipped
+)
+
+// String trả về tên trạng thái: "created", "starting", ...
+func (s AdapterState) String() string {
+	switch s {
+	case StateCreated:
+		return "created"
+	case StateStarting:
+		return "starting"
+	case StateStarted:
+		return "started"
+	case StateStopping:
+		return "stopping"
+	case StateStopped:
+		return "stopped"
+	case StateFailed:
+		return "failed"
+	case StateSkipped:
+		return "skipped"
+	default:
+		return fmt.Sprintf("AdapterState(%d)", int32(s))
+	}
+}
+
+// Ready báo adapter đang phục vụ (StateStarted), dùng cho readiness check
+func (s AdapterState) Ready() bool {
+	return s == StateStarted
+}
+
+// Healthy báo adapter không lỗi (khác StateFailed), dùng cho liveness/health check
+// Adapter bị tắt (StateSkipped) là healthy nhưng không Ready: không làm fail health check
+func (s AdapterState) Healthy() bool {
+	return s != StateFailed
+}
+
+var (
+	// ErrAlreadyStarted được trả về khi OnStart được gọi lúc adapter đang starting/started/stopping
+	ErrAlreadyStarted = errors.New("adapter already started")
+
+	// ErrNotStarted được trả về khi OnStop được gọi lúc adapter chưa started
+	ErrNotStarted = errors.New("adapter not started")
+)
+
+// lifecycleState giữ AdapterState, đọc/ghi atomic nên State() an toàn khi gọi đồng thời
+// Zero value là StateCreated
+type lifecycleState struct {
+	state atomic.Int32
+}
+
+// State trả về trạng thái hiện tại
+func (l *lifecycleState) State() AdapterState {
+	return AdapterState(l.state.Load())
+}
+
+// transition chuyển sang to nếu trạng thái hiện tại thuộc from
+// Trả về trạng thái hiện tại và false nếu không được phép chuyển
+func (l *lifecycleState) transition(to AdapterState, from ...AdapterState) (AdapterState, bool) {
+	for {
+		current := l.State()
+		allowed := false
+		for _, s := range from {
+			if current == s {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return current, false
+		}
+		if l.state.CompareAndSwap(int32(current), int32(to)) {
+			return current, true
+		}
+	}
+}
+
+// trackedLifecycle bọc AdapterLifecycle để cập nhật lifecycleState quanh OnStart/OnStop
+type trackedLifecycle struct {
+	state   *lifecycleState
+	impl    AdapterLifecycle
+	enabled EnabledFunc // nil: luôn bật
+}
+
+// OnStart implements AdapterLifecycle
+func (t *trackedLifecycle) OnStart(ctx context.Context) error {
+	if current, ok := t.state.transition(StateStarting, StateCreated, StateStopped, StateFailed, StateSkipped); !ok {
+		return fmt.Errorf("%w (state: %s)", ErrAlreadyStarted, current)
+	}
+
+	if t.enabled != nil {
+		enabled, err := t.enabled(ctx)
+		if err != nil {
+			t.state.state.Store(int32(StateFailed))
+			return fmt.Errorf("adapter enabled check: %w", err)
+		}
+		if !enabled {
+			t.state.state.Store(int32(StateSkipped))
+			return nil
+		}
+	}
+
+	if err := t.impl.OnStart(ctx); err != nil {
+		t.state.state.Store(int32(StateFailed))
+		return err
+	}
+	t.state.state.Store(int32(StateStarted))
+	return nil
+}
+
+// OnStop implements AdapterLifecycle
+// Adapter đang StateSkipped: no-op
+func (t *trackedLifecycle) OnStop(ctx context.Context) error {
+	if t.state.State() == StateSkipped {
+		return nil
+	}
+	if current, ok := t.state.transition(StateStopping, StateStarted); !ok {
+		return fmt.Errorf("%w (state: %s)", ErrNotStarted, current)
+	}
+
+	if err := t.impl.OnStop(ctx); err != nil {
+		t.state.state.Store(int32(StateFailed))
+		return err
+	}
+	t.state.state.Store(int32(StateStopped))
+	return nil
+}
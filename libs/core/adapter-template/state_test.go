@@ -0,0 +1,131 @@
+package adaptertemplate
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"go.uber.org/fx"
+	"go.uber.org/fx/fxtest"
+)
+
+// stateAdapter ghi lại State() quan sát được bên trong OnStart/OnStop
+type stateAdapter struct {
+	BaseAdapter[struct{}]
+	observed []AdapterState
+	starts   int
+	startErr error
+}
+
+func (s *stateAdapter) OnStart(ctx context.Context) error {
+	s.starts++
+	s.observed = append(s.observed, s.State())
+	return s.startErr
+}
+
+func (s *stateAdapter) OnStop(ctx context.Context) error {
+	s.observed = append(s.observed, s.State())
+	return nil
+}
+
+func newStateApp(t *testing.T, adapter *stateAdapter) *fxtest.App {
+	return fxtest.New(t,
+		fx.Supply(adapter),
+		fx.Invoke(func(lc fx.Lifecycle, a *stateAdapter) {
+			a.RegisterLifecycle(lc, a)
+		}),
+	)
+}
+
+func TestBaseAdapter_StateTransitions(t *testing.T) {
+	adapter := &stateAdapter{}
+	app := newStateApp(t, adapter)
+
+	if got := adapter.State(); got != StateCreated {
+		t.Errorf("Expected created before start, got %s", got)
+	}
+
+	app.RequireStart()
+	if got := adapter.State(); got != StateStarted {
+		t.Errorf("Expected started after start, got %s", got)
+	}
+
+	app.RequireStop()
+	if got := adapter.State(); got != StateStopped {
+		t.Errorf("Expected stopped after stop, got %s", got)
+	}
+
+	// Verify: Hooks thấy trạng thái đang chuyển
+	expected := []AdapterState{StateStarting, StateStopping}
+	if !reflect.DeepEqual(adapter.observed, expected) {
+		t.Errorf("Expected states inside hooks %v, got %v", expected, adapter.observed)
+	}
+}
+
+func TestBaseAdapter_StateFailedWhenStartErrors(t *testing.T) {
+	startErr := errors.New("port in use")
+	adapter := &stateAdapter{startErr: startErr}
+	app := newStateApp(t, adapter)
+
+	if err := app.Start(context.Background()); !errors.Is(err, startErr) {
+		t.Fatalf("Expected start error, got: %v", err)
+	}
+	if got := adapter.State(); got != StateFailed {
+		t.Errorf("Expected failed after start error, got %s", got)
+	}
+}
+
+func TestTrackLifecycle_ProgrammingErrors(t *testing.T) {
+	adapter := &stateAdapter{}
+	tracked := adapter.TrackLifecycle(adapter)
+	ctx := context.Background()
+
+	// Verify: OnStop trước khi start
+	if err := tracked.OnStop(ctx); !errors.Is(err, ErrNotStarted) {
+		t.Errorf("Expected ErrNotStarted, got: %v", err)
+	}
+	if len(adapter.observed) != 0 {
+		t.Errorf("Expected impl.OnStop not to run, observed %v", adapter.observed)
+	}
+
+	// Verify: OnStart 2 lần
+	if err := tracked.OnStart(ctx); err != nil {
+		t.Fatalf("Expected first start to succeed, got: %v", err)
+	}
+	err := tracked.OnStart(ctx)
+	if !errors.Is(err, ErrAlreadyStarted) {
+		t.Errorf("Expected ErrAlreadyStarted, got: %v", err)
+	}
+	if err != nil && err.Error() != "adapter already started (state: started)" {
+		t.Errorf("Expected current state in error, got: %v", err)
+	}
+	if adapter.starts != 1 {
+		t.Errorf("Expected impl.OnStart to run once, ran %d times", adapter.starts)
+	}
+
+	// Verify: Stop rồi start lại được
+	if err := tracked.OnStop(ctx); err != nil {
+		t.Fatalf("Expected stop to succeed, got: %v", err)
+	}
+	if err := tracked.OnStart(ctx); err != nil {
+		t.Errorf("Expected restart after stop to succeed, got: %v", err)
+	}
+}
+
+func TestBaseAdapter_StateConcurrentReads(t *testing.T) {
+	adapter := &stateAdapter{}
+	tracked := adapter.TrackLifecycle(adapter)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 1000; i++ {
+			_ = adapter.State()
+		}
+	}()
+
+	_ = tracked.OnStart(context.Background())
+	_ = tracked.OnStop(context.Background())
+	<-done
+}
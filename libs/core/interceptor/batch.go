@@ -0,0 +1,137 @@
+package interceptor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrBatchResultMismatch is returned to every caller of a batch when the batch handler
+// returns a different number of results than it received requests.
+var ErrBatchResultMismatch = errors.New("batch result count mismatch")
+
+// BatchHandler processes a batch of requests in a single call.
+// It must return exactly one result per context, in the same order.
+// A non-nil error fails every request in the batch.
+type BatchHandler[M any] func(ctxs []*UniversalContext[M]) ([]any, error)
+
+// BatchInterceptor coalesces requests for the same operation into batches.
+// Create with Batch.
+type BatchInterceptor[M any] struct {
+	window  time.Duration
+	maxSize int
+	handler BatchHandler[M]
+
+	mu      sync.Mutex
+	pending map[string]*batch[M]
+}
+
+// batch is a group of requests waiting to be flushed together.
+type batch[M any] struct {
+	ctxs    []*UniversalContext[M]
+	waiters []chan batchResult
+	timer   *time.Timer
+}
+
+type batchResult struct {
+	value any
+	err   error
+}
+
+// Batch creates an interceptor that collects requests arriving within window
+// (per ctx.OperationName()) and runs handler once for the whole group.
+// Each caller receives the result at its own position in the batch.
+// The interceptor terminates the chain: next is never called, handler replaces it.
+//
+// Parameters:
+//   - window: how long the first request of a batch waits for others
+//   - maxSize: flush immediately once this many requests are collected (<= 0 means no limit)
+//   - handler: processes the batch, returning one result per request
+//
+// Example:
+//
+//	loadUsers := interceptor.Batch[GinMeta](5*time.Millisecond, 100,
+//	    func(ctxs []*interceptor.UniversalContext[GinMeta]) ([]any, error) {
+//	        ids := make([]string, len(ctxs))
+//	        for i, c := range ctxs {
+//	            ids[i] = c.Meta.Params["id"]
+//	        }
+//	        return db.GetUsersByIDs(ids) // one query for the whole batch
+//	    },
+//	)
+func Batch[M any](window time.Duration, maxSize int, handler BatchHandler[M]) *BatchInterceptor[M] {
+	return &BatchInterceptor[M]{
+		window:  window,
+		maxSize: maxSize,
+		handler: handler,
+		pending: make(map[string]*batch[M]),
+	}
+}
+
+// Intercept implements Interceptor.
+// It blocks until the batch containing ctx is flushed or ctx is done.
+func (b *BatchInterceptor[M]) Intercept(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+	key := ctx.OperationName()
+	done := make(chan batchResult, 1)
+
+	b.mu.Lock()
+	current, ok := b.pending[key]
+	if !ok {
+		current = &batch[M]{}
+		b.pending[key] = current
+		current.timer = time.AfterFunc(b.window, func() {
+			b.flush(key, current)
+		})
+	}
+	current.ctxs = append(current.ctxs, ctx)
+	current.waiters = append(current.waiters, done)
+	full := b.maxSize > 0 && len(current.ctxs) >= b.maxSize
+	b.mu.Unlock()
+
+	if full {
+		current.timer.Stop()
+		b.flush(key, current)
+	}
+
+	select {
+	case res := <-done:
+		return res.value, res.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// flush detaches the batch and runs the handler. Only the first call for a batch has any effect.
+func (b *BatchInterceptor[M]) flush(key string, current *batch[M]) {
+	b.mu.Lock()
+	if b.pending[key] != current {
+		b.mu.Unlock()
+		return
+	}
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	results, err := b.run(current.ctxs)
+	if err == nil && len(results) != len(current.ctxs) {
+		err = fmt.Errorf("%w: %d requests, %d results", ErrBatchResultMismatch, len(current.ctxs), len(results))
+	}
+
+	for i, waiter := range current.waiters {
+		if err != nil {
+			waiter <- batchResult{err: err}
+			continue
+		}
+		waiter <- batchResult{value: results[i]}
+	}
+}
+
+// run calls the batch handler, converting a panic into an error for every caller.
+func (b *BatchInterceptor[M]) run(ctxs []*UniversalContext[M]) (results []any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewInterceptorError("batch", fmt.Errorf("panic: %v", r))
+		}
+	}()
+	return b.handler(ctxs)
+}
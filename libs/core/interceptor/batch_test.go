@@ -0,0 +1,154 @@
+package interceptor
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// runConcurrently starts one pipeline per method argument and collects results by index.
+func runConcurrently(b *BatchInterceptor[TestMeta], operation string, args []string) ([]any, []error) {
+	results := make([]any, len(args))
+	errs := make([]error, len(args))
+	var wg sync.WaitGroup
+
+	for i, arg := range args {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := NewUniversalContext[TestMeta](nil, "http", operation, TestMeta{UserID: arg})
+			results[i], errs[i] = Chain(nil, b)(ctx)
+		}()
+	}
+	wg.Wait()
+	return results, errs
+}
+
+func TestBatch_CoalescesWithinWindow(t *testing.T) {
+	var calls atomic.Int32
+	var batchSize atomic.Int32
+
+	b := Batch[TestMeta](50*time.Millisecond, 10, func(ctxs []*UniversalContext[TestMeta]) ([]any, error) {
+		calls.Add(1)
+		batchSize.Store(int32(len(ctxs)))
+		results := make([]any, len(ctxs))
+		for i, c := range ctxs {
+			results[i] = "user:" + c.Meta.UserID
+		}
+		return results, nil
+	})
+
+	results, errs := runConcurrently(b, "users.get", []string{"a", "b", "c"})
+
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 batch handler call, got %d", calls.Load())
+	}
+	if batchSize.Load() != 3 {
+		t.Errorf("Expected batch of 3, got %d", batchSize.Load())
+	}
+	for i, id := range []string{"a", "b", "c"} {
+		if errs[i] != nil {
+			t.Errorf("Caller %s: unexpected error %v", id, errs[i])
+		}
+		if results[i] != "user:"+id {
+			t.Errorf("Caller %s: expected %q, got %v", id, "user:"+id, results[i])
+		}
+	}
+}
+
+func TestBatch_FlushesAtMaxSize(t *testing.T) {
+	var calls atomic.Int32
+
+	// Window is long enough that only maxSize can trigger the flush in time
+	b := Batch[TestMeta](time.Minute, 2, func(ctxs []*UniversalContext[TestMeta]) ([]any, error) {
+		calls.Add(1)
+		return make([]any, len(ctxs)), nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		runConcurrently(b, "users.get", []string{"a", "b"})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected batch to flush when maxSize was reached")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected 1 batch handler call, got %d", calls.Load())
+	}
+}
+
+func TestBatch_SeparatesOperations(t *testing.T) {
+	var calls atomic.Int32
+
+	b := Batch[TestMeta](20*time.Millisecond, 0, func(ctxs []*UniversalContext[TestMeta]) ([]any, error) {
+		calls.Add(1)
+		results := make([]any, len(ctxs))
+		for i, c := range ctxs {
+			results[i] = c.OperationName()
+		}
+		return results, nil
+	})
+
+	var wg sync.WaitGroup
+	for _, op := range []string{"users.get", "orders.get"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results, _ := runConcurrently(b, op, []string{"x"})
+			if results[0] != op {
+				t.Errorf("Expected result %q, got %v", op, results[0])
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls.Load() != 2 {
+		t.Errorf("Expected 1 batch per operation, got %d calls", calls.Load())
+	}
+}
+
+func TestBatch_ErrorFansOut(t *testing.T) {
+	downstream := errors.New("db down")
+	b := Batch[TestMeta](10*time.Millisecond, 0, func(ctxs []*UniversalContext[TestMeta]) ([]any, error) {
+		return nil, downstream
+	})
+
+	_, errs := runConcurrently(b, "users.get", []string{"a", "b"})
+	for i, err := range errs {
+		if !errors.Is(err, downstream) {
+			t.Errorf("Caller %d: expected downstream error, got %v", i, err)
+		}
+	}
+}
+
+func TestBatch_ResultMismatch(t *testing.T) {
+	b := Batch[TestMeta](10*time.Millisecond, 0, func(ctxs []*UniversalContext[TestMeta]) ([]any, error) {
+		return []any{"only one"}, nil
+	})
+
+	_, errs := runConcurrently(b, "users.get", []string{"a", "b"})
+	for i, err := range errs {
+		if !errors.Is(err, ErrBatchResultMismatch) {
+			t.Errorf("Caller %d: expected ErrBatchResultMismatch, got %v", i, err)
+		}
+	}
+}
+
+func TestBatch_HandlerPanic(t *testing.T) {
+	b := Batch[TestMeta](10*time.Millisecond, 0, func(ctxs []*UniversalContext[TestMeta]) ([]any, error) {
+		panic("boom")
+	})
+
+	_, errs := runConcurrently(b, "users.get", []string{"a"})
+
+	var interceptorErr *InterceptorError
+	if !errors.As(errs[0], &interceptorErr) || interceptorErr.InterceptorName != "batch" {
+		t.Errorf("Expected batch InterceptorError, got %v", errs[0])
+	}
+}
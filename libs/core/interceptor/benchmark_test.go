@@ -0,0 +1,108 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+// executePipelineAllocBudget is the maximum number of allocations ExecutePipeline may make
+// for a request with no interceptors (BaseBridge + SimpleResolver). Raising it is a
+// deliberate decision: every request served through a bridge pays this cost.
+const executePipelineAllocBudget = 4
+
+func newBenchBridge() *BaseBridge[MockMeta, *MockNativeContext] {
+	return &BaseBridge[MockMeta, *MockNativeContext]{
+		Protocol: "http",
+		ExtractMetaFn: func(nc *MockNativeContext) MockMeta {
+			return MockMeta{RequestPath: nc.Path, UserID: nc.UserID}
+		},
+		GetMethodFn: func(nc *MockNativeContext) string { return nc.Method },
+	}
+}
+
+func benchHandler(ctx *UniversalContext[MockMeta]) (any, error) {
+	return ctx.Meta.UserID, nil
+}
+
+func passThroughInterceptors(n int) []Interceptor[MockMeta] {
+	interceptors := make([]Interceptor[MockMeta], n)
+	for i := range interceptors {
+		interceptors[i] = InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+			return next(ctx)
+		})
+	}
+	return interceptors
+}
+
+func TestExecutePipeline_AllocBudget(t *testing.T) {
+	bridge := newBenchBridge()
+	resolver := NewSimpleResolver[MockMeta]()
+	nativeCtx := &MockNativeContext{Path: "/api/users", Method: "GET /api/users", UserID: "user-1"}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := ExecutePipeline(bridge, resolver, nativeCtx, "/api/users", benchHandler); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	if allocs > executePipelineAllocBudget {
+		t.Errorf("ExecutePipeline allocates %.0f times per request, budget is %d", allocs, executePipelineAllocBudget)
+	}
+}
+
+func BenchmarkChain(b *testing.B) {
+	for _, n := range []int{1, 5, 10} {
+		interceptors := passThroughInterceptors(n)
+		uCtx := NewUniversalContext(context.Background(), "http", "/api/users", MockMeta{UserID: "user-1"})
+
+		b.Run(fmt.Sprintf("compose/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = Chain(benchHandler, interceptors...)
+			}
+		})
+
+		b.Run(fmt.Sprintf("execute/%d", n), func(b *testing.B) {
+			pipeline := Chain(benchHandler, interceptors...)
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := pipeline(uCtx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkExecutePipeline(b *testing.B) {
+	bridge := newBenchBridge()
+	nativeCtx := &MockNativeContext{Path: "/api/users", Method: "GET /api/users", UserID: "user-1"}
+
+	for _, n := range []int{0, 1, 5, 10} {
+		resolver := NewSimpleResolver(passThroughInterceptors(n)...)
+
+		b.Run(fmt.Sprintf("interceptors/%d", n), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := ExecutePipeline(bridge, resolver, nativeCtx, "/api/users", benchHandler); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// benchContextSink keeps benchmarked contexts escaping, as they do in a real pipeline.
+var benchContextSink *UniversalContext[MockMeta]
+
+func BenchmarkNewUniversalContext(b *testing.B) {
+	ctx := context.Background()
+	meta := MockMeta{RequestPath: "/api/users", UserID: "user-1"}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchContextSink = NewUniversalContext(ctx, "http", "/api/users", meta)
+	}
+}
@@ -1,5 +1,7 @@
 package interceptor
 
+import "context"
+
 // Bridge connects the interceptor system with a specific framework or adapter.
 // Each framework (Gin, Echo, gRPC, etc.) should implement this interface.
 type Bridge[M any, NativeCtx any] interface {
@@ -26,8 +28,11 @@ type BaseBridge[M any, NativeCtx any] struct {
 	Protocol      string
 	ExtractMetaFn func(NativeCtx) M
 	GetMethodFn   func(NativeCtx) string
+	GetContextFn  func(NativeCtx) context.Context // Optional: request context (deadline, cancellation); default context.Background()
 	OnSuccessFn   func(NativeCtx, any)
 	OnErrorFn     func(NativeCtx, error)
+	Normalizer    MethodNormalizer // Optional: maps Method to a canonical Operation
+	MetaClone     func(M) M        // Optional: copies Meta for derived contexts (see WithMetaClone)
 }
 
 // ExtractMeta implements Bridge interface.
@@ -47,12 +52,31 @@ func (b *BaseBridge[M, NativeCtx]) CreateUniversalContext(nativeCtx NativeCtx) *
 		method = b.GetMethodFn(nativeCtx)
 	}
 
-	return NewUniversalContext(
-		nil, // Context will be set by framework
+	var ctx context.Context // nil falls back to context.Background()
+	if b.GetContextFn != nil {
+		ctx = b.GetContextFn(nativeCtx)
+	}
+
+	var opts []ContextOption[M]
+	if b.MetaClone != nil {
+		opts = append(opts, WithMetaClone(b.MetaClone))
+	}
+
+	uCtx := NewUniversalContext(
+		ctx,
 		b.Protocol,
 		method,
 		meta,
+		opts...,
 	)
+
+	if b.Normalizer != nil {
+		if operation, ok := b.Normalizer.NormalizeMethod(uCtx.Protocol, uCtx.Method); ok {
+			uCtx.Operation = operation
+		}
+	}
+
+	return uCtx
 }
 
 // OnSuccess implements Bridge interface (default: no-op).
@@ -81,31 +105,92 @@ type SimpleResolver[M any] struct {
 	Interceptors []Interceptor[M]
 }
 
+// NewSimpleResolver creates a SimpleResolver from the given interceptors.
+//
+// Example:
+//
+//	resolver := interceptor.NewSimpleResolver[GinMeta](loggingInterceptor, authInterceptor)
+func NewSimpleResolver[M any](interceptors ...Interceptor[M]) *SimpleResolver[M] {
+	return &SimpleResolver[M]{
+		Interceptors: interceptors,
+	}
+}
+
+// Append adds interceptors to the end of the list.
+// Returns *SimpleResolver[M] to support method chaining.
+func (s *SimpleResolver[M]) Append(interceptors ...Interceptor[M]) *SimpleResolver[M] {
+	s.Interceptors = append(s.Interceptors, interceptors...)
+	return s
+}
+
 // Resolve implements InterceptorResolver.
 func (s *SimpleResolver[M]) Resolve(ctx *UniversalContext[M], handlerKey string) []Interceptor[M] {
 	return s.Interceptors
 }
 
 // ExecutePipeline is a helper to execute interceptor pipeline with a bridge.
-// This provides the standard flow: Extract → Resolve → Chain → Execute
+// This provides the standard flow: Extract → Normalize → Gate → Resolve → Chain → Execute
+//
+// If bridge implements MethodNormalizer and the context has no Operation yet,
+// it is used to fill UniversalContext.Operation.
+//
+// If resolver implements Gate, Allow is checked before resolving;
+// a rejection skips the chain and goes straight to OnError.
+//
+// The UniversalContext carries whatever context the bridge provides
+// (BaseBridge.GetContextFn, otherwise context.Background()).
+// Use ExecutePipelineContext to pass the request context explicitly.
 func ExecutePipeline[M any, NativeCtx any](
 	bridge Bridge[M, NativeCtx],
 	resolver InterceptorResolver[M],
 	nativeCtx NativeCtx,
 	handlerKey string,
 	businessHandler NextFunc[M],
+) (any, error) {
+	return ExecutePipelineContext(nil, bridge, resolver, nativeCtx, handlerKey, businessHandler)
+}
+
+// ExecutePipelineContext is like ExecutePipeline, but runs the pipeline with ctx as
+// UniversalContext.Context, so interceptors and the handler see its deadline,
+// cancellation and values (e.g. ctx.Deadline() for timeout budgeting).
+// A nil ctx keeps the context created by the bridge.
+//
+// Example:
+//
+//	func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+//	    interceptor.ExecutePipelineContext(r.Context(), bridge, resolver, &HTTPRequest{w, r}, "GET /users", listUsers)
+//	}
+func ExecutePipelineContext[M any, NativeCtx any](
+	ctx context.Context,
+	bridge Bridge[M, NativeCtx],
+	resolver InterceptorResolver[M],
+	nativeCtx NativeCtx,
+	handlerKey string,
+	businessHandler NextFunc[M],
 ) (any, error) {
 	// 1. Create UniversalContext from native context
 	uCtx := bridge.CreateUniversalContext(nativeCtx)
+	if ctx != nil {
+		uCtx.Context = ctx
+	}
+	normalizeOperation(bridge, uCtx)
+
+	// 2. Let the resolver veto the request before building the chain
+	if gate, ok := resolver.(Gate[M]); ok {
+		if err := gate.Allow(uCtx, handlerKey); err != nil {
+			bridge.OnError(nativeCtx, err)
+			return nil, err
+		}
+	}
 
-	// 2. Resolve interceptors
+	// 3. Resolve interceptors
 	interceptors := resolver.Resolve(uCtx, handlerKey)
 
-	// 3. Build and execute pipeline
+	// 4. Build and execute pipeline
 	pipeline := Chain(businessHandler, interceptors...)
 	result, err := pipeline(uCtx)
 
-	// 4. Call hooks
+	// 5. Call hooks
 	if err != nil {
 		bridge.OnError(nativeCtx, err)
 	} else {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 )
 
 type MockNativeContext struct {
@@ -186,6 +187,36 @@ func TestSimpleResolver_ResolveEmpty(t *testing.T) {
 	}
 }
 
+func TestNewSimpleResolver_Append(t *testing.T) {
+	var calls []string
+	named := func(name string) Interceptor[MockMeta] {
+		return InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+			calls = append(calls, name)
+			return next(ctx)
+		})
+	}
+
+	resolver := NewSimpleResolver(named("first"), named("second"))
+	resolver.Append(named("third")).Append(named("fourth"))
+
+	ctx := NewUniversalContext[MockMeta](nil, "http", "GET /", MockMeta{})
+	interceptors := resolver.Resolve(ctx, "/api/users")
+
+	if len(interceptors) != 4 {
+		t.Fatalf("Expected 4 interceptors, got %d", len(interceptors))
+	}
+
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return nil, nil
+	}
+	Chain(handler, interceptors...)(ctx)
+
+	expectedCalls := []string{"first", "second", "third", "fourth"}
+	if !equalSlices(calls, expectedCalls) {
+		t.Errorf("Expected calls %v, got %v", expectedCalls, calls)
+	}
+}
+
 func TestExecutePipeline_Success(t *testing.T) {
 	var calls []string
 	var onSuccessCalled bool
@@ -346,3 +377,74 @@ func TestExecutePipeline_ContextPropagation(t *testing.T) {
 		t.Errorf("Expected 'interceptor-value', got %v", result)
 	}
 }
+
+// deadlineRecorder captures the deadline an interceptor observes
+func deadlineRecorder(observed *time.Time, ok *bool) Interceptor[MockMeta] {
+	return InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		*observed, *ok = ctx.Deadline()
+		return next(ctx)
+	})
+}
+
+func TestExecutePipelineContext_PropagatesDeadline(t *testing.T) {
+	deadline := time.Now().Add(time.Minute)
+	reqCtx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	var observed time.Time
+	var hasDeadline bool
+	bridge := &BaseBridge[MockMeta, *MockNativeContext]{Protocol: "http"}
+	resolver := NewSimpleResolver(deadlineRecorder(&observed, &hasDeadline))
+
+	handlerSawDeadline := false
+	_, err := ExecutePipelineContext(reqCtx, bridge, resolver, &MockNativeContext{Path: "/api/users"}, "GET /api/users",
+		func(ctx *UniversalContext[MockMeta]) (any, error) {
+			_, handlerSawDeadline = ctx.Deadline()
+			return nil, nil
+		})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !hasDeadline || !observed.Equal(deadline) {
+		t.Errorf("Expected interceptor to see deadline %v, got %v (ok=%v)", deadline, observed, hasDeadline)
+	}
+	if !handlerSawDeadline {
+		t.Error("Expected handler to see the deadline")
+	}
+}
+
+func TestExecutePipeline_GetContextFn(t *testing.T) {
+	deadline := time.Now().Add(time.Minute)
+	reqCtx, cancel := context.WithDeadline(context.Background(), deadline)
+	defer cancel()
+
+	type nativeWithContext struct {
+		ctx context.Context
+	}
+
+	var observed time.Time
+	var hasDeadline bool
+	bridge := &BaseBridge[MockMeta, *nativeWithContext]{
+		Protocol:     "http",
+		GetContextFn: func(nc *nativeWithContext) context.Context { return nc.ctx },
+	}
+	resolver := NewSimpleResolver(deadlineRecorder(&observed, &hasDeadline))
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) { return nil, nil }
+
+	if _, err := ExecutePipeline(bridge, resolver, &nativeWithContext{ctx: reqCtx}, "key", handler); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !hasDeadline || !observed.Equal(deadline) {
+		t.Errorf("Expected deadline from GetContextFn, got %v (ok=%v)", observed, hasDeadline)
+	}
+
+	// Verify: Without a request context the pipeline runs with no deadline
+	hasDeadline = true
+	if _, err := ExecutePipeline(&BaseBridge[MockMeta, *MockNativeContext]{}, resolver, &MockNativeContext{}, "key", handler); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if hasDeadline {
+		t.Error("Expected no deadline with the default background context")
+	}
+}
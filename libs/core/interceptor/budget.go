@@ -0,0 +1,102 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+)
+
+type budgetKey struct{}
+
+// Budget is the time a handler has to finish, as set up by BudgetInterceptor.
+type Budget struct {
+	// Deadline is the deadline of the handler's context.
+	Deadline time.Time
+	// Incoming is the time that was left on the incoming context, or 0 if it had no deadline.
+	Incoming time.Duration
+	// Capped reports whether the per-hop cap shortened the incoming deadline.
+	Capped bool
+}
+
+// Remaining returns the time left until Deadline (never negative).
+func (b Budget) Remaining() time.Duration {
+	if d := time.Until(b.Deadline); d > 0 {
+		return d
+	}
+	return 0
+}
+
+// Split divides the remaining time evenly across n sequential downstream calls.
+// Use it as the per-call timeout when a handler fans out; n <= 1 returns Remaining.
+//
+// Example:
+//
+//	budget, _ := interceptor.BudgetFrom(ctx)
+//	for _, svc := range downstreams {
+//	    callCtx, cancel := context.WithTimeout(ctx, budget.Split(len(downstreams)))
+//	    ...
+//	}
+func (b Budget) Split(n int) time.Duration {
+	if n <= 1 {
+		return b.Remaining()
+	}
+	return b.Remaining() / time.Duration(n)
+}
+
+// BudgetInterceptor exposes the remaining deadline to the handler as a Budget (see BudgetFrom).
+// When maxPerHop > 0, the handler's context deadline is shortened to at most maxPerHop from now,
+// so one hop cannot spend the whole budget of the call chain.
+//
+// Behavior:
+//   - Incoming deadline, no cap (maxPerHop <= 0): the deadline is exposed unchanged
+//   - Incoming deadline later than now+maxPerHop, or no incoming deadline: the context gets a
+//     deadline of now+maxPerHop and Budget.Capped is true
+//   - Neither a deadline nor a cap: no Budget is stored and the context is unchanged
+//
+// The derived context is cancelled and the original restored when next returns.
+//
+// Example:
+//
+//	budget := interceptor.BudgetInterceptor[GinMeta](300 * time.Millisecond)
+//
+//	func handler(ctx *interceptor.UniversalContext[GinMeta]) (any, error) {
+//	    b, _ := interceptor.BudgetFrom(ctx)
+//	    return inventory.Get(ctx, b.Split(2)) // two downstream calls share the budget
+//	}
+func BudgetInterceptor[M any](maxPerHop time.Duration) Interceptor[M] {
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		now := time.Now()
+		deadline, hasDeadline := ctx.Deadline()
+
+		var budget Budget
+		if hasDeadline {
+			budget.Deadline = deadline
+			budget.Incoming = deadline.Sub(now)
+		}
+		if maxPerHop > 0 && (!hasDeadline || budget.Incoming > maxPerHop) {
+			budget.Deadline = now.Add(maxPerHop)
+			budget.Capped = true
+		}
+		if budget.Deadline.IsZero() {
+			return next(ctx)
+		}
+
+		parent := ctx.Context
+		defer func() { ctx.Context = parent }()
+
+		derived := context.WithValue(parent, budgetKey{}, budget)
+		if budget.Capped {
+			var cancel context.CancelFunc
+			derived, cancel = context.WithDeadline(derived, budget.Deadline)
+			defer cancel()
+		}
+		ctx.Context = derived
+
+		return next(ctx)
+	})
+}
+
+// BudgetFrom returns the Budget stored by BudgetInterceptor, and false if there is none.
+func BudgetFrom(ctx context.Context) (Budget, bool) {
+	b, ok := ctx.Value(budgetKey{}).(Budget)
+	return b, ok
+}
@@ -0,0 +1,86 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// runBudget runs a handler behind BudgetInterceptor and returns what it saw
+func runBudget(t *testing.T, parent context.Context, maxPerHop time.Duration) (budget Budget, ok bool, remaining time.Duration, hasDeadline bool) {
+	t.Helper()
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		budget, ok = BudgetFrom(ctx)
+		var deadline time.Time
+		deadline, hasDeadline = ctx.Deadline()
+		remaining = time.Until(deadline)
+		return nil, nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](parent, "http", "GET /orders", TestMeta{})
+	if _, err := Chain(handler, BudgetInterceptor[TestMeta](maxPerHop))(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if ctx.Context != parent {
+		t.Error("Expected the original context to be restored")
+	}
+	return budget, ok, remaining, hasDeadline
+}
+
+func TestBudgetInterceptor_CapsPerHop(t *testing.T) {
+	parent, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	budget, ok, remaining, _ := runBudget(t, parent, 300*time.Millisecond)
+
+	if !ok || !budget.Capped {
+		t.Fatalf("Expected a capped budget, got %+v (ok=%v)", budget, ok)
+	}
+	if remaining > 300*time.Millisecond || remaining < 250*time.Millisecond {
+		t.Errorf("Expected handler deadline ~300ms, got %v", remaining)
+	}
+	if budget.Incoming < 900*time.Millisecond || budget.Incoming > time.Second {
+		t.Errorf("Expected ~1s incoming budget, got %v", budget.Incoming)
+	}
+}
+
+func TestBudgetInterceptor_KeepsShorterIncomingDeadline(t *testing.T) {
+	parent, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	budget, ok, remaining, _ := runBudget(t, parent, 300*time.Millisecond)
+
+	if !ok || budget.Capped {
+		t.Fatalf("Expected an uncapped budget, got %+v (ok=%v)", budget, ok)
+	}
+	if remaining > 100*time.Millisecond {
+		t.Errorf("Expected the incoming 100ms deadline, got %v", remaining)
+	}
+}
+
+func TestBudgetInterceptor_NoDeadline(t *testing.T) {
+	if _, ok, _, hasDeadline := runBudget(t, context.Background(), 0); ok || hasDeadline {
+		t.Error("Expected no budget and no deadline without a deadline or cap")
+	}
+
+	budget, ok, remaining, _ := runBudget(t, context.Background(), 200*time.Millisecond)
+	if !ok || !budget.Capped || budget.Incoming != 0 {
+		t.Fatalf("Expected the cap to set a deadline, got %+v (ok=%v)", budget, ok)
+	}
+	if remaining > 200*time.Millisecond || remaining < 150*time.Millisecond {
+		t.Errorf("Expected handler deadline ~200ms, got %v", remaining)
+	}
+}
+
+func TestBudget_Split(t *testing.T) {
+	b := Budget{Deadline: time.Now().Add(time.Second)}
+	if part := b.Split(4); part > 250*time.Millisecond || part < 200*time.Millisecond {
+		t.Errorf("Expected ~250ms per call, got %v", part)
+	}
+	if whole := b.Split(0); whole < 900*time.Millisecond {
+		t.Errorf("Expected Split(0) to return the whole remaining time, got %v", whole)
+	}
+	if (Budget{Deadline: time.Now().Add(-time.Second)}).Remaining() != 0 {
+		t.Error("Expected Remaining to never be negative")
+	}
+}
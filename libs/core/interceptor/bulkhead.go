@@ -0,0 +1,230 @@
+package interceptor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrBulkheadFull is matched (via errors.Is) by every BulkheadFullError.
+// Bridges can map it to 503 Service Unavailable / RESOURCE_EXHAUSTED.
+var ErrBulkheadFull = errors.New("bulkhead full")
+
+// BulkheadFullError is returned when a method has no free slot.
+type BulkheadFullError struct {
+	Method string
+	Limit  int
+}
+
+// Error implements the error interface.
+func (e *BulkheadFullError) Error() string {
+	return fmt.Sprintf("bulkhead full: method %s (limit %d)", e.Method, e.Limit)
+}
+
+// Is makes errors.Is(err, ErrBulkheadFull) match.
+func (e *BulkheadFullError) Is(target error) bool {
+	return target == ErrBulkheadFull
+}
+
+// BulkheadOption configures a BulkheadInterceptor.
+type BulkheadOption func(*bulkheadOptions)
+
+type bulkheadOptions struct {
+	noWait      bool
+	onOccupancy func(method string, inUse, limit int)
+	warmup      warmupScale
+}
+
+// WithoutWait rejects immediately when a method is at its limit instead of waiting.
+func WithoutWait() BulkheadOption {
+	return func(o *bulkheadOptions) {
+		o.noWait = true
+	}
+}
+
+// WithOccupancyHook registers a callback invoked after every acquire and release
+// with the current number of in-flight executions for the method.
+func WithOccupancyHook(fn func(method string, inUse, limit int)) BulkheadOption {
+	return func(o *bulkheadOptions) {
+		o.onOccupancy = fn
+	}
+}
+
+// WithWarmup scales every limit by a factor moving linearly from factorStart to factorEnd
+// over the warm-up window of w, e.g. 3 then 1 to admit three times as many requests while caches
+// are cold. Scaled limits are rounded up; unlimited methods stay unlimited.
+// The first request through the bulkhead starts the window.
+func WithWarmup(w *WarmupController, factorStart, factorEnd float64) BulkheadOption {
+	return func(o *bulkheadOptions) {
+		o.warmup = warmupScale{controller: w, factorStart: factorStart, factorEnd: factorEnd}
+	}
+}
+
+// BulkheadInterceptor caps concurrent executions per method.
+// Create with Bulkhead.
+type BulkheadInterceptor[M any] struct {
+	limits       map[string]int
+	defaultLimit int
+	waitTimeout  time.Duration
+	opts         bulkheadOptions
+
+	mu    sync.Mutex
+	slots map[string]*bulkheadSlots
+}
+
+// Bulkhead creates an interceptor that caps concurrent executions per method (ctx.OperationName()).
+//
+// Parameters:
+//   - limits: per-method limits, keyed by ctx.OperationName()
+//   - defaultLimit: limit for methods not in limits (<= 0 means unlimited)
+//   - waitTimeout: how long to wait for a free slot (0 waits until the context is done)
+//
+// Example:
+//
+//	bulkhead := interceptor.Bulkhead[GinMeta](
+//	    map[string]int{"POST /reports": 2},
+//	    50,
+//	    100*time.Millisecond,
+//	)
+func Bulkhead[M any](limits map[string]int, defaultLimit int, waitTimeout time.Duration, opts ...BulkheadOption) *BulkheadInterceptor[M] {
+	b := &BulkheadInterceptor[M]{
+		limits:       limits,
+		defaultLimit: defaultLimit,
+		waitTimeout:  waitTimeout,
+		slots:        make(map[string]*bulkheadSlots),
+	}
+	for _, opt := range opts {
+		opt(&b.opts)
+	}
+	return b
+}
+
+// Intercept implements Interceptor.
+// The slot is released via defer, so a panicking handler never leaks it.
+func (b *BulkheadInterceptor[M]) Intercept(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+	b.opts.warmup.begin()
+
+	method := ctx.OperationName()
+	if b.limitFor(method) <= 0 {
+		return next(ctx)
+	}
+
+	slots := b.slotsFor(method)
+	if err := b.acquire(ctx, method, slots); err != nil {
+		return nil, err
+	}
+	defer b.release(method, slots)
+
+	return next(ctx)
+}
+
+// InUse returns the number of in-flight executions for method.
+func (b *BulkheadInterceptor[M]) InUse(method string) int {
+	b.mu.Lock()
+	slots, ok := b.slots[method]
+	b.mu.Unlock()
+	if !ok {
+		return 0
+	}
+	return slots.count()
+}
+
+// limitFor returns the current limit for method, scaled during warm-up.
+func (b *BulkheadInterceptor[M]) limitFor(method string) int {
+	limit, ok := b.limits[method]
+	if !ok {
+		limit = b.defaultLimit
+	}
+	return b.opts.warmup.apply(limit)
+}
+
+// slotsFor returns the slots of method, creating them on first use.
+func (b *BulkheadInterceptor[M]) slotsFor(method string) *bulkheadSlots {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	slots, ok := b.slots[method]
+	if !ok {
+		slots = &bulkheadSlots{freed: make(chan struct{})}
+		b.slots[method] = slots
+	}
+	return slots
+}
+
+func (b *BulkheadInterceptor[M]) acquire(ctx *UniversalContext[M], method string, slots *bulkheadSlots) error {
+	var timeout <-chan time.Time
+	for {
+		limit := b.limitFor(method)
+		inUse, freed, ok := slots.tryAcquire(limit)
+		if ok {
+			b.report(method, inUse, limit)
+			return nil
+		}
+		if b.opts.noWait {
+			return &BulkheadFullError{Method: method, Limit: limit}
+		}
+
+		if timeout == nil && b.waitTimeout > 0 {
+			timer := time.NewTimer(b.waitTimeout)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+
+		select {
+		case <-freed:
+		case <-timeout:
+			return &BulkheadFullError{Method: method, Limit: limit}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (b *BulkheadInterceptor[M]) release(method string, slots *bulkheadSlots) {
+	b.report(method, slots.release(), b.limitFor(method))
+}
+
+func (b *BulkheadInterceptor[M]) report(method string, inUse, limit int) {
+	if b.opts.onOccupancy != nil {
+		b.opts.onOccupancy(method, inUse, limit)
+	}
+}
+
+// bulkheadSlots counts the in-flight executions of one method.
+// The limit is passed on every acquire, since it changes during warm-up.
+type bulkheadSlots struct {
+	mu    sync.Mutex
+	inUse int
+	freed chan struct{} // closed and replaced on every release, waking waiters
+}
+
+// tryAcquire takes a slot if fewer than limit are in use. Otherwise it returns
+// a channel closed on the next release.
+func (s *bulkheadSlots) tryAcquire(limit int) (inUse int, freed <-chan struct{}, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.inUse < limit {
+		s.inUse++
+		return s.inUse, nil, true
+	}
+	return s.inUse, s.freed, false
+}
+
+// release frees a slot and returns the number still in use.
+func (s *bulkheadSlots) release() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inUse--
+	close(s.freed)
+	s.freed = make(chan struct{})
+	return s.inUse
+}
+
+func (s *bulkheadSlots) count() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.inUse
+}
@@ -0,0 +1,277 @@
+package interceptor
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// startBlocked launches n pipelines that hold their slot until release is closed
+// and waits until the bulkhead reports them in flight.
+func startBlocked(t *testing.T, b *BulkheadInterceptor[TestMeta], method string, n int, release chan struct{}) *sync.WaitGroup {
+	t.Helper()
+	var wg sync.WaitGroup
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		<-release
+		return "done", nil
+	}
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := NewUniversalContext[TestMeta](nil, "http", method, TestMeta{})
+			Chain(handler, b)(ctx)
+		}()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for b.InUse(method) < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %d in-flight executions, got %d", n, b.InUse(method))
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return &wg
+}
+
+func TestBulkhead_AdmitsUpToLimit(t *testing.T) {
+	b := Bulkhead[TestMeta](map[string]int{"report": 3}, 0, 0, WithoutWait())
+
+	var admitted, rejected atomic.Int32
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		admitted.Add(1)
+		<-release
+		return nil, nil
+	}
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := NewUniversalContext[TestMeta](nil, "http", "report", TestMeta{})
+			if _, err := Chain(handler, b)(ctx); errors.Is(err, ErrBulkheadFull) {
+				rejected.Add(1)
+			}
+		}()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for admitted.Load() < 3 || rejected.Load() < 7 {
+		if time.Now().After(deadline) {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+
+	if admitted.Load() != 3 {
+		t.Errorf("Expected 3 admitted, got %d", admitted.Load())
+	}
+	if rejected.Load() != 7 {
+		t.Errorf("Expected 7 rejected, got %d", rejected.Load())
+	}
+	if b.InUse("report") != 0 {
+		t.Errorf("Expected all slots released, got %d in use", b.InUse("report"))
+	}
+}
+
+func TestBulkhead_WaitTimeout(t *testing.T) {
+	b := Bulkhead[TestMeta](map[string]int{"report": 1}, 0, 20*time.Millisecond)
+	release := make(chan struct{})
+	wg := startBlocked(t, b, "report", 1, release)
+	defer func() {
+		close(release)
+		wg.Wait()
+	}()
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "ok", nil
+	}
+	ctx := NewUniversalContext[TestMeta](nil, "http", "report", TestMeta{})
+
+	start := time.Now()
+	_, err := Chain(handler, b)(ctx)
+
+	var fullErr *BulkheadFullError
+	if !errors.As(err, &fullErr) {
+		t.Fatalf("Expected BulkheadFullError, got %v", err)
+	}
+	if fullErr.Method != "report" || fullErr.Limit != 1 {
+		t.Errorf("Unexpected error details: %+v", fullErr)
+	}
+	if time.Since(start) < 20*time.Millisecond {
+		t.Error("Expected to wait for waitTimeout before rejecting")
+	}
+}
+
+func TestBulkhead_WaiterAdmittedWhenSlotFrees(t *testing.T) {
+	b := Bulkhead[TestMeta](map[string]int{"report": 1}, 0, time.Second)
+	release := make(chan struct{})
+	wg := startBlocked(t, b, "report", 1, release)
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "ok", nil
+	}
+	ctx := NewUniversalContext[TestMeta](nil, "http", "report", TestMeta{})
+
+	time.AfterFunc(10*time.Millisecond, func() { close(release) })
+	result, err := Chain(handler, b)(ctx)
+	wg.Wait()
+
+	if err != nil {
+		t.Errorf("Expected waiter to be admitted, got %v", err)
+	}
+	if result != "ok" {
+		t.Errorf("Expected 'ok', got %v", result)
+	}
+}
+
+func TestBulkhead_DefaultLimitAndUnlimited(t *testing.T) {
+	b := Bulkhead[TestMeta](map[string]int{"unlimited": 0}, 1, 0, WithoutWait())
+	release := make(chan struct{})
+	wg := startBlocked(t, b, "other", 1, release)
+	defer func() {
+		close(release)
+		wg.Wait()
+	}()
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "ok", nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "other", TestMeta{})
+	if _, err := Chain(handler, b)(ctx); !errors.Is(err, ErrBulkheadFull) {
+		t.Errorf("Expected default limit to apply, got %v", err)
+	}
+
+	ctx = NewUniversalContext[TestMeta](nil, "http", "unlimited", TestMeta{})
+	if _, err := Chain(handler, b)(ctx); err != nil {
+		t.Errorf("Expected unlimited method to pass, got %v", err)
+	}
+}
+
+func TestBulkhead_ReleasesOnPanic(t *testing.T) {
+	b := Bulkhead[TestMeta](map[string]int{"report": 1}, 0, 0, WithoutWait())
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		panic("boom")
+	}
+	ctx := NewUniversalContext[TestMeta](nil, "http", "report", TestMeta{})
+
+	func() {
+		defer func() { recover() }()
+		Chain(handler, b)(ctx)
+	}()
+
+	if b.InUse("report") != 0 {
+		t.Errorf("Expected slot released after panic, got %d in use", b.InUse("report"))
+	}
+}
+
+func TestBulkhead_OccupancyHook(t *testing.T) {
+	var occupancy []int
+	b := Bulkhead[TestMeta](nil, 2, 0, WithOccupancyHook(func(method string, inUse, limit int) {
+		if method != "report" || limit != 2 {
+			t.Errorf("Unexpected hook args: %s, %d", method, limit)
+		}
+		occupancy = append(occupancy, inUse)
+	}))
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return nil, nil
+	}
+	ctx := NewUniversalContext[TestMeta](nil, "http", "report", TestMeta{})
+	Chain(handler, b)(ctx)
+
+	expected := []int{1, 0}
+	if len(occupancy) != 2 || occupancy[0] != expected[0] || occupancy[1] != expected[1] {
+		t.Errorf("Expected occupancy %v, got %v", expected, occupancy)
+	}
+}
+
+// admitted runs n concurrent pipelines through b and returns how many were admitted
+// while all of them hold their slot.
+func admitted(t *testing.T, b *BulkheadInterceptor[TestMeta], n int) int {
+	t.Helper()
+	release := make(chan struct{})
+	var wg sync.WaitGroup
+	var count, rejected atomic.Int32
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := NewUniversalContext[TestMeta](nil, "http", "report", TestMeta{})
+			_, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) {
+				count.Add(1)
+				<-release
+				return nil, nil
+			}, b)(ctx)
+			if errors.Is(err, ErrBulkheadFull) {
+				rejected.Add(1)
+			}
+		}()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for int(count.Load()+rejected.Load()) < n && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	return int(count.Load())
+}
+
+func TestBulkhead_Warmup(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	warmup := NewWarmup(time.Minute, clock.now)
+	b := Bulkhead[TestMeta](map[string]int{"report": 2}, 0, 0, WithoutWait(), WithWarmup(warmup, 3, 1))
+
+	if got := admitted(t, b, 10); got != 6 {
+		t.Errorf("Expected 3x the limit at the start of the window, got %d admitted", got)
+	}
+
+	clock.advance(30 * time.Second)
+	if got := admitted(t, b, 10); got != 4 {
+		t.Errorf("Expected 2x the limit halfway through, got %d admitted", got)
+	}
+
+	clock.advance(time.Minute)
+	if got := admitted(t, b, 10); got != 2 {
+		t.Errorf("Expected the normal limit after the window, got %d admitted", got)
+	}
+}
+
+func TestBulkhead_WithoutWarmup(t *testing.T) {
+	b := Bulkhead[TestMeta](map[string]int{"report": 2}, 0, 0, WithoutWait())
+	if got := admitted(t, b, 10); got != 2 {
+		t.Errorf("Expected the normal limit without warm-up, got %d admitted", got)
+	}
+}
+
+func TestBulkhead_WarmupRejectionReportsScaledLimit(t *testing.T) {
+	warmup := NewWarmup(time.Minute, (&fakeClock{t: time.Unix(1700000000, 0)}).now)
+	b := Bulkhead[TestMeta](map[string]int{"report": 1}, 0, 0, WithoutWait(), WithWarmup(warmup, 2, 1))
+	release := make(chan struct{})
+	wg := startBlocked(t, b, "report", 2, release)
+	defer func() {
+		close(release)
+		wg.Wait()
+	}()
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "report", TestMeta{})
+	_, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) { return nil, nil }, b)(ctx)
+
+	var fullErr *BulkheadFullError
+	if !errors.As(err, &fullErr) || fullErr.Limit != 2 {
+		t.Errorf("Expected rejection at the warm-up limit 2, got %v", err)
+	}
+}
@@ -0,0 +1,217 @@
+package interceptor
+
+import (
+	"container/list"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrNotModified is matched (via errors.Is) by every NotModifiedError.
+// HTTP bridges should respond 304 Not Modified with an empty body instead of treating it as a failure.
+var ErrNotModified = errors.New("not modified")
+
+// NotModifiedError is returned by ConditionalCache when the version the client already has
+// matches the cached version of the response.
+type NotModifiedError struct {
+	Version string
+}
+
+// Error implements the error interface.
+func (e *NotModifiedError) Error() string {
+	return fmt.Sprintf("not modified: %s", e.Version)
+}
+
+// Is makes errors.Is(err, ErrNotModified) match.
+func (e *NotModifiedError) Is(target error) bool {
+	return target == ErrNotModified
+}
+
+// Defaults for ConditionalCacheInterceptor.
+const (
+	DefaultCacheTTL        = 5 * time.Minute
+	DefaultCacheMaxEntries = 1024
+)
+
+// ConditionalCacheOption configures ConditionalCacheInterceptor.
+type ConditionalCacheOption func(*conditionalCacheOptions)
+
+type conditionalCacheOptions struct {
+	ttl        time.Duration
+	maxEntries int
+}
+
+// WithCacheTTL expires cached versions after ttl, so a change the cache was not told about
+// (see ConditionalCache.Invalidate) is picked up within ttl.
+// Values <= 0 are ignored. Default DefaultCacheTTL.
+func WithCacheTTL(ttl time.Duration) ConditionalCacheOption {
+	return func(o *conditionalCacheOptions) {
+		if ttl > 0 {
+			o.ttl = ttl
+		}
+	}
+}
+
+// WithCacheMaxEntries sets how many keys are cached (least recently used are evicted).
+// Values < 1 are ignored. Default DefaultCacheMaxEntries.
+func WithCacheMaxEntries(n int) ConditionalCacheOption {
+	return func(o *conditionalCacheOptions) {
+		if n > 0 {
+			o.maxEntries = n
+		}
+	}
+}
+
+// ConditionalCache remembers the version (ETag) of the last response per key and
+// short-circuits requests from clients that already have it. Versions expire after a TTL
+// and at most a fixed number of keys is kept. Safe for concurrent use.
+type ConditionalCache[M any] struct {
+	keyFn      func(*UniversalContext[M]) string
+	versionFn  func(*UniversalContext[M]) string
+	etagFn     func(result any) string
+	ttl        time.Duration
+	maxEntries int
+	now        func() time.Time
+
+	mu      sync.Mutex
+	order   *list.List // front = most recently used
+	entries map[string]*list.Element
+}
+
+type conditionalCacheEntry struct {
+	key     string
+	version string
+	expires time.Time
+}
+
+// ConditionalCacheInterceptor creates a conditional response cache.
+//
+// Parameters:
+//   - keyFn: identifies the resource (e.g. method + path); "" bypasses the cache
+//   - versionFn: the version the client already has, from Meta (e.g. If-None-Match); "" means none
+//   - etagFn: the version of a handler result (e.g. a hash or updated_at); "" is not cached
+//
+// When versionFn matches the cached version for the key, next is not called and a
+// *NotModifiedError is returned. Otherwise next runs and the version of its result is cached.
+// Failed requests never change the cache. Versions expire after DefaultCacheTTL and the
+// least recently used keys are evicted beyond DefaultCacheMaxEntries
+// (see WithCacheTTL and WithCacheMaxEntries).
+//
+// Panics if keyFn, versionFn or etagFn is nil.
+//
+// Example:
+//
+//	cache := interceptor.ConditionalCacheInterceptor[GinMeta](
+//	    func(ctx *interceptor.UniversalContext[GinMeta]) string { return ctx.Method + " " + ctx.Meta.Path },
+//	    func(ctx *interceptor.UniversalContext[GinMeta]) string { return ctx.Meta.Headers["If-None-Match"] },
+//	    func(result any) string { return result.(*Product).ETag() },
+//	    interceptor.WithCacheTTL(time.Minute),
+//	)
+//
+//	// In the bridge:
+//	// errors.Is(err, interceptor.ErrNotModified) → 304
+//
+//	// After a write:
+//	cache.Invalidate("GET /products/42")
+func ConditionalCacheInterceptor[M any](
+	keyFn func(*UniversalContext[M]) string,
+	versionFn func(*UniversalContext[M]) string,
+	etagFn func(result any) string,
+	opts ...ConditionalCacheOption,
+) *ConditionalCache[M] {
+	if keyFn == nil || versionFn == nil || etagFn == nil {
+		panic("interceptor: ConditionalCacheInterceptor requires keyFn, versionFn and etagFn")
+	}
+
+	o := conditionalCacheOptions{ttl: DefaultCacheTTL, maxEntries: DefaultCacheMaxEntries}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &ConditionalCache[M]{
+		keyFn:      keyFn,
+		versionFn:  versionFn,
+		etagFn:     etagFn,
+		ttl:        o.ttl,
+		maxEntries: o.maxEntries,
+		now:        time.Now,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Intercept implements Interceptor.
+func (c *ConditionalCache[M]) Intercept(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+	key := c.keyFn(ctx)
+	if key == "" {
+		return next(ctx)
+	}
+
+	if version := c.versionFn(ctx); version != "" {
+		if cached, ok := c.lookup(key); ok && cached == version {
+			return nil, &NotModifiedError{Version: version}
+		}
+	}
+
+	result, err := next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if etag := c.etagFn(result); etag != "" {
+		c.store(key, etag)
+	}
+	return result, nil
+}
+
+// Invalidate forgets the cached version for key, so the next request runs the handler.
+// Call it after writes to the resource.
+func (c *ConditionalCache[M]) Invalidate(key string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+}
+
+// lookup returns the unexpired cached version for key.
+func (c *ConditionalCache[M]) lookup(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	entry := elem.Value.(*conditionalCacheEntry)
+	if !c.now().Before(entry.expires) {
+		c.remove(elem)
+		return "", false
+	}
+	c.order.MoveToFront(elem)
+	return entry.version, true
+}
+
+func (c *ConditionalCache[M]) store(key, version string) {
+	entry := &conditionalCacheEntry{key: key, version: version, expires: c.now().Add(c.ttl)}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	if c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove drops elem from the cache. Callers hold c.mu.
+func (c *ConditionalCache[M]) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*conditionalCacheEntry).key)
+}
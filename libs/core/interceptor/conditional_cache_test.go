@@ -0,0 +1,166 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// ConditionalMeta carries the resource path and the client's If-None-Match
+type ConditionalMeta struct {
+	Path        string
+	IfNoneMatch string
+}
+
+type versionedResource struct {
+	Name    string
+	Version string
+}
+
+// newTestConditionalCache returns a cache over ConditionalMeta and a handler that serves *current,
+// counting its calls.
+func newTestConditionalCache(current *versionedResource, calls *int, opts ...ConditionalCacheOption) (*ConditionalCache[ConditionalMeta], NextFunc[ConditionalMeta]) {
+	cache := ConditionalCacheInterceptor[ConditionalMeta](
+		func(ctx *UniversalContext[ConditionalMeta]) string { return ctx.Meta.Path },
+		func(ctx *UniversalContext[ConditionalMeta]) string { return ctx.Meta.IfNoneMatch },
+		func(result any) string { return result.(*versionedResource).Version },
+		opts...,
+	)
+	handler := func(ctx *UniversalContext[ConditionalMeta]) (any, error) {
+		*calls++
+		resource := *current
+		return &resource, nil
+	}
+	return cache, Chain(handler, cache)
+}
+
+func conditionalRequest(path, ifNoneMatch string) *UniversalContext[ConditionalMeta] {
+	return NewUniversalContext(context.Background(), "http", "GET", ConditionalMeta{Path: path, IfNoneMatch: ifNoneMatch})
+}
+
+func TestConditionalCache_MatchingVersionNotModified(t *testing.T) {
+	current := &versionedResource{Name: "widget", Version: "v1"}
+	calls := 0
+	_, pipeline := newTestConditionalCache(current, &calls)
+
+	// First request fills the cache
+	result, err := pipeline(conditionalRequest("/products/1", ""))
+	if err != nil || result.(*versionedResource).Version != "v1" {
+		t.Fatalf("Expected v1 result, got %v, %v", result, err)
+	}
+
+	_, err = pipeline(conditionalRequest("/products/1", "v1"))
+	if !errors.Is(err, ErrNotModified) {
+		t.Fatalf("Expected ErrNotModified, got: %v", err)
+	}
+	var notModified *NotModifiedError
+	if !errors.As(err, &notModified) || notModified.Version != "v1" {
+		t.Errorf("Expected NotModifiedError for v1, got: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected handler to be skipped, called %d times", calls)
+	}
+
+	// Verify: Other keys are cached separately
+	if _, err := pipeline(conditionalRequest("/products/2", "v1")); err != nil {
+		t.Errorf("Expected uncached key to run the handler, got: %v", err)
+	}
+}
+
+func TestConditionalCache_ChangedVersionReinvokesHandler(t *testing.T) {
+	current := &versionedResource{Name: "widget", Version: "v1"}
+	calls := 0
+	cache, pipeline := newTestConditionalCache(current, &calls)
+
+	pipeline(conditionalRequest("/products/1", ""))
+
+	// Client has a stale version
+	result, err := pipeline(conditionalRequest("/products/1", "v0"))
+	if err != nil || result.(*versionedResource).Version != "v1" {
+		t.Fatalf("Expected full v1 response for stale client, got %v, %v", result, err)
+	}
+
+	// Resource changes; after invalidation the client's v1 no longer matches
+	current.Version = "v2"
+	cache.Invalidate("/products/1")
+
+	result, err = pipeline(conditionalRequest("/products/1", "v1"))
+	if err != nil || result.(*versionedResource).Version != "v2" {
+		t.Fatalf("Expected v2 after invalidation, got %v, %v", result, err)
+	}
+	if _, err := pipeline(conditionalRequest("/products/1", "v2")); !errors.Is(err, ErrNotModified) {
+		t.Errorf("Expected v2 to be cached, got: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 handler calls, got %d", calls)
+	}
+}
+
+func TestConditionalCache_TTLAndErrors(t *testing.T) {
+	current := &versionedResource{Name: "widget", Version: "v1"}
+	calls := 0
+	cache, pipeline := newTestConditionalCache(current, &calls, WithCacheTTL(time.Minute))
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	cache.now = clock.now
+
+	pipeline(conditionalRequest("/products/1", ""))
+	clock.advance(time.Minute)
+
+	if _, err := pipeline(conditionalRequest("/products/1", "v1")); err != nil {
+		t.Errorf("Expected expired version to run the handler, got: %v", err)
+	}
+
+	// Verify: Handler errors do not touch the cache
+	failing := Chain(func(ctx *UniversalContext[ConditionalMeta]) (any, error) {
+		return nil, errors.New("db down")
+	}, cache)
+	if _, err := failing(conditionalRequest("/products/3", "")); err == nil || errors.Is(err, ErrNotModified) {
+		t.Errorf("Expected handler error, got: %v", err)
+	}
+	if _, ok := cache.lookup("/products/3"); ok {
+		t.Error("Expected failed request not to be cached")
+	}
+}
+
+func TestConditionalCache_DefaultTTL(t *testing.T) {
+	current := &versionedResource{Name: "widget", Version: "v1"}
+	calls := 0
+	cache, pipeline := newTestConditionalCache(current, &calls)
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	cache.now = clock.now
+
+	pipeline(conditionalRequest("/products/1", ""))
+	clock.advance(DefaultCacheTTL - time.Second)
+	if _, err := pipeline(conditionalRequest("/products/1", "v1")); !errors.Is(err, ErrNotModified) {
+		t.Fatalf("Expected version cached within the default TTL, got: %v", err)
+	}
+
+	clock.advance(time.Second)
+	if _, err := pipeline(conditionalRequest("/products/1", "v1")); err != nil {
+		t.Errorf("Expected version to expire after the default TTL, got: %v", err)
+	}
+}
+
+func TestConditionalCache_EvictsLeastRecentlyUsed(t *testing.T) {
+	current := &versionedResource{Name: "widget", Version: "v1"}
+	calls := 0
+	cache, pipeline := newTestConditionalCache(current, &calls, WithCacheMaxEntries(2))
+
+	pipeline(conditionalRequest("/products/1", ""))
+	pipeline(conditionalRequest("/products/2", ""))
+	pipeline(conditionalRequest("/products/1", "v1")) // /products/1 is now most recently used
+	pipeline(conditionalRequest("/products/3", ""))
+
+	if len(cache.entries) != 2 || cache.order.Len() != 2 {
+		t.Errorf("Expected 2 cached keys, got %d", len(cache.entries))
+	}
+	if _, ok := cache.lookup("/products/2"); ok {
+		t.Error("Expected least recently used key to be evicted")
+	}
+	for _, key := range []string{"/products/1", "/products/3"} {
+		if _, ok := cache.lookup(key); !ok {
+			t.Errorf("Expected %s to stay cached", key)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package interceptor
+
+import (
+	"context"
+	"sync"
+)
+
+// ConnectionBridge extends Bridge with lifecycle hooks for long-lived connections
+// (WebSocket, streaming RPC, etc.).
+// The interceptor chain runs once when the connection opens, not once per message.
+type ConnectionBridge[M any, NativeConn any] interface {
+	Bridge[M, NativeConn]
+
+	// OnConnect is called after the connection pipeline succeeds.
+	OnConnect(conn NativeConn, result any)
+
+	// OnDisconnect is called exactly once when the connection is closed.
+	// err is the close reason (nil for a normal close).
+	OnDisconnect(conn NativeConn, err error)
+}
+
+// BaseConnectionBridge provides default implementation for ConnectionBridge.
+// Frameworks can embed this and override specific methods.
+type BaseConnectionBridge[M any, NativeConn any] struct {
+	BaseBridge[M, NativeConn]
+	OnConnectFn    func(NativeConn, any)
+	OnDisconnectFn func(NativeConn, error)
+}
+
+// OnConnect implements ConnectionBridge interface (default: no-op).
+func (b *BaseConnectionBridge[M, NativeConn]) OnConnect(conn NativeConn, result any) {
+	if b.OnConnectFn != nil {
+		b.OnConnectFn(conn, result)
+	}
+}
+
+// OnDisconnect implements ConnectionBridge interface (default: no-op).
+func (b *BaseConnectionBridge[M, NativeConn]) OnDisconnect(conn NativeConn, err error) {
+	if b.OnDisconnectFn != nil {
+		b.OnDisconnectFn(conn, err)
+	}
+}
+
+// Connection is an open connection whose pipeline has already run.
+// Its context stays alive until Close is called.
+type Connection[M any, NativeConn any] struct {
+	bridge ConnectionBridge[M, NativeConn]
+	native NativeConn
+	ctx    *UniversalContext[M]
+	cancel context.CancelFunc
+	state  *connectionState
+	once   sync.Once
+}
+
+// connectionState holds cleanups registered by interceptors via AddCleanup.
+type connectionState struct {
+	mu       sync.Mutex
+	cleanups []func()
+}
+
+type connectionStateKey struct{}
+
+// AddCleanup registers fn to run when the connection owning ctx is closed.
+// Cleanups run in reverse registration order (LIFO).
+// Returns false if ctx does not belong to a connection opened by Connect.
+//
+// Example:
+//
+//	func presenceInterceptor(ctx *UniversalContext[WSMeta], next NextFunc[WSMeta]) (any, error) {
+//	    presence.Join(ctx.Meta.UserID)
+//	    interceptor.AddCleanup(ctx, func() { presence.Leave(ctx.Meta.UserID) })
+//	    return next(ctx)
+//	}
+func AddCleanup(ctx context.Context, fn func()) bool {
+	state, ok := ctx.Value(connectionStateKey{}).(*connectionState)
+	if !ok {
+		return false
+	}
+
+	state.mu.Lock()
+	state.cleanups = append(state.cleanups, fn)
+	state.mu.Unlock()
+	return true
+}
+
+// run executes registered cleanups in LIFO order.
+func (s *connectionState) run() {
+	s.mu.Lock()
+	cleanups := s.cleanups
+	s.cleanups = nil
+	s.mu.Unlock()
+
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i]()
+	}
+}
+
+// Connect runs the interceptor pipeline once for a new connection.
+// This provides the connection flow: Extract → Normalize → Gate → Resolve → Chain → Execute → OnConnect
+//
+// On success the returned Connection keeps the context alive until Close.
+// On error, cleanups registered so far are run, OnError is called and no Connection is returned.
+func Connect[M any, NativeConn any](
+	bridge ConnectionBridge[M, NativeConn],
+	resolver InterceptorResolver[M],
+	conn NativeConn,
+	handlerKey string,
+	connectHandler NextFunc[M],
+) (*Connection[M, NativeConn], error) {
+	uCtx := bridge.CreateUniversalContext(conn)
+	normalizeOperation(bridge, uCtx)
+
+	state := &connectionState{}
+	parent, cancel := context.WithCancel(uCtx.Context)
+	uCtx.Context = context.WithValue(parent, connectionStateKey{}, state)
+
+	fail := func(err error) (*Connection[M, NativeConn], error) {
+		state.run()
+		cancel()
+		bridge.OnError(conn, err)
+		return nil, err
+	}
+
+	if gate, ok := resolver.(Gate[M]); ok {
+		if err := gate.Allow(uCtx, handlerKey); err != nil {
+			return fail(err)
+		}
+	}
+
+	interceptors := resolver.Resolve(uCtx, handlerKey)
+	result, err := Chain(connectHandler, interceptors...)(uCtx)
+	if err != nil {
+		return fail(err)
+	}
+
+	bridge.OnConnect(conn, result)
+
+	return &Connection[M, NativeConn]{
+		bridge: bridge,
+		native: conn,
+		ctx:    uCtx,
+		cancel: cancel,
+		state:  state,
+	}, nil
+}
+
+// Context returns the connection context produced by the pipeline.
+// Values stored by interceptors are visible to every message handler.
+func (c *Connection[M, NativeConn]) Context() *UniversalContext[M] {
+	return c.ctx
+}
+
+// Handle runs handler for a single message using the connection context.
+// Interceptors are not re-run; they already ran once in Connect.
+func (c *Connection[M, NativeConn]) Handle(handler NextFunc[M]) (any, error) {
+	return handler(c.ctx)
+}
+
+// Close runs registered cleanups, cancels the connection context
+// and calls OnDisconnect. Only the first call has any effect.
+func (c *Connection[M, NativeConn]) Close(reason error) {
+	c.once.Do(func() {
+		c.state.run()
+		c.cancel()
+		c.bridge.OnDisconnect(c.native, reason)
+	})
+}
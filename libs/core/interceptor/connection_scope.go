@@ -0,0 +1,78 @@
+package interceptor
+
+// ConnectionSetupKey is the handler key NewConnectionScope resolves the setup chain with.
+// Resolvers return connection-level interceptors (auth, presence) for this key
+// and message-level interceptors for message handler keys.
+const ConnectionSetupKey = "connection.setup"
+
+// ConnectionScope keeps per-connection state for WebSocket/streaming bridges.
+// The setup chain runs once in NewConnectionScope; every message then runs its own
+// pipeline on a context derived from the setup context, so values stored during
+// setup (e.g. the authenticated principal) are visible to all message handlers.
+type ConnectionScope[M any, NativeConn any] struct {
+	*Connection[M, NativeConn]
+	resolver InterceptorResolver[M]
+}
+
+// NewConnectionScope runs the interceptors resolved for ConnectionSetupKey once
+// and returns a scope for the connection's messages.
+// Cleanups registered with AddCleanup during setup run on Close.
+//
+// Example:
+//
+//	scope, err := interceptor.NewConnectionScope(bridge, resolver, wsConn)
+//	if err != nil {
+//	    return // rejected; bridge.OnError was called
+//	}
+//	defer scope.Close(nil)
+//
+//	for msg := range wsConn.Messages() {
+//	    scope.PerMessage(msg.Type, handlers[msg.Type])
+//	}
+func NewConnectionScope[M any, NativeConn any](
+	bridge ConnectionBridge[M, NativeConn],
+	resolver InterceptorResolver[M],
+	nativeCtx NativeConn,
+) (*ConnectionScope[M, NativeConn], error) {
+	conn, err := Connect(bridge, resolver, nativeCtx, ConnectionSetupKey, func(ctx *UniversalContext[M]) (any, error) {
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ConnectionScope[M, NativeConn]{
+		Connection: conn,
+		resolver:   resolver,
+	}, nil
+}
+
+// PerMessage runs handler for one message through the interceptors resolved for handlerKey.
+// Setup interceptors are not re-run; the message context inherits the setup context's
+// values, and changes made while handling one message do not leak into the next
+// (changes inside Meta only with WithMetaClone, see UniversalContext.Derive).
+// This provides the message flow: Derive → Normalize → Gate → Resolve → Chain → Execute → OnSuccess/OnError
+func (s *ConnectionScope[M, NativeConn]) PerMessage(handlerKey string, handler NextFunc[M]) (any, error) {
+	msgCtx := s.ctx.Derive()
+	msgCtx.Method = handlerKey
+	msgCtx.Operation = ""
+	normalizeOperation(s.bridge, msgCtx)
+
+	if gate, ok := s.resolver.(Gate[M]); ok {
+		if err := gate.Allow(msgCtx, handlerKey); err != nil {
+			s.bridge.OnError(s.native, err)
+			return nil, err
+		}
+	}
+
+	interceptors := s.resolver.Resolve(msgCtx, handlerKey)
+	result, err := Chain(handler, interceptors...)(msgCtx)
+
+	if err != nil {
+		s.bridge.OnError(s.native, err)
+	} else {
+		s.bridge.OnSuccess(s.native, result)
+	}
+
+	return result, err
+}
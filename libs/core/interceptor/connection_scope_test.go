@@ -0,0 +1,129 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// keyResolver resolves interceptors by handler key
+type keyResolver map[string][]Interceptor[MockMeta]
+
+func (r keyResolver) Resolve(ctx *UniversalContext[MockMeta], handlerKey string) []Interceptor[MockMeta] {
+	return r[handlerKey]
+}
+
+type principalKey struct{}
+
+func TestConnectionScope_SetupRunsOnce(t *testing.T) {
+	setupRuns, messageRuns := 0, 0
+	var cleaned bool
+
+	auth := InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		setupRuns++
+		ctx.Context = context.WithValue(ctx.Context, principalKey{}, "principal:"+ctx.Meta.UserID)
+		AddCleanup(ctx, func() { cleaned = true })
+		return next(ctx)
+	})
+	perMessage := InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		messageRuns++
+		return next(ctx)
+	})
+
+	resolver := keyResolver{
+		ConnectionSetupKey: {auth},
+		"chat.send":        {perMessage},
+	}
+	native := &fakeConn{ID: "user123"}
+
+	scope, err := NewConnectionScope(newFakeConnBridge(), resolver, native)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	var seen []any
+	for i := 0; i < 3; i++ {
+		_, err := scope.PerMessage("chat.send", func(ctx *UniversalContext[MockMeta]) (any, error) {
+			seen = append(seen, ctx.Value(principalKey{}))
+			if ctx.Method != "chat.send" {
+				t.Errorf("Expected message Method 'chat.send', got %q", ctx.Method)
+			}
+			return nil, nil
+		})
+		if err != nil {
+			t.Fatalf("message %d: unexpected error %v", i, err)
+		}
+	}
+
+	if setupRuns != 1 {
+		t.Errorf("Expected setup to run once, ran %d times", setupRuns)
+	}
+	if messageRuns != 3 {
+		t.Errorf("Expected per-message interceptor to run 3 times, ran %d", messageRuns)
+	}
+	expected := []any{"principal:user123", "principal:user123", "principal:user123"}
+	if !reflect.DeepEqual(seen, expected) {
+		t.Errorf("Expected principal in every message, got %v", seen)
+	}
+
+	scope.Close(nil)
+	if !cleaned {
+		t.Error("Expected setup cleanup to run on Close")
+	}
+	if native.closes != 1 {
+		t.Errorf("Expected OnDisconnect once, got %d", native.closes)
+	}
+}
+
+func TestConnectionScope_MessageStateDoesNotLeak(t *testing.T) {
+	type msgKey struct{}
+	scope, err := NewConnectionScope(newFakeConnBridge(), keyResolver{}, &fakeConn{ID: "u"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	defer scope.Close(nil)
+
+	scope.PerMessage("first", func(ctx *UniversalContext[MockMeta]) (any, error) {
+		ctx.Context = context.WithValue(ctx.Context, msgKey{}, "first")
+		return nil, nil
+	})
+
+	result, _ := scope.PerMessage("second", func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return ctx.Value(msgKey{}), nil
+	})
+	if result != nil {
+		t.Errorf("Expected message values not to leak, got %v", result)
+	}
+}
+
+func TestConnectionScope_SetupRejected(t *testing.T) {
+	denied := errors.New("unauthenticated")
+	reject := InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		return nil, denied
+	})
+
+	scope, err := NewConnectionScope(newFakeConnBridge(), keyResolver{ConnectionSetupKey: {reject}}, &fakeConn{})
+	if !errors.Is(err, denied) || scope != nil {
+		t.Errorf("Expected setup error and no scope, got %v, %v", scope, err)
+	}
+}
+
+func TestConnectionScope_MessageErrorCallsOnError(t *testing.T) {
+	var hookErr error
+	bridge := newFakeConnBridge()
+	bridge.OnErrorFn = func(c *fakeConn, err error) { hookErr = err }
+
+	scope, _ := NewConnectionScope(bridge, keyResolver{}, &fakeConn{})
+	defer scope.Close(nil)
+
+	boom := errors.New("boom")
+	if _, err := scope.PerMessage("m", func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return nil, boom
+	}); !errors.Is(err, boom) {
+		t.Errorf("Expected handler error, got %v", err)
+	}
+	if hookErr != boom {
+		t.Errorf("Expected OnError to receive handler error, got %v", hookErr)
+	}
+}
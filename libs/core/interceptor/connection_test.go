@@ -0,0 +1,160 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type fakeConn struct {
+	ID        string
+	connected bool
+	closedErr error
+	closes    int
+}
+
+func newFakeConnBridge() *BaseConnectionBridge[MockMeta, *fakeConn] {
+	return &BaseConnectionBridge[MockMeta, *fakeConn]{
+		BaseBridge: BaseBridge[MockMeta, *fakeConn]{
+			Protocol: "ws",
+			ExtractMetaFn: func(c *fakeConn) MockMeta {
+				return MockMeta{UserID: c.ID}
+			},
+		},
+		OnConnectFn: func(c *fakeConn, result any) {
+			c.connected = true
+		},
+		OnDisconnectFn: func(c *fakeConn, err error) {
+			c.closes++
+			c.closedErr = err
+		},
+	}
+}
+
+func TestConnect_RunsChainOnce(t *testing.T) {
+	runs := 0
+	counter := InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		runs++
+		ctx.Context = context.WithValue(ctx.Context, "session", "s-1")
+		return next(ctx)
+	})
+
+	bridge := newFakeConnBridge()
+	resolver := &SimpleResolver[MockMeta]{Interceptors: []Interceptor[MockMeta]{counter}}
+	native := &fakeConn{ID: "user123"}
+
+	conn, err := Connect(bridge, resolver, native, "/ws", func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return "welcome", nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !native.connected {
+		t.Error("Expected OnConnect to be called")
+	}
+
+	for i := 0; i < 3; i++ {
+		result, err := conn.Handle(func(ctx *UniversalContext[MockMeta]) (any, error) {
+			return ctx.Value("session"), nil
+		})
+		if err != nil || result != "s-1" {
+			t.Errorf("Expected message to see session 's-1', got %v (err: %v)", result, err)
+		}
+	}
+
+	if runs != 1 {
+		t.Errorf("Expected chain to run once per connection, ran %d times", runs)
+	}
+	if conn.Context().Meta.UserID != "user123" {
+		t.Errorf("Expected UserID 'user123', got '%s'", conn.Context().Meta.UserID)
+	}
+}
+
+func TestConnection_CloseRunsCleanup(t *testing.T) {
+	var calls []string
+	cleanup := func(name string) Interceptor[MockMeta] {
+		return InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+			if !AddCleanup(ctx, func() { calls = append(calls, name) }) {
+				t.Errorf("Expected AddCleanup to succeed for %s", name)
+			}
+			return next(ctx)
+		})
+	}
+
+	bridge := newFakeConnBridge()
+	resolver := &SimpleResolver[MockMeta]{Interceptors: []Interceptor[MockMeta]{cleanup("first"), cleanup("second")}}
+	native := &fakeConn{}
+
+	conn, err := Connect(bridge, resolver, native, "/ws", func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	reason := errors.New("client went away")
+	conn.Close(reason)
+	conn.Close(nil)
+
+	expectedCalls := []string{"second", "first"}
+	if !equalSlices(calls, expectedCalls) {
+		t.Errorf("Expected cleanups %v, got %v", expectedCalls, calls)
+	}
+	if native.closes != 1 {
+		t.Errorf("Expected OnDisconnect once, got %d", native.closes)
+	}
+	if native.closedErr != reason {
+		t.Errorf("Expected close reason %v, got %v", reason, native.closedErr)
+	}
+	if conn.Context().Err() == nil {
+		t.Error("Expected connection context to be cancelled after Close")
+	}
+}
+
+func TestConnect_ErrorRunsCleanupAndOnError(t *testing.T) {
+	expectedErr := errors.New("unauthorized")
+	cleaned := false
+	var capturedError error
+
+	bridge := newFakeConnBridge()
+	bridge.OnErrorFn = func(c *fakeConn, err error) {
+		capturedError = err
+	}
+
+	resolver := &SimpleResolver[MockMeta]{Interceptors: []Interceptor[MockMeta]{
+		InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+			AddCleanup(ctx, func() { cleaned = true })
+			return next(ctx)
+		}),
+		InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+			return nil, expectedErr
+		}),
+	}}
+	native := &fakeConn{}
+
+	conn, err := Connect(bridge, resolver, native, "/ws", func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return nil, nil
+	})
+
+	if err != expectedErr {
+		t.Errorf("Expected error %v, got %v", expectedErr, err)
+	}
+	if conn != nil {
+		t.Error("Expected nil connection on error")
+	}
+	if !cleaned {
+		t.Error("Expected cleanup to run when connect fails")
+	}
+	if capturedError != expectedErr {
+		t.Errorf("Expected OnError to receive %v, got %v", expectedErr, capturedError)
+	}
+	if native.connected {
+		t.Error("Expected OnConnect not to be called on error")
+	}
+}
+
+func TestAddCleanup_OutsideConnection(t *testing.T) {
+	if AddCleanup(context.Background(), func() {}) {
+		t.Error("Expected AddCleanup to return false outside a connection")
+	}
+}
@@ -6,9 +6,33 @@ import "context"
 // Use context.WithValue for storing additional data.
 type UniversalContext[M any] struct {
 	context.Context
-	Protocol string // "http", "grpc", "kafka", etc.
-	Method   string // Route, RPC method, or topic name
-	Meta     M      // Adapter-specific metadata
+	Protocol  string // "http", "grpc", "kafka", etc.
+	Method    string // Route, RPC method, or topic name
+	Operation string // Canonical operation name (see MethodNormalizer), empty if unknown
+	Meta      M      // Adapter-specific metadata
+
+	cloneMeta func(M) M // Copies Meta for derived contexts (see WithMetaClone)
+}
+
+// ContextOption configures a UniversalContext created by NewUniversalContext.
+type ContextOption[M any] func(*UniversalContext[M])
+
+// WithMetaClone installs clone, used by Derive to give every derived context
+// (Parallel branches, connection scope messages) its own copy of Meta.
+// Provide it when M holds pointers, maps or slices that interceptors mutate:
+// Meta is copied by value, so without a clone those are shared across branches.
+//
+// Example:
+//
+//	interceptor.NewUniversalContext(ctx, "http", method, meta,
+//	    interceptor.WithMetaClone(func(m GinMeta) GinMeta {
+//	        m.Tags = maps.Clone(m.Tags)
+//	        return m
+//	    }))
+func WithMetaClone[M any](clone func(M) M) ContextOption[M] {
+	return func(c *UniversalContext[M]) {
+		c.cloneMeta = clone
+	}
 }
 
 // NewUniversalContext creates a new UniversalContext.
@@ -16,15 +40,44 @@ func NewUniversalContext[M any](
 	ctx context.Context,
 	protocol, method string,
 	meta M,
+	opts ...ContextOption[M],
 ) *UniversalContext[M] {
 	if ctx == nil {
 		ctx = context.Background()
 	}
 
-	return &UniversalContext[M]{
+	c := &UniversalContext[M]{
 		Context:  ctx,
 		Protocol: protocol,
 		Method:   method,
 		Meta:     meta,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// OperationName returns Operation if set, otherwise Method.
+// Resolvers, limits and metrics should key on this instead of Method.
+func (c *UniversalContext[M]) OperationName() string {
+	if c.Operation != "" {
+		return c.Operation
+	}
+	return c.Method
+}
+
+// Derive returns a copy of c for a concurrent or separate branch of work.
+// Meta is copied with the WithMetaClone function when one is installed, so changes
+// made through the copy do not reach c; otherwise it is a shallow copy.
+// Builds with the interceptordebug tag log a warning, once per type, when M holds
+// reference kinds and no clone function is installed.
+func (c *UniversalContext[M]) Derive() *UniversalContext[M] {
+	d := *c
+	if c.cloneMeta != nil {
+		d.Meta = c.cloneMeta(c.Meta)
+	} else {
+		warnSharedMeta[M]()
+	}
+	return &d
 }
@@ -0,0 +1,171 @@
+// Package compression provides a response compression interceptor for HTTP bridges.
+//
+// Encoding negotiation and compression are implemented once at the pipeline level.
+// Bridges only expose two capabilities on their Meta type (see Capable):
+// whether the client accepts an encoding, and a hook to swap the response writer.
+package compression
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+// Supported encodings, in default preference order.
+const (
+	Gzip    = "gzip"
+	Deflate = "deflate"
+)
+
+// DefaultMinSize is the response size (in bytes) below which bodies are sent uncompressed.
+const DefaultMinSize = 1024
+
+// Capable is implemented by the Meta type of HTTP bridges that support compression.
+type Capable interface {
+	// AcceptsEncoding reports whether the client accepts enc (usually via Accept-Encoding).
+	// Bridges can implement it with Accepts.
+	AcceptsEncoding(enc string) bool
+
+	// WrapResponseWriter replaces the response writer used by the handler with wrap(current).
+	// enc is the negotiated encoding.
+	WrapResponseWriter(enc string, wrap func(w http.ResponseWriter) http.ResponseWriter)
+}
+
+// Option configures the Compression interceptor.
+type Option func(*options)
+
+type options struct {
+	minSize      int
+	encodings    []string
+	skipPrefixes []string
+}
+
+// WithMinSize sets the minimum body size that gets compressed (default DefaultMinSize).
+func WithMinSize(n int) Option {
+	return func(o *options) {
+		o.minSize = n
+	}
+}
+
+// WithEncodings sets the supported encodings in preference order (default gzip, deflate).
+// Unknown encodings are ignored.
+func WithEncodings(encodings ...string) Option {
+	return func(o *options) {
+		o.encodings = encodings
+	}
+}
+
+// WithSkipContentTypes adds Content-Type prefixes that are never compressed,
+// in addition to the defaults (images, video, audio, archives).
+func WithSkipContentTypes(prefixes ...string) Option {
+	return func(o *options) {
+		o.skipPrefixes = append(o.skipPrefixes, prefixes...)
+	}
+}
+
+// defaultSkipPrefixes are content types that are already compressed.
+var defaultSkipPrefixes = []string{
+	"image/",
+	"video/",
+	"audio/",
+	"font/woff",
+	"application/zip",
+	"application/gzip",
+	"application/x-gzip",
+	"application/zstd",
+	"application/x-7z-compressed",
+	"application/x-rar-compressed",
+}
+
+// Compression creates an interceptor that compresses responses with the best encoding
+// the client accepts. Requests whose Meta does not implement Capable pass through untouched.
+//
+// Bodies smaller than the minimum size, responses that already carry a Content-Encoding,
+// and already-compressed content types are sent as-is. Streaming handlers that call Flush
+// are compressed incrementally instead of buffered.
+//
+// Example:
+//
+//	pipeline := interceptor.Chain(handler,
+//	    compression.Compression[*HTTPMeta](compression.WithMinSize(512)),
+//	    authInterceptor,
+//	)
+func Compression[M any](opts ...Option) interceptor.Interceptor[M] {
+	o := options{
+		minSize:      DefaultMinSize,
+		encodings:    []string{Gzip, Deflate},
+		skipPrefixes: append([]string(nil), defaultSkipPrefixes...),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return interceptor.InterceptorFunc[M](func(ctx *interceptor.UniversalContext[M], next interceptor.NextFunc[M]) (any, error) {
+		meta, ok := any(ctx.Meta).(Capable)
+		if !ok {
+			return next(ctx)
+		}
+
+		enc := negotiate(meta, o.encodings)
+		if enc == "" {
+			return next(ctx)
+		}
+
+		var cw *compressWriter
+		meta.WrapResponseWriter(enc, func(w http.ResponseWriter) http.ResponseWriter {
+			cw = newCompressWriter(w, enc, &o)
+			return cw
+		})
+		if cw == nil {
+			return next(ctx)
+		}
+
+		result, err := next(ctx)
+		if closeErr := cw.Close(); closeErr != nil && err == nil {
+			err = interceptor.NewInterceptorError("compression", closeErr)
+		}
+		return result, err
+	})
+}
+
+// negotiate returns the first supported encoding the client accepts.
+func negotiate(meta Capable, encodings []string) string {
+	for _, enc := range encodings {
+		if (enc == Gzip || enc == Deflate) && meta.AcceptsEncoding(enc) {
+			return enc
+		}
+	}
+	return ""
+}
+
+// Accepts reports whether an Accept-Encoding header value allows enc.
+// It honours q-values ("gzip;q=0") and the "*" wildcard.
+//
+// Example:
+//
+//	func (m *HTTPMeta) AcceptsEncoding(enc string) bool {
+//	    return compression.Accepts(m.Request.Header.Get("Accept-Encoding"), enc)
+//	}
+func Accepts(header, enc string) bool {
+	wildcard := false
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		name = strings.TrimSpace(name)
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+
+		switch {
+		case strings.EqualFold(name, enc):
+			return q > 0
+		case name == "*":
+			wildcard = q > 0
+		}
+	}
+	return wildcard
+}
@@ -0,0 +1,261 @@
+package compression
+
+import (
+	"bufio"
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+// httpMeta is a minimal stdhttp bridge Meta implementing Capable.
+type httpMeta struct {
+	req *http.Request
+	w   http.ResponseWriter
+}
+
+func (m *httpMeta) AcceptsEncoding(enc string) bool {
+	return Accepts(m.req.Header.Get("Accept-Encoding"), enc)
+}
+
+func (m *httpMeta) WrapResponseWriter(enc string, wrap func(http.ResponseWriter) http.ResponseWriter) {
+	m.w = wrap(m.w)
+}
+
+// newServer runs handler through the Compression interceptor for every request.
+func newServer(t *testing.T, handler func(m *httpMeta), opts ...Option) *httptest.Server {
+	t.Helper()
+	pipeline := interceptor.Chain(func(ctx *interceptor.UniversalContext[*httpMeta]) (any, error) {
+		handler(ctx.Meta)
+		return nil, nil
+	}, Compression[*httpMeta](opts...))
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		meta := &httpMeta{req: r, w: w}
+		ctx := interceptor.NewUniversalContext(r.Context(), "http", r.Method+" "+r.URL.Path, meta)
+		if _, err := pipeline(ctx); err != nil {
+			t.Errorf("pipeline error: %v", err)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func get(t *testing.T, url, acceptEncoding string) *http.Response {
+	t.Helper()
+	req, _ := http.NewRequest(http.MethodGet, url, nil)
+	if acceptEncoding != "" {
+		req.Header.Set("Accept-Encoding", acceptEncoding)
+	}
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	t.Cleanup(func() { resp.Body.Close() })
+	return resp
+}
+
+func TestCompression_GzipLargeBody(t *testing.T) {
+	body := strings.Repeat("hello compression ", 200)
+	srv := newServer(t, func(m *httpMeta) {
+		m.w.Header().Set("Content-Type", "text/plain")
+		m.w.Write([]byte(body))
+	})
+
+	resp := get(t, srv.URL, "gzip, deflate")
+
+	if got := resp.Header.Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("Expected Content-Encoding gzip, got %q", got)
+	}
+	if got := resp.Header.Get("Vary"); got != "Accept-Encoding" {
+		t.Errorf("Expected Vary: Accept-Encoding, got %q", got)
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatalf("invalid gzip body: %v", err)
+	}
+	decoded, _ := io.ReadAll(reader)
+	if string(decoded) != body {
+		t.Errorf("Decoded body does not match original (%d vs %d bytes)", len(decoded), len(body))
+	}
+}
+
+func TestCompression_Deflate(t *testing.T) {
+	body := strings.Repeat("deflate me ", 200)
+	srv := newServer(t, func(m *httpMeta) {
+		m.w.WriteHeader(http.StatusCreated)
+		m.w.Write([]byte(body))
+	})
+
+	resp := get(t, srv.URL, "deflate")
+
+	if resp.StatusCode != http.StatusCreated {
+		t.Errorf("Expected status 201, got %d", resp.StatusCode)
+	}
+	if got := resp.Header.Get("Content-Encoding"); got != "deflate" {
+		t.Fatalf("Expected Content-Encoding deflate, got %q", got)
+	}
+	reader, err := zlib.NewReader(resp.Body)
+	if err != nil {
+		t.Fatalf("invalid deflate body: %v", err)
+	}
+	decoded, _ := io.ReadAll(reader)
+	if string(decoded) != body {
+		t.Error("Decoded body does not match original")
+	}
+}
+
+func TestCompression_SmallBodyUncompressed(t *testing.T) {
+	srv := newServer(t, func(m *httpMeta) {
+		m.w.Write([]byte("tiny"))
+	})
+
+	resp := get(t, srv.URL, "gzip")
+
+	if got := resp.Header.Get("Content-Encoding"); got != "" {
+		t.Errorf("Expected no Content-Encoding for small body, got %q", got)
+	}
+	if body, _ := io.ReadAll(resp.Body); string(body) != "tiny" {
+		t.Errorf("Expected body %q, got %q", "tiny", body)
+	}
+}
+
+func TestCompression_SniffsMissingContentType(t *testing.T) {
+	body := "<!DOCTYPE html><html><body>" + strings.Repeat("<p>hello</p>", 200) + "</body></html>"
+	srv := newServer(t, func(m *httpMeta) {
+		m.w.Write([]byte(body))
+	})
+
+	resp := get(t, srv.URL, "gzip")
+
+	if got := resp.Header.Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("Expected Content-Encoding gzip, got %q", got)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "text/html; charset=utf-8" {
+		t.Errorf("Expected Content-Type sniffed from the uncompressed body, got %q", got)
+	}
+}
+
+func TestCompression_SniffedCompressedTypeStaysUncompressed(t *testing.T) {
+	// PNG signature: sniffed as image/png, which is skipped by default
+	body := "\x89PNG\r\n\x1a\n" + strings.Repeat("x", 4096)
+	srv := newServer(t, func(m *httpMeta) {
+		m.w.Write([]byte(body))
+	})
+
+	resp := get(t, srv.URL, "gzip")
+
+	if got := resp.Header.Get("Content-Encoding"); got != "" {
+		t.Errorf("Expected sniffed image/png to stay uncompressed, got %q", got)
+	}
+	if got := resp.Header.Get("Content-Type"); got != "image/png" {
+		t.Errorf("Expected Content-Type image/png, got %q", got)
+	}
+}
+
+func TestCompression_SkipsCompressedContentType(t *testing.T) {
+	body := strings.Repeat("x", 4096)
+	srv := newServer(t, func(m *httpMeta) {
+		m.w.Header().Set("Content-Type", "image/png")
+		m.w.Write([]byte(body))
+	})
+
+	resp := get(t, srv.URL, "gzip")
+
+	if got := resp.Header.Get("Content-Encoding"); got != "" {
+		t.Errorf("Expected image/png to stay uncompressed, got %q", got)
+	}
+	if got, _ := io.ReadAll(resp.Body); string(got) != body {
+		t.Error("Expected original body")
+	}
+}
+
+func TestCompression_ClientDoesNotAccept(t *testing.T) {
+	body := strings.Repeat("x", 4096)
+	srv := newServer(t, func(m *httpMeta) {
+		m.w.Write([]byte(body))
+	})
+
+	resp := get(t, srv.URL, "br, gzip;q=0")
+
+	if got := resp.Header.Get("Content-Encoding"); got != "" {
+		t.Errorf("Expected no compression, got %q", got)
+	}
+}
+
+func TestCompression_StreamingFlush(t *testing.T) {
+	release := make(chan struct{})
+	srv := newServer(t, func(m *httpMeta) {
+		m.w.Header().Set("Content-Type", "text/event-stream")
+		m.w.Write([]byte("data: first\n"))
+		m.w.(http.Flusher).Flush()
+		<-release
+		m.w.Write([]byte("data: second\n"))
+	})
+
+	resp := get(t, srv.URL, "gzip")
+	if got := resp.Header.Get("Content-Encoding"); got != "gzip" {
+		t.Fatalf("Expected streaming response to be gzip-encoded, got %q", got)
+	}
+
+	reader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		t.Fatalf("invalid gzip stream: %v", err)
+	}
+	lines := bufio.NewReader(reader)
+
+	// Verify: first chunk is readable before the handler finishes
+	first, err := lines.ReadString('\n')
+	if err != nil || first != "data: first\n" {
+		t.Fatalf("Expected first chunk before handler completes, got %q (%v)", first, err)
+	}
+	close(release)
+
+	rest, _ := io.ReadAll(lines)
+	if string(rest) != "data: second\n" {
+		t.Errorf("Expected second chunk, got %q", rest)
+	}
+}
+
+func TestCompression_NonCapableMetaPassesThrough(t *testing.T) {
+	called := false
+	pipeline := interceptor.Chain(func(ctx *interceptor.UniversalContext[string]) (any, error) {
+		called = true
+		return "ok", nil
+	}, Compression[string]())
+
+	result, err := pipeline(interceptor.NewUniversalContext[string](nil, "grpc", "/svc/Method", "meta"))
+	if err != nil || result != "ok" || !called {
+		t.Errorf("Expected pass-through, got result=%v err=%v called=%v", result, err, called)
+	}
+}
+
+func TestAccepts(t *testing.T) {
+	tests := []struct {
+		header string
+		enc    string
+		want   bool
+	}{
+		{"gzip, deflate", "gzip", true},
+		{"deflate", "gzip", false},
+		{"GZIP", "gzip", true},
+		{"gzip;q=0", "gzip", false},
+		{"gzip; q=0.5", "gzip", true},
+		{"*", "deflate", true},
+		{"*;q=0", "gzip", false},
+		{"gzip;q=0, *", "gzip", false},
+		{"", "gzip", false},
+	}
+
+	for _, tt := range tests {
+		if got := Accepts(tt.header, tt.enc); got != tt.want {
+			t.Errorf("Accepts(%q, %q) = %v, want %v", tt.header, tt.enc, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package compression
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// encoder is the common interface of gzip.Writer and zlib.Writer.
+type encoder interface {
+	io.WriteCloser
+	Flush() error
+}
+
+// compressWriter buffers the body until it reaches the minimum size, then commits
+// to compressing it. Flush commits early so streaming responses are compressed incrementally.
+type compressWriter struct {
+	http.ResponseWriter
+	enc  string
+	opts *options
+
+	buf     []byte
+	status  int
+	decided bool
+	encoder encoder // nil once decided means the body is passed through
+	err     error
+}
+
+func newCompressWriter(w http.ResponseWriter, enc string, opts *options) *compressWriter {
+	return &compressWriter{ResponseWriter: w, enc: enc, opts: opts}
+}
+
+// WriteHeader delays the status line until the compression decision is made.
+func (w *compressWriter) WriteHeader(status int) {
+	if w.decided {
+		w.ResponseWriter.WriteHeader(status)
+		return
+	}
+	if w.status != 0 {
+		return
+	}
+	w.status = status
+	if status == http.StatusNoContent || status == http.StatusNotModified || w.skip() {
+		w.decide(false)
+	}
+}
+
+// Write implements http.ResponseWriter.
+func (w *compressWriter) Write(p []byte) (int, error) {
+	if !w.decided {
+		if w.skip() {
+			w.decide(false)
+		} else {
+			w.buf = append(w.buf, p...)
+			if len(w.buf) >= w.opts.minSize {
+				w.decide(true)
+			}
+			return len(p), w.err
+		}
+	}
+
+	if w.encoder != nil {
+		return w.encoder.Write(p)
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Flush implements http.Flusher. A flush before the decision commits to compression,
+// since the handler is streaming and the final size is unknown.
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		w.decide(!w.skip())
+	}
+	if w.encoder != nil {
+		if err := w.encoder.Flush(); err != nil && w.err == nil {
+			w.err = err
+		}
+	}
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController.
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// Close sends any buffered body uncompressed if it never reached the minimum size,
+// or finishes the compressed stream.
+func (w *compressWriter) Close() error {
+	if !w.decided {
+		w.decide(false)
+	}
+	if w.encoder != nil {
+		if err := w.encoder.Close(); err != nil && w.err == nil {
+			w.err = err
+		}
+	}
+	return w.err
+}
+
+// decide writes the headers and the buffered body, compressed or not.
+func (w *compressWriter) decide(compress bool) {
+	w.decided = true
+	header := w.Header()
+
+	// net/http would sniff the compressed bytes (application/x-gzip), so sniff the plaintext instead
+	if compress && header.Get("Content-Type") == "" && len(w.buf) > 0 {
+		header.Set("Content-Type", http.DetectContentType(w.buf))
+		compress = !w.skip()
+	}
+
+	if header.Get("Content-Encoding") == "" {
+		header.Add("Vary", "Accept-Encoding")
+	}
+	if compress {
+		header.Set("Content-Encoding", w.enc)
+		header.Del("Content-Length")
+		if w.enc == Deflate {
+			w.encoder = zlib.NewWriter(w.ResponseWriter)
+		} else {
+			w.encoder = gzip.NewWriter(w.ResponseWriter)
+		}
+	}
+
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+
+	if len(w.buf) > 0 {
+		var err error
+		if w.encoder != nil {
+			_, err = w.encoder.Write(w.buf)
+		} else {
+			_, err = w.ResponseWriter.Write(w.buf)
+		}
+		if err != nil {
+			w.err = err
+		}
+		w.buf = nil
+	}
+}
+
+// skip reports whether the response must not be compressed:
+// it is already encoded or its content type is already compressed.
+func (w *compressWriter) skip() bool {
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return true
+	}
+
+	contentType := strings.ToLower(header.Get("Content-Type"))
+	for _, prefix := range w.opts.skipPrefixes {
+		if strings.HasPrefix(contentType, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+// Package otel provides an OpenTelemetry tracing interceptor.
+//
+// It lives in its own module so the core interceptor package stays free of the
+// OpenTelemetry dependency. Bridges opt into inbound propagation by exposing
+// the request headers on their Meta type (see Carrier and HeaderCarrier).
+package otel
+
+import (
+	"fmt"
+	"net/http"
+
+	otelglobal "go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+// Span attribute keys set by Tracing.
+const (
+	AttrProtocol  = attribute.Key("interceptor.protocol")
+	AttrMethod    = attribute.Key("interceptor.method")
+	AttrOperation = attribute.Key("interceptor.operation")
+)
+
+// Carrier is implemented by the Meta type of bridges that carry propagation headers
+// (HTTP headers, gRPC metadata, Kafka record headers).
+type Carrier interface {
+	// Carrier returns the inbound headers, e.g. propagation.HeaderCarrier(req.Header).
+	Carrier() propagation.TextMapCarrier
+}
+
+// HeaderCarrier is implemented by HTTP Meta types that expose the inbound request headers
+// without depending on OpenTelemetry (e.g. *stdhttp.HTTPMeta). Carrier takes precedence.
+type HeaderCarrier interface {
+	RequestHeader() http.Header
+}
+
+// Option configures the Tracing interceptor.
+type Option func(*options)
+
+type options struct {
+	propagator propagation.TextMapPropagator
+	spanKind   trace.SpanKind
+}
+
+// WithPropagator sets the propagator used to extract the parent span
+// (default: the global propagator from otel.GetTextMapPropagator).
+func WithPropagator(p propagation.TextMapPropagator) Option {
+	return func(o *options) {
+		o.propagator = p
+	}
+}
+
+// WithSpanKind sets the kind of the created spans (default trace.SpanKindServer).
+// Use trace.SpanKindConsumer for message consumers.
+func WithSpanKind(kind trace.SpanKind) Option {
+	return func(o *options) {
+		o.spanKind = kind
+	}
+}
+
+// Tracing creates an interceptor that wraps each request in a span.
+//
+// Behavior:
+//   - The span is named after ctx.OperationName() (Operation, falling back to Method)
+//   - If Meta implements Carrier (or HeaderCarrier), the parent span is extracted from the inbound headers
+//   - The span is stored in ctx.Context, so trace.SpanFromContext works downstream;
+//     ctx.Context is restored when the interceptor returns, so outer interceptors never see the ended span
+//   - Errors (and panics, which are re-raised) are recorded and set the span status to Error
+//
+// Example:
+//
+//	pipeline := interceptor.Chain(handler,
+//	    otel.Tracing[*HTTPMeta](otelglobal.Tracer("orders")),
+//	    authInterceptor,
+//	)
+func Tracing[M any](tracer trace.Tracer, opts ...Option) interceptor.Interceptor[M] {
+	o := options{spanKind: trace.SpanKindServer}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return interceptor.InterceptorFunc[M](func(ctx *interceptor.UniversalContext[M], next interceptor.NextFunc[M]) (result any, err error) {
+		propagator := o.propagator
+		if propagator == nil {
+			propagator = otelglobal.GetTextMapPropagator()
+		}
+
+		previous := ctx.Context
+		defer func() { ctx.Context = previous }()
+
+		parent := previous
+		if carrier := carrierOf(ctx.Meta); carrier != nil {
+			parent = propagator.Extract(parent, carrier)
+		}
+
+		attrs := []attribute.KeyValue{AttrProtocol.String(ctx.Protocol), AttrMethod.String(ctx.Method)}
+		if ctx.Operation != "" {
+			attrs = append(attrs, AttrOperation.String(ctx.Operation))
+		}
+
+		spanCtx, span := tracer.Start(parent, ctx.OperationName(),
+			trace.WithSpanKind(o.spanKind),
+			trace.WithAttributes(attrs...),
+		)
+		ctx.Context = spanCtx
+
+		defer func() {
+			if r := recover(); r != nil {
+				recordError(span, fmt.Errorf("panic: %v", r))
+				span.End()
+				panic(r)
+			}
+			if err != nil {
+				recordError(span, err)
+			}
+			span.End()
+		}()
+
+		return next(ctx)
+	})
+}
+
+// carrierOf returns the propagation headers exposed by meta, or nil.
+func carrierOf(meta any) propagation.TextMapCarrier {
+	switch m := meta.(type) {
+	case Carrier:
+		return m.Carrier()
+	case HeaderCarrier:
+		if h := m.RequestHeader(); h != nil {
+			return propagation.HeaderCarrier(h)
+		}
+	}
+	return nil
+}
+
+func recordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,251 @@
+package otel
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/compression"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/stdhttp"
+)
+
+// httpMeta exposes request headers as a propagation carrier
+type httpMeta struct {
+	Header http.Header
+}
+
+func (m httpMeta) Carrier() propagation.TextMapCarrier {
+	return propagation.HeaderCarrier(m.Header)
+}
+
+func newRecorder() (*tracetest.SpanRecorder, trace.Tracer) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	return recorder, provider.Tracer("test")
+}
+
+func run[M any](t *testing.T, i interceptor.Interceptor[M], uCtx *interceptor.UniversalContext[M], handler interceptor.NextFunc[M]) error {
+	t.Helper()
+	_, err := interceptor.Chain(handler, i)(uCtx)
+	return err
+}
+
+func TestTracing_SpanNameAndAttributes(t *testing.T) {
+	recorder, tracer := newRecorder()
+
+	uCtx := interceptor.NewUniversalContext(context.Background(), "http", "GET /users/42", httpMeta{Header: http.Header{}})
+	uCtx.Operation = "GET /users/:id"
+
+	var inHandler trace.SpanContext
+	err := run(t, Tracing[httpMeta](tracer), uCtx, func(ctx *interceptor.UniversalContext[httpMeta]) (any, error) {
+		inHandler = trace.SpanContextFromContext(ctx)
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "GET /users/:id" {
+		t.Errorf("Expected span named after Operation, got %q", span.Name())
+	}
+	if span.SpanKind() != trace.SpanKindServer {
+		t.Errorf("Expected server span, got %v", span.SpanKind())
+	}
+	if span.Status().Code != codes.Unset {
+		t.Errorf("Expected unset status on success, got %v", span.Status().Code)
+	}
+
+	attrs := make(map[string]string)
+	for _, kv := range span.Attributes() {
+		attrs[string(kv.Key)] = kv.Value.AsString()
+	}
+	if attrs["interceptor.protocol"] != "http" || attrs["interceptor.method"] != "GET /users/42" {
+		t.Errorf("Expected protocol and method attributes, got %v", attrs)
+	}
+
+	// Verify: Downstream code sees the span through ctx.Context
+	if inHandler.SpanID() != span.SpanContext().SpanID() {
+		t.Error("Expected the handler context to carry the request span")
+	}
+}
+
+func TestTracing_ErrorStatus(t *testing.T) {
+	recorder, tracer := newRecorder()
+	failure := errors.New("db down")
+
+	uCtx := interceptor.NewUniversalContext(context.Background(), "grpc", "/orders.Service/Get", struct{}{})
+	err := run(t, Tracing[struct{}](tracer), uCtx, func(ctx *interceptor.UniversalContext[struct{}]) (any, error) {
+		return nil, failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("Expected handler error to propagate, got %v", err)
+	}
+
+	span := recorder.Ended()[0]
+	if span.Name() != "/orders.Service/Get" {
+		t.Errorf("Expected span named after Method, got %q", span.Name())
+	}
+	if span.Status().Code != codes.Error || span.Status().Description != "db down" {
+		t.Errorf("Expected error status, got %+v", span.Status())
+	}
+	if len(span.Events()) != 1 || span.Events()[0].Name != "exception" {
+		t.Errorf("Expected recorded error event, got %v", span.Events())
+	}
+}
+
+func TestTracing_RestoresContext(t *testing.T) {
+	_, tracer := newRecorder()
+	uCtx := interceptor.NewUniversalContext(context.Background(), "http", "/orders", struct{}{})
+
+	var inner, outer trace.Span
+	observer := interceptor.InterceptorFunc[struct{}](func(ctx *interceptor.UniversalContext[struct{}], next interceptor.NextFunc[struct{}]) (any, error) {
+		result, err := next(ctx)
+		outer = trace.SpanFromContext(ctx.Context)
+		return result, err
+	})
+	_, err := interceptor.Chain(func(ctx *interceptor.UniversalContext[struct{}]) (any, error) {
+		inner = trace.SpanFromContext(ctx.Context)
+		return nil, nil
+	}, observer, Tracing[struct{}](tracer))(uCtx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !inner.SpanContext().IsValid() {
+		t.Error("Expected the handler to see the span")
+	}
+	if outer.SpanContext().IsValid() {
+		t.Errorf("Expected the outer interceptor not to see the ended span, got %v", outer.SpanContext().SpanID())
+	}
+	if uCtx.Context != context.Background() {
+		t.Error("Expected ctx.Context to be restored after the chain")
+	}
+}
+
+func TestTracing_PanicEndsSpan(t *testing.T) {
+	recorder, tracer := newRecorder()
+	uCtx := interceptor.NewUniversalContext(context.Background(), "http", "/boom", struct{}{})
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic to be re-raised")
+			}
+		}()
+		_ = run(t, Tracing[struct{}](tracer), uCtx, func(ctx *interceptor.UniversalContext[struct{}]) (any, error) {
+			panic("boom")
+		})
+	}()
+
+	spans := recorder.Ended()
+	if len(spans) != 1 || spans[0].Status().Code != codes.Error {
+		t.Fatalf("Expected 1 ended span with error status, got %v", spans)
+	}
+}
+
+func TestTracing_ParentFromTraceparent(t *testing.T) {
+	recorder, tracer := newRecorder()
+
+	const traceparent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	header := http.Header{}
+	header.Set("traceparent", traceparent)
+
+	uCtx := interceptor.NewUniversalContext(context.Background(), "http", "GET /users", httpMeta{Header: header})
+	i := Tracing[httpMeta](tracer, WithPropagator(propagation.TraceContext{}))
+	if err := run(t, i, uCtx, func(ctx *interceptor.UniversalContext[httpMeta]) (any, error) { return nil, nil }); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	span := recorder.Ended()[0]
+	if got := span.SpanContext().TraceID().String(); got != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected trace ID from traceparent, got %s", got)
+	}
+	if got := span.Parent().SpanID().String(); got != "00f067aa0ba902b7" {
+		t.Errorf("Expected parent span ID from traceparent, got %s", got)
+	}
+	if !span.Parent().IsRemote() {
+		t.Error("Expected parent to be marked remote")
+	}
+}
+
+func TestTracing_SpanKindOption(t *testing.T) {
+	recorder, tracer := newRecorder()
+	uCtx := interceptor.NewUniversalContext(context.Background(), "kafka", "orders.created", struct{}{})
+
+	i := Tracing[struct{}](tracer, WithSpanKind(trace.SpanKindConsumer))
+	if err := run(t, i, uCtx, func(ctx *interceptor.UniversalContext[struct{}]) (any, error) { return nil, nil }); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if kind := recorder.Ended()[0].SpanKind(); kind != trace.SpanKindConsumer {
+		t.Errorf("Expected consumer span, got %v", kind)
+	}
+}
+
+func TestTracing_StdhttpWithCompression(t *testing.T) {
+	recorder, tracer := newRecorder()
+
+	var inHandler trace.SpanContext
+	body := strings.Repeat("hello ", 512)
+	app := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		inHandler = trace.SpanContextFromContext(r.Context())
+		w.Header().Set("Content-Type", "text/plain")
+		io.WriteString(w, body)
+	})
+	handler := stdhttp.ToMiddleware(interceptor.NewSimpleResolver[*stdhttp.HTTPMeta](
+		Tracing[*stdhttp.HTTPMeta](tracer, WithPropagator(propagation.TraceContext{})),
+		compression.Compression[*stdhttp.HTTPMeta](),
+	))(app)
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	req.Header.Set("Accept-Encoding", "gzip")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	// Verify: *HTTPMeta is compression.Capable
+	if got := rec.Header().Get("Content-Encoding"); got != compression.Gzip {
+		t.Fatalf("Expected gzip response, got Content-Encoding %q", got)
+	}
+	zr, err := gzip.NewReader(rec.Body)
+	if err != nil {
+		t.Fatalf("Expected a gzip body, got %v", err)
+	}
+	decoded, err := io.ReadAll(zr)
+	if err != nil || string(decoded) != body {
+		t.Errorf("Expected the handler body after decompression, got %d bytes, err %v", len(decoded), err)
+	}
+
+	// Verify: the parent span comes from the request headers exposed by *HTTPMeta
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	span := spans[0]
+	if got := span.Parent().SpanID().String(); got != "00f067aa0ba902b7" {
+		t.Errorf("Expected parent span ID from traceparent, got %s", got)
+	}
+	if span.Name() != "GET /users" {
+		t.Errorf("Expected span named after the request, got %q", span.Name())
+	}
+	if inHandler.SpanID() != span.SpanContext().SpanID() {
+		t.Error("Expected the handler request context to carry the span")
+	}
+}
@@ -0,0 +1,139 @@
+package profiling
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"runtime/pprof"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+type TestMeta struct{}
+
+type ctxKey struct{}
+
+// run executes handler through ExecutePipelineContext with ProfilingLabels first in the chain.
+func run(operation string, handler interceptor.NextFunc[TestMeta], interceptors ...interceptor.Interceptor[TestMeta]) {
+	bridge := &interceptor.BaseBridge[TestMeta, TestMeta]{
+		Protocol:    "http",
+		GetMethodFn: func(TestMeta) string { return "POST /orders" },
+		Normalizer: interceptor.NormalizerFunc(func(protocol, method string) (string, bool) {
+			return operation, true
+		}),
+	}
+	resolver := interceptor.NewSimpleResolver(append([]interceptor.Interceptor[TestMeta]{ProfilingLabels[TestMeta]()}, interceptors...)...)
+	parent := context.WithValue(context.Background(), ctxKey{}, "parent")
+	interceptor.ExecutePipelineContext(parent, bridge, resolver, TestMeta{}, "", handler)
+}
+
+func TestProfilingLabels_HandlerReceivesLabeledContext(t *testing.T) {
+	var protocol, method, operation, parentValue any
+	var after context.Context
+
+	type userKey struct{}
+	// A later interceptor deriving its own context must keep the labels
+	withUser := interceptor.InterceptorFunc[TestMeta](func(ctx *interceptor.UniversalContext[TestMeta], next interceptor.NextFunc[TestMeta]) (any, error) {
+		ctx.Context = context.WithValue(ctx.Context, userKey{}, "alice")
+		return next(ctx)
+	})
+	run("orders.create", func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		protocol, _ = pprof.Label(ctx, LabelProtocol)
+		method, _ = pprof.Label(ctx, LabelMethod)
+		operation, _ = pprof.Label(ctx, LabelOperation)
+		parentValue = ctx.Value(ctxKey{})
+		after = ctx.Context
+		return nil, nil
+	}, withUser)
+
+	if protocol != "http" || method != "POST /orders" || operation != "orders.create" {
+		t.Errorf("Expected protocol=http method='POST /orders' operation=orders.create, got %v %v %v", protocol, method, operation)
+	}
+	if parentValue != "parent" {
+		t.Errorf("Expected labeled context to keep parent values, got %v", parentValue)
+	}
+	if _, ok := pprof.Label(after, LabelOperation); !ok {
+		t.Error("Expected the handler's context to carry the labels")
+	}
+}
+
+func TestProfilingLabels_RestoresContext(t *testing.T) {
+	ctx := interceptor.NewUniversalContext(context.Background(), "grpc", "/orders.Orders/Create", TestMeta{})
+	interceptor.Chain(func(*interceptor.UniversalContext[TestMeta]) (any, error) { return nil, nil }, ProfilingLabels[TestMeta]())(ctx)
+
+	if _, ok := pprof.Label(ctx, LabelOperation); ok {
+		t.Error("Expected labels to be removed from ctx after the chain")
+	}
+}
+
+// sink keeps busy's result live so the loop is not optimized away.
+var sink uint64
+
+// busy burns CPU for d.
+func busy(d time.Duration) {
+	deadline := time.Now().Add(d)
+	x := uint64(1)
+	for time.Now().Before(deadline) {
+		for i := 0; i < 10000; i++ {
+			x = x*6364136223846793005 + 1442695040888963407
+		}
+	}
+	sink = x
+}
+
+func TestProfilingLabels_AppearInCPUProfile(t *testing.T) {
+	if testing.Short() {
+		t.Skip("collects a CPU profile")
+	}
+
+	var buf bytes.Buffer
+	if err := pprof.StartCPUProfile(&buf); err != nil {
+		t.Skipf("CPU profiler unavailable: %v", err)
+	}
+	run("profiling.busy_operation", func(*interceptor.UniversalContext[TestMeta]) (any, error) {
+		busy(300 * time.Millisecond)
+		return nil, nil
+	})
+	pprof.StopCPUProfile()
+
+	// The profile is a gzipped protobuf; label keys and values live in its string table,
+	// which only holds strings referenced by samples.
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatalf("failed to read profile: %v", err)
+	}
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("failed to read profile: %v", err)
+	}
+	for _, s := range []string{LabelOperation, "profiling.busy_operation", LabelProtocol} {
+		if !bytes.Contains(raw, []byte(s)) {
+			t.Errorf("Expected %q in the profile's label set", s)
+		}
+	}
+}
+
+func BenchmarkProfilingLabels(b *testing.B) {
+	handler := func(*interceptor.UniversalContext[TestMeta]) (any, error) { return nil, nil }
+	chain := interceptor.Chain(handler, ProfilingLabels[TestMeta]())
+	ctx := interceptor.NewUniversalContext(context.Background(), "http", "GET /orders", TestMeta{})
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		chain(ctx)
+	}
+}
+
+func BenchmarkNoLabels(b *testing.B) {
+	handler := func(*interceptor.UniversalContext[TestMeta]) (any, error) { return nil, nil }
+	chain := interceptor.Chain(handler)
+	ctx := interceptor.NewUniversalContext(context.Background(), "http", "GET /orders", TestMeta{})
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		chain(ctx)
+	}
+}
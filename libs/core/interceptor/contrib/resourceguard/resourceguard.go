@@ -0,0 +1,189 @@
+// Package resourceguard provides an interceptor that watches the time and memory
+// each request uses, and reports (or rejects) requests that exceed a budget.
+//
+// Memory is measured as heap bytes allocated while the handler ran, read from
+// runtime/metrics ("/gc/heap/allocs:bytes"). The counter is process-wide, so
+// concurrent requests inflate each other's deltas: treat it as a diagnostic signal
+// for outliers, not an exact per-request figure.
+package resourceguard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"runtime"
+	"runtime/metrics"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+// heapAllocsMetric is the cumulative heap allocation counter read around each sampled request.
+const heapAllocsMetric = "/gc/heap/allocs:bytes"
+
+// ErrBudgetExceeded is matched (via errors.Is) by every BudgetError.
+var ErrBudgetExceeded = errors.New("resource budget exceeded")
+
+// BudgetError is returned in hard-fail mode when a request exceeds its budget.
+type BudgetError struct {
+	Method string
+	Usage  Usage
+}
+
+// Error implements the error interface.
+func (e *BudgetError) Error() string {
+	return fmt.Sprintf("resource budget exceeded: %s took %s, allocated %d bytes", e.Method, e.Usage.Duration, e.Usage.AllocBytes)
+}
+
+// Is makes errors.Is(err, ErrBudgetExceeded) match.
+func (e *BudgetError) Is(target error) bool {
+	return target == ErrBudgetExceeded
+}
+
+// Logger receives the diagnostic for requests over budget.
+// ISugaredLogger from the log library satisfies it.
+type Logger interface {
+	Warnw(msg string, keysAndValues ...any)
+}
+
+// GuardOptions configures ResourceGuard. Zero limits are disabled.
+type GuardOptions struct {
+	// MaxDuration is the time budget for a request.
+	MaxDuration time.Duration
+
+	// SoftMemoryDelta is the heap allocation budget for a request, in bytes.
+	SoftMemoryDelta uint64
+
+	// SampleRate is the fraction of requests whose memory is measured, in (0, 1].
+	// Duration is always measured. 0 measures every request.
+	SampleRate float64
+
+	// HardFail turns a breach into a *BudgetError instead of only logging it.
+	// The handler still runs to completion; its result is discarded.
+	// If the handler itself failed, its error is returned instead.
+	HardFail bool
+
+	// Logger receives a diagnostic for every breach. Optional.
+	Logger Logger
+
+	// Observer is called with the usage of every request. Optional.
+	Observer func(method string, usage Usage)
+}
+
+// Usage is what one request consumed.
+type Usage struct {
+	Duration   time.Duration
+	AllocBytes uint64 // heap bytes allocated while the request ran; 0 if not sampled
+	Sampled    bool   // whether AllocBytes was measured
+}
+
+type usageKey struct{}
+
+// UsageFromContext returns the usage ResourceGuard recorded for the request.
+// It is filled in once the guarded part of the chain returns, so interceptors
+// placed before ResourceGuard can read it after calling next.
+func UsageFromContext(ctx context.Context) (Usage, bool) {
+	u, ok := ctx.Value(usageKey{}).(*Usage)
+	if !ok {
+		return Usage{}, false
+	}
+	return *u, true
+}
+
+// ResourceGuard creates an interceptor that measures the duration and heap allocations of
+// next and reports requests exceeding opts' budget with a diagnostic log entry
+// (method, duration, allocated bytes, goroutine count). Requests are not failed unless
+// opts.HardFail is set.
+//
+// Example:
+//
+//	guard := resourceguard.ResourceGuard[GinMeta](resourceguard.GuardOptions{
+//	    MaxDuration:     2 * time.Second,
+//	    SoftMemoryDelta: 256 << 20, // 256 MiB
+//	    SampleRate:      0.05,      // measure memory on 5% of requests
+//	    Logger:          logger,
+//	})
+func ResourceGuard[M any](opts GuardOptions) interceptor.Interceptor[M] {
+	g := &guard{opts: opts, sample: sampler(opts.SampleRate)}
+
+	return interceptor.InterceptorFunc[M](func(ctx *interceptor.UniversalContext[M], next interceptor.NextFunc[M]) (any, error) {
+		method := ctx.OperationName()
+		usage := &Usage{Sampled: g.sample()}
+		ctx.Context = context.WithValue(ctx.Context, usageKey{}, usage)
+
+		var before uint64
+		if usage.Sampled {
+			before = heapAllocs()
+		}
+		start := time.Now()
+
+		result, err := next(ctx)
+
+		usage.Duration = time.Since(start)
+		if usage.Sampled {
+			usage.AllocBytes = heapAllocs() - before
+		}
+
+		if breachErr := g.check(method, *usage); breachErr != nil && err == nil {
+			return nil, breachErr
+		}
+		return result, err
+	})
+}
+
+// guard holds the configuration shared by every request.
+type guard struct {
+	opts   GuardOptions
+	sample func() bool
+}
+
+// check reports usage to the observer and logger, and returns a *BudgetError
+// for a breach in hard-fail mode.
+func (g *guard) check(method string, usage Usage) error {
+	if g.opts.Observer != nil {
+		g.opts.Observer(method, usage)
+	}
+
+	overTime := g.opts.MaxDuration > 0 && usage.Duration > g.opts.MaxDuration
+	overMemory := g.opts.SoftMemoryDelta > 0 && usage.AllocBytes > g.opts.SoftMemoryDelta
+	if !overTime && !overMemory {
+		return nil
+	}
+
+	if g.opts.Logger != nil {
+		g.opts.Logger.Warnw("resource budget exceeded",
+			"method", method,
+			"duration", usage.Duration,
+			"max_duration", g.opts.MaxDuration,
+			"alloc_bytes", usage.AllocBytes,
+			"soft_memory_delta", g.opts.SoftMemoryDelta,
+			"memory_sampled", usage.Sampled,
+			"goroutines", runtime.NumGoroutine(),
+			"hard_fail", g.opts.HardFail,
+		)
+	}
+
+	if g.opts.HardFail {
+		return &BudgetError{Method: method, Usage: usage}
+	}
+	return nil
+}
+
+// sampler returns a func deciding whether to measure memory for a request.
+func sampler(rate float64) func() bool {
+	if rate <= 0 || rate >= 1 {
+		return func() bool { return true }
+	}
+	return func() bool { return rand.Float64() < rate }
+}
+
+// heapAllocs reads the cumulative heap allocation counter.
+func heapAllocs() uint64 {
+	sample := []metrics.Sample{{Name: heapAllocsMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
@@ -0,0 +1,160 @@
+package resourceguard
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+type TestMeta struct{}
+
+type diagnostic struct {
+	msg    string
+	fields map[string]any
+}
+
+type recordingLogger struct {
+	entries []diagnostic
+}
+
+func (r *recordingLogger) Warnw(msg string, keysAndValues ...any) {
+	fields := make(map[string]any)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+	r.entries = append(r.entries, diagnostic{msg, fields})
+}
+
+// retained keeps allocations reachable so the compiler cannot elide them.
+var retained []byte
+
+// allocating allocates n bytes and returns "done".
+func allocating(n int) interceptor.NextFunc[TestMeta] {
+	return func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		retained = make([]byte, n)
+		return "done", nil
+	}
+}
+
+func run(guard interceptor.Interceptor[TestMeta], handler interceptor.NextFunc[TestMeta]) (any, error) {
+	ctx := interceptor.NewUniversalContext(context.Background(), "http", "POST /reports", TestMeta{})
+	return interceptor.Chain(handler, guard)(ctx)
+}
+
+func TestResourceGuard_SoftModeLogsWithoutFailing(t *testing.T) {
+	logger := &recordingLogger{}
+	guard := ResourceGuard[TestMeta](GuardOptions{SoftMemoryDelta: 1 << 20, Logger: logger})
+
+	result, err := run(guard, allocating(8<<20))
+	if err != nil || result != "done" {
+		t.Fatalf("Expected soft mode to keep the result, got %v, %v", result, err)
+	}
+
+	if len(logger.entries) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %+v", logger.entries)
+	}
+	fields := logger.entries[0].fields
+	if fields["method"] != "POST /reports" {
+		t.Errorf("Expected method in diagnostic, got %v", fields["method"])
+	}
+	if alloc, _ := fields["alloc_bytes"].(uint64); alloc < 8<<20 {
+		t.Errorf("Expected alloc_bytes >= 8 MiB, got %v", fields["alloc_bytes"])
+	}
+	if goroutines, _ := fields["goroutines"].(int); goroutines < 1 {
+		t.Errorf("Expected goroutine count, got %v", fields["goroutines"])
+	}
+}
+
+func TestResourceGuard_HardModeFailsRequest(t *testing.T) {
+	logger := &recordingLogger{}
+	guard := ResourceGuard[TestMeta](GuardOptions{SoftMemoryDelta: 1 << 20, HardFail: true, Logger: logger})
+
+	result, err := run(guard, allocating(8<<20))
+	if !errors.Is(err, ErrBudgetExceeded) || result != nil {
+		t.Fatalf("Expected ErrBudgetExceeded without result, got %v, %v", result, err)
+	}
+	var budgetErr *BudgetError
+	if !errors.As(err, &budgetErr) || budgetErr.Method != "POST /reports" || budgetErr.Usage.AllocBytes < 8<<20 {
+		t.Errorf("Expected BudgetError with usage, got: %v", err)
+	}
+	if len(logger.entries) != 1 {
+		t.Errorf("Expected diagnostic in hard mode too, got %+v", logger.entries)
+	}
+
+	// Verify: Requests within budget pass
+	if _, err := run(guard, allocating(1024)); err != nil {
+		t.Errorf("Expected small request to pass, got: %v", err)
+	}
+}
+
+func TestResourceGuard_MaxDuration(t *testing.T) {
+	logger := &recordingLogger{}
+	guard := ResourceGuard[TestMeta](GuardOptions{MaxDuration: 5 * time.Millisecond, HardFail: true, Logger: logger})
+
+	_, err := run(guard, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		time.Sleep(20 * time.Millisecond)
+		return "done", nil
+	})
+	if !errors.Is(err, ErrBudgetExceeded) {
+		t.Fatalf("Expected ErrBudgetExceeded, got: %v", err)
+	}
+	if d, _ := logger.entries[0].fields["duration"].(time.Duration); d < 20*time.Millisecond {
+		t.Errorf("Expected duration >= 20ms in diagnostic, got %v", logger.entries[0].fields["duration"])
+	}
+
+	// Verify: The handler's own error wins over the breach
+	handlerErr := errors.New("report failed")
+	_, err = run(guard, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		time.Sleep(10 * time.Millisecond)
+		return nil, handlerErr
+	})
+	if !errors.Is(err, handlerErr) {
+		t.Errorf("Expected handler error, got: %v", err)
+	}
+}
+
+func TestResourceGuard_UsageReported(t *testing.T) {
+	var observed Usage
+	guard := ResourceGuard[TestMeta](GuardOptions{
+		Observer: func(method string, usage Usage) { observed = usage },
+	})
+
+	var fromContext Usage
+	outer := interceptor.InterceptorFunc[TestMeta](func(ctx *interceptor.UniversalContext[TestMeta], next interceptor.NextFunc[TestMeta]) (any, error) {
+		result, err := next(ctx)
+		fromContext, _ = UsageFromContext(ctx)
+		return result, err
+	})
+	ctx := interceptor.NewUniversalContext(context.Background(), "http", "POST /reports", TestMeta{})
+	if _, err := interceptor.Chain(allocating(4<<20), outer, guard)(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !observed.Sampled || observed.AllocBytes < 4<<20 {
+		t.Errorf("Expected sampled usage >= 4 MiB, got %+v", observed)
+	}
+	if fromContext != observed {
+		t.Errorf("Expected context usage %+v to match observer %+v", fromContext, observed)
+	}
+}
+
+func TestResourceGuard_SampleRateSkipsMemory(t *testing.T) {
+	logger := &recordingLogger{}
+	var observed Usage
+	guard := ResourceGuard[TestMeta](GuardOptions{
+		SoftMemoryDelta: 1 << 20,
+		SampleRate:      1e-12, // practically never
+		Logger:          logger,
+		Observer:        func(method string, usage Usage) { observed = usage },
+	})
+
+	if _, err := run(guard, allocating(8<<20)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if observed.Sampled || observed.AllocBytes != 0 || len(logger.entries) != 0 {
+		t.Errorf("Expected unsampled request without memory diagnostic, got %+v %+v", observed, logger.entries)
+	}
+}
@@ -0,0 +1,158 @@
+// Package stdhttp bridges net/http handlers and the interceptor pipeline.
+//
+// Besides the bridge itself, it converts between the two middleware styles:
+// FromMiddleware runs classic func(http.Handler) http.Handler middleware (CORS, gzip, ...)
+// as an Interceptor, and ToMiddleware runs a pipeline as classic middleware.
+package stdhttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor/contrib/compression"
+)
+
+// Protocol is the UniversalContext.Protocol set by the bridge.
+const Protocol = "http"
+
+// HTTPMeta exposes the native response writer and request to interceptors.
+// Interceptors may replace either one; the replacement is what later interceptors,
+// converted middleware and the handler see.
+//
+// *HTTPMeta implements compression.Capable, and RequestHeader lets the otel contrib
+// extract the parent span, so both contribs work with this bridge.
+type HTTPMeta struct {
+	Writer  http.ResponseWriter
+	Request *http.Request
+}
+
+// AcceptsEncoding implements compression.Capable using the request's Accept-Encoding.
+func (m *HTTPMeta) AcceptsEncoding(enc string) bool {
+	return compression.Accepts(m.Request.Header.Get("Accept-Encoding"), enc)
+}
+
+// WrapResponseWriter implements compression.Capable by replacing Writer with wrap(Writer).
+func (m *HTTPMeta) WrapResponseWriter(enc string, wrap func(http.ResponseWriter) http.ResponseWriter) {
+	m.Writer = wrap(m.Writer)
+}
+
+// RequestHeader returns the inbound request headers, e.g. for trace context propagation.
+func (m *HTTPMeta) RequestHeader() http.Header {
+	return m.Request.Header
+}
+
+// NewBridge creates a bridge for net/http. The native context is the *HTTPMeta itself,
+// the method is "<METHOD> <path>" and the context is the request context.
+// Derived contexts (see interceptor.Parallel) get a copy of the HTTPMeta.
+//
+// Example:
+//
+//	bridge := stdhttp.NewBridge()
+//	http.HandleFunc("/users", func(w http.ResponseWriter, r *http.Request) {
+//	    interceptor.ExecutePipeline(bridge, resolver, &stdhttp.HTTPMeta{Writer: w, Request: r}, "/users", listUsers)
+//	})
+func NewBridge() *interceptor.BaseBridge[*HTTPMeta, *HTTPMeta] {
+	return &interceptor.BaseBridge[*HTTPMeta, *HTTPMeta]{
+		Protocol:      Protocol,
+		ExtractMetaFn: func(m *HTTPMeta) *HTTPMeta { return m },
+		GetMethodFn:   func(m *HTTPMeta) string { return m.Request.Method + " " + m.Request.URL.Path },
+		GetContextFn:  func(m *HTTPMeta) context.Context { return m.Request.Context() },
+		MetaClone: func(m *HTTPMeta) *HTTPMeta {
+			clone := *m
+			return &clone
+		},
+	}
+}
+
+// FromMiddleware adapts classic net/http middleware into an Interceptor.
+//
+// The middleware runs around a shim handler that calls next. The writer and request the
+// middleware passes to the shim (e.g. a gzip writer, a request with added context values)
+// become ctx.Meta.Writer, ctx.Meta.Request and ctx.Context for the rest of the chain,
+// and are restored once the middleware returns.
+//
+// If the middleware responds without calling the shim (e.g. a CORS preflight),
+// the rest of the chain and the handler do not run and the interceptor returns (nil, nil).
+//
+// Limitations:
+//   - The middleware must call the shim synchronously, at most once. A middleware that
+//     retries runs the rest of the chain again; one that calls it from another goroutine
+//     after returning is not supported
+//   - Middleware that hijacks the connection (WebSocket upgraders) takes over the response;
+//     interceptors before it must not write to ctx.Meta.Writer afterwards
+//   - Errors from next are returned to the interceptors before it, but the middleware
+//     itself only sees what the handler wrote to the response
+//
+// Example:
+//
+//	resolver := interceptor.NewSimpleResolver(
+//	    loggingInterceptor,
+//	    stdhttp.FromMiddleware(cors.Default().Handler),
+//	    authInterceptor,
+//	)
+func FromMiddleware(mw func(http.Handler) http.Handler) interceptor.Interceptor[*HTTPMeta] {
+	return interceptor.InterceptorFunc[*HTTPMeta](func(ctx *interceptor.UniversalContext[*HTTPMeta], next interceptor.NextFunc[*HTTPMeta]) (any, error) {
+		outerMeta, outerCtx := ctx.Meta, ctx.Context
+		defer func() {
+			ctx.Meta, ctx.Context = outerMeta, outerCtx
+		}()
+
+		var result any
+		var err error
+		shim := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx.Meta = &HTTPMeta{Writer: w, Request: r}
+			ctx.Context = r.Context()
+			result, err = next(ctx)
+		})
+
+		mw(shim).ServeHTTP(outerMeta.Writer, requestWithContext(outerMeta.Request, outerCtx))
+		return result, err
+	})
+}
+
+// ToMiddleware wraps a pipeline as classic net/http middleware: every request runs through
+// the interceptors resolved for "<METHOD> <path>", and the wrapped handler is the business
+// handler at the end of the chain.
+//
+// If the pipeline returns an error before the wrapped handler runs (e.g. auth rejected the
+// request), the response is 500 Internal Server Error, or 304 Not Modified for
+// interceptor.ErrNotModified (see interceptor.ConditionalCacheInterceptor).
+// Errors after it ran are dropped, since the response has already been written.
+//
+// Example:
+//
+//	resolver := interceptor.NewSimpleResolver(loggingInterceptor, authInterceptor)
+//	http.ListenAndServe(":8080", stdhttp.ToMiddleware(resolver)(mux))
+func ToMiddleware(resolver interceptor.InterceptorResolver[*HTTPMeta]) func(http.Handler) http.Handler {
+	bridge := NewBridge()
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served := false
+			handler := func(ctx *interceptor.UniversalContext[*HTTPMeta]) (any, error) {
+				served = true
+				next.ServeHTTP(ctx.Meta.Writer, requestWithContext(ctx.Meta.Request, ctx.Context))
+				return nil, nil
+			}
+
+			meta := &HTTPMeta{Writer: w, Request: r}
+			_, err := interceptor.ExecutePipeline(bridge, resolver, meta, r.Method+" "+r.URL.Path, handler)
+			if err != nil && !served {
+				if errors.Is(err, interceptor.ErrNotModified) {
+					w.WriteHeader(http.StatusNotModified)
+					return
+				}
+				http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			}
+		})
+	}
+}
+
+// requestWithContext returns r carrying ctx, copying r only if the context changed.
+func requestWithContext(r *http.Request, ctx context.Context) *http.Request {
+	if ctx == nil || r.Context() == ctx {
+		return r
+	}
+	return r.WithContext(ctx)
+}
@@ -0,0 +1,242 @@
+package stdhttp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+// corsOptions and newCORS mirror the shape of third-party CORS packages:
+// options struct in, func(http.Handler) http.Handler out.
+type corsOptions struct {
+	AllowedOrigins []string
+	AllowedMethods []string
+}
+
+func newCORS(opts corsOptions, steps *[]string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*steps = append(*steps, "cors")
+			origin := r.Header.Get("Origin")
+			for _, allowed := range opts.AllowedOrigins {
+				if allowed == origin {
+					w.Header().Set("Access-Control-Allow-Origin", origin)
+					w.Header().Add("Vary", "Origin")
+				}
+			}
+			if r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != "" {
+				w.Header().Set("Access-Control-Allow-Methods", strings.Join(opts.AllowedMethods, ", "))
+				w.WriteHeader(http.StatusNoContent)
+				return // preflight: next is not called
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// recordInterceptor appends "<name>→" and "←<name>" around next.
+func recordInterceptor(name string, steps *[]string) interceptor.Interceptor[*HTTPMeta] {
+	return interceptor.InterceptorFunc[*HTTPMeta](func(ctx *interceptor.UniversalContext[*HTTPMeta], next interceptor.NextFunc[*HTTPMeta]) (any, error) {
+		*steps = append(*steps, name+"→")
+		result, err := next(ctx)
+		*steps = append(*steps, "←"+name)
+		return result, err
+	})
+}
+
+// serve runs req through the interceptors with a handler that writes "ok".
+func serve(req *http.Request, steps *[]string, interceptors ...interceptor.Interceptor[*HTTPMeta]) *httptest.ResponseRecorder {
+	rec := httptest.NewRecorder()
+	handler := func(ctx *interceptor.UniversalContext[*HTTPMeta]) (any, error) {
+		*steps = append(*steps, "handler")
+		ctx.Meta.Writer.Write([]byte("ok"))
+		return nil, nil
+	}
+	interceptor.ExecutePipeline(NewBridge(), interceptor.NewSimpleResolver(interceptors...), &HTTPMeta{Writer: rec, Request: req}, "", handler)
+	return rec
+}
+
+func TestFromMiddleware_CORSAroundNativeInterceptors(t *testing.T) {
+	var steps []string
+	cors := newCORS(corsOptions{AllowedOrigins: []string{"https://app.example"}, AllowedMethods: []string{"GET", "POST"}}, &steps)
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.Header.Set("Origin", "https://app.example")
+	rec := serve(req, &steps,
+		recordInterceptor("log", &steps),
+		FromMiddleware(cors),
+		recordInterceptor("auth", &steps),
+	)
+
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example" {
+		t.Errorf("Expected CORS origin header, got %q", got)
+	}
+	if rec.Body.String() != "ok" {
+		t.Errorf("Expected handler body, got %q", rec.Body.String())
+	}
+	want := []string{"log→", "cors", "auth→", "handler", "←auth", "←log"}
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("Expected steps %v, got %v", want, steps)
+	}
+}
+
+func TestFromMiddleware_PreflightShortCircuits(t *testing.T) {
+	var steps []string
+	cors := newCORS(corsOptions{AllowedOrigins: []string{"https://app.example"}, AllowedMethods: []string{"GET", "POST"}}, &steps)
+
+	req := httptest.NewRequest(http.MethodOptions, "/users", nil)
+	req.Header.Set("Origin", "https://app.example")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	rec := serve(req, &steps,
+		recordInterceptor("log", &steps),
+		FromMiddleware(cors),
+		recordInterceptor("auth", &steps),
+	)
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected 204, got %d", rec.Code)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Methods"); got != "GET, POST" {
+		t.Errorf("Expected allowed methods header, got %q", got)
+	}
+	want := []string{"log→", "cors", "←log"}
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("Expected steps %v, got %v", want, steps)
+	}
+}
+
+type ctxKey struct{}
+
+// taggingWriter marks the body written through it.
+type taggingWriter struct {
+	http.ResponseWriter
+}
+
+func (w taggingWriter) Write(b []byte) (int, error) {
+	return w.ResponseWriter.Write(append([]byte("tagged:"), b...))
+}
+
+func TestFromMiddleware_ExposesWrappedWriterAndRequest(t *testing.T) {
+	var steps []string
+	wrap := func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(taggingWriter{w}, r.WithContext(context.WithValue(r.Context(), ctxKey{}, "from-mw")))
+		})
+	}
+
+	var seen any
+	var outerWriter http.ResponseWriter
+	inspect := interceptor.InterceptorFunc[*HTTPMeta](func(ctx *interceptor.UniversalContext[*HTTPMeta], next interceptor.NextFunc[*HTTPMeta]) (any, error) {
+		seen = ctx.Value(ctxKey{})
+		return next(ctx)
+	})
+	restore := interceptor.InterceptorFunc[*HTTPMeta](func(ctx *interceptor.UniversalContext[*HTTPMeta], next interceptor.NextFunc[*HTTPMeta]) (any, error) {
+		result, err := next(ctx)
+		outerWriter = ctx.Meta.Writer
+		return result, err
+	})
+
+	rec := serve(httptest.NewRequest(http.MethodGet, "/", nil), &steps, restore, FromMiddleware(wrap), inspect)
+
+	if seen != "from-mw" {
+		t.Errorf("Expected middleware context value in ctx, got %v", seen)
+	}
+	if rec.Body.String() != "tagged:ok" {
+		t.Errorf("Expected handler to write through the wrapped writer, got %q", rec.Body.String())
+	}
+	if outerWriter != rec {
+		t.Errorf("Expected writer to be restored after the middleware, got %T", outerWriter)
+	}
+}
+
+func TestToMiddleware_RunsPipelineAroundHandler(t *testing.T) {
+	var steps []string
+	errUnauthorized := errors.New("unauthorized")
+	auth := interceptor.InterceptorFunc[*HTTPMeta](func(ctx *interceptor.UniversalContext[*HTTPMeta], next interceptor.NextFunc[*HTTPMeta]) (any, error) {
+		if ctx.Meta.Request.Header.Get("Authorization") == "" {
+			return nil, errUnauthorized
+		}
+		ctx.Context = context.WithValue(ctx.Context, ctxKey{}, "alice")
+		return next(ctx)
+	})
+
+	var method string
+	app := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		steps = append(steps, "handler")
+		w.Write([]byte("hello " + r.Context().Value(ctxKey{}).(string)))
+	})
+	resolver := interceptor.NewSimpleResolver[*HTTPMeta](
+		recordInterceptor("log", &steps),
+		interceptor.InterceptorFunc[*HTTPMeta](func(ctx *interceptor.UniversalContext[*HTTPMeta], next interceptor.NextFunc[*HTTPMeta]) (any, error) {
+			method = ctx.Method
+			return next(ctx)
+		}),
+		auth,
+	)
+	handler := ToMiddleware(resolver)(app)
+
+	req := httptest.NewRequest(http.MethodGet, "/greet", nil)
+	req.Header.Set("Authorization", "Bearer token")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK || rec.Body.String() != "hello alice" {
+		t.Errorf("Expected 200 'hello alice', got %d %q", rec.Code, rec.Body.String())
+	}
+	if method != "GET /greet" {
+		t.Errorf("Expected method 'GET /greet', got %q", method)
+	}
+	want := []string{"log→", "handler", "←log"}
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("Expected steps %v, got %v", want, steps)
+	}
+
+	// Verify: rejection before the handler becomes a 500
+	steps = nil
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/greet", nil))
+
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("Expected 500, got %d", rec.Code)
+	}
+	want = []string{"log→", "←log"}
+	if !reflect.DeepEqual(steps, want) {
+		t.Errorf("Expected steps %v, got %v", want, steps)
+	}
+}
+
+func TestToMiddleware_NotModified(t *testing.T) {
+	cache := interceptor.ConditionalCacheInterceptor[*HTTPMeta](
+		func(ctx *interceptor.UniversalContext[*HTTPMeta]) string { return ctx.Method },
+		func(ctx *interceptor.UniversalContext[*HTTPMeta]) string {
+			return ctx.Meta.Request.Header.Get("If-None-Match")
+		},
+		func(result any) string { return `"v1"` },
+	)
+	calls := 0
+	handler := ToMiddleware(interceptor.NewSimpleResolver[*HTTPMeta](cache))(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("product"))
+	}))
+
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/products/1", nil))
+
+	req := httptest.NewRequest(http.MethodGet, "/products/1", nil)
+	req.Header.Set("If-None-Match", `"v1"`)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+		t.Errorf("Expected empty 304, got %d %q", rec.Code, rec.Body.String())
+	}
+	if calls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", calls)
+	}
+}
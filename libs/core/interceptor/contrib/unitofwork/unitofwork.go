@@ -0,0 +1,155 @@
+// Package unitofwork provides an interceptor that runs each request in a transaction:
+// it begins before the handler, commits when the handler succeeds and rolls back when
+// it fails or panics.
+//
+// The transaction type is the caller's (a *sql.Tx wrapper, a pgx.Tx adapter, ...);
+// it only needs Commit and Rollback. Handlers retrieve it with TxFromContext.
+package unitofwork
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+// name attributes begin, commit and rollback errors to the interceptor (see interceptor.InterceptorError).
+const name = "unitofwork"
+
+var (
+	// ErrBegin is matched (via errors.Is) when the transaction could not be started.
+	ErrBegin = errors.New("begin transaction failed")
+
+	// ErrCommit is matched (via errors.Is) when the handler succeeded but the commit failed.
+	ErrCommit = errors.New("commit failed")
+
+	// ErrRollback is matched (via errors.Is) when rolling back after a handler error failed.
+	ErrRollback = errors.New("rollback failed")
+)
+
+// Tx is the transaction a unit of work commits or rolls back.
+type Tx interface {
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// TxKey is the context key the transaction of type T is stored under.
+// Use TxFromContext to read it; the key is exported so other packages can place a Tx themselves,
+// e.g. a repository test running without the interceptor.
+type TxKey[T Tx] struct{}
+
+// TxFromContext returns the transaction of type T opened by UnitOfWork, and false if there is none.
+func TxFromContext[T Tx](ctx context.Context) (T, bool) {
+	tx, ok := ctx.Value(TxKey[T]{}).(T)
+	return tx, ok
+}
+
+// Propagation decides what a unit of work does when the context already holds a transaction,
+// e.g. in a pipeline nested inside another request's handler.
+type Propagation int
+
+const (
+	// Required joins an existing transaction: the handler uses it, and the unit of work that
+	// opened it commits or rolls back. Opens a new one when there is none. The default.
+	Required Propagation = iota
+
+	// RequiresNew always opens a new transaction, committed or rolled back independently.
+	// The outer transaction is visible again once the handler returns.
+	RequiresNew
+)
+
+// String returns "required" or "requires_new".
+func (p Propagation) String() string {
+	switch p {
+	case Required:
+		return "required"
+	case RequiresNew:
+		return "requires_new"
+	default:
+		return fmt.Sprintf("Propagation(%d)", int(p))
+	}
+}
+
+// Option configures UnitOfWork.
+type Option func(*options)
+
+type options struct {
+	propagation Propagation
+}
+
+// WithPropagation sets how an existing transaction is handled. Default Required.
+func WithPropagation(p Propagation) Option {
+	return func(o *options) {
+		o.propagation = p
+	}
+}
+
+// UnitOfWork runs next inside a transaction started with begin.
+//
+// Behavior:
+//   - The transaction is stored in the context (see TxFromContext) for the rest of the chain
+//   - next returns nil: Commit; a commit error is returned as an *interceptor.InterceptorError
+//     wrapping ErrCommit, and the handler's result is discarded
+//   - next returns an error: Rollback, and the handler's error is returned (joined with an
+//     ErrRollback error if the rollback fails too)
+//   - next panics: Rollback, then the panic is re-raised
+//   - A begin error fails the request with ErrBegin before next runs
+//
+// Rollback runs on a context that is not cancelled with the request, so a timed-out
+// request still rolls back. With Required (the default), a pipeline whose context already
+// holds a T joins it instead of opening a second transaction (see Propagation).
+//
+// Example:
+//
+//	uow := unitofwork.UnitOfWork[GinMeta](func(ctx context.Context) (*SQLTx, error) {
+//	    tx, err := db.BeginTx(ctx, nil)
+//	    return &SQLTx{tx}, err
+//	})
+//
+//	func createOrder(ctx *interceptor.UniversalContext[GinMeta]) (any, error) {
+//	    tx, _ := unitofwork.TxFromContext[*SQLTx](ctx)
+//	    return orders.Insert(ctx, tx, order)
+//	}
+func UnitOfWork[M any, T Tx](begin func(ctx context.Context) (T, error), opts ...Option) interceptor.Interceptor[M] {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return interceptor.InterceptorFunc[M](func(ctx *interceptor.UniversalContext[M], next interceptor.NextFunc[M]) (any, error) {
+		if _, ok := TxFromContext[T](ctx); ok && o.propagation == Required {
+			return next(ctx)
+		}
+
+		tx, err := begin(ctx)
+		if err != nil {
+			return nil, interceptor.NewInterceptorError(name, fmt.Errorf("%w: %w", ErrBegin, err))
+		}
+
+		parent := ctx.Context
+		ctx.Context = context.WithValue(parent, TxKey[T]{}, tx)
+		defer func() { ctx.Context = parent }()
+
+		settled := false
+		defer func() {
+			if !settled { // next panicked; the panic keeps propagating after the rollback
+				_ = tx.Rollback(context.WithoutCancel(parent))
+			}
+		}()
+
+		result, err := next(ctx)
+		settled = true
+		if err != nil {
+			if rbErr := tx.Rollback(context.WithoutCancel(parent)); rbErr != nil {
+				return result, errors.Join(err, interceptor.NewInterceptorError(name, fmt.Errorf("%w: %w", ErrRollback, rbErr)))
+			}
+			return result, err
+		}
+
+		if err := tx.Commit(ctx); err != nil {
+			return nil, interceptor.NewInterceptorError(name, fmt.Errorf("%w: %w", ErrCommit, err))
+		}
+		return result, nil
+	})
+}
@@ -0,0 +1,231 @@
+package unitofwork
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/core/interceptor"
+)
+
+type TestMeta struct{}
+
+// fakeTx records Commit and Rollback calls into a shared log
+type fakeTx struct {
+	id          int
+	log         *txLog
+	commitErr   error
+	rollbackErr error
+}
+
+func (tx *fakeTx) Commit(ctx context.Context) error {
+	tx.log.add("commit", tx.id)
+	return tx.commitErr
+}
+
+func (tx *fakeTx) Rollback(ctx context.Context) error {
+	tx.log.add("rollback", tx.id)
+	return tx.rollbackErr
+}
+
+type txLog struct {
+	mu    sync.Mutex
+	calls []string
+}
+
+func (l *txLog) add(call string, id int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.calls = append(l.calls, call+"#"+strconv.Itoa(id))
+}
+
+// newBegin returns a begin function opening fakeTx #1, #2, ... and recording "begin#n"
+func newBegin(log *txLog, configure func(*fakeTx)) func(context.Context) (*fakeTx, error) {
+	var n int
+	return func(context.Context) (*fakeTx, error) {
+		n++
+		log.add("begin", n)
+		tx := &fakeTx{id: n, log: log}
+		if configure != nil {
+			configure(tx)
+		}
+		return tx, nil
+	}
+}
+
+func run(uow interceptor.Interceptor[TestMeta], handler interceptor.NextFunc[TestMeta]) (any, error) {
+	ctx := interceptor.NewUniversalContext[TestMeta](nil, "http", "POST /orders", TestMeta{})
+	return interceptor.Chain(handler, uow)(ctx)
+}
+
+func TestUnitOfWork_CommitsOnSuccess(t *testing.T) {
+	log := &txLog{}
+	uow := UnitOfWork[TestMeta](newBegin(log, nil))
+
+	var seen *fakeTx
+	result, err := run(uow, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		seen, _ = TxFromContext[*fakeTx](ctx)
+		return "order-1", nil
+	})
+
+	if err != nil || result != "order-1" {
+		t.Fatalf("Expected order-1, got %v, %v", result, err)
+	}
+	if seen == nil || seen.id != 1 {
+		t.Errorf("Expected handler to see tx #1, got %+v", seen)
+	}
+	if want := []string{"begin#1", "commit#1"}; !reflect.DeepEqual(log.calls, want) {
+		t.Errorf("Expected %v, got %v", want, log.calls)
+	}
+}
+
+func TestUnitOfWork_RollsBackOnError(t *testing.T) {
+	log := &txLog{}
+	uow := UnitOfWork[TestMeta](newBegin(log, nil))
+	handlerErr := errors.New("out of stock")
+
+	_, err := run(uow, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		return nil, handlerErr
+	})
+
+	if !errors.Is(err, handlerErr) {
+		t.Errorf("Expected handler error, got %v", err)
+	}
+	if want := []string{"begin#1", "rollback#1"}; !reflect.DeepEqual(log.calls, want) {
+		t.Errorf("Expected %v, got %v", want, log.calls)
+	}
+}
+
+func TestUnitOfWork_RollbackFailureIsJoined(t *testing.T) {
+	log := &txLog{}
+	rbErr := errors.New("connection lost")
+	uow := UnitOfWork[TestMeta](newBegin(log, func(tx *fakeTx) { tx.rollbackErr = rbErr }))
+	handlerErr := errors.New("out of stock")
+
+	_, err := run(uow, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		return nil, handlerErr
+	})
+
+	if !errors.Is(err, handlerErr) || !errors.Is(err, ErrRollback) || !errors.Is(err, rbErr) {
+		t.Errorf("Expected handler and rollback errors, got %v", err)
+	}
+}
+
+func TestUnitOfWork_RollsBackAndRepanics(t *testing.T) {
+	log := &txLog{}
+	uow := UnitOfWork[TestMeta](newBegin(log, nil))
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("Expected panic to propagate, got %v", r)
+			}
+		}()
+		run(uow, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+			panic("boom")
+		})
+	}()
+
+	if want := []string{"begin#1", "rollback#1"}; !reflect.DeepEqual(log.calls, want) {
+		t.Errorf("Expected %v, got %v", want, log.calls)
+	}
+}
+
+func TestUnitOfWork_CommitFailure(t *testing.T) {
+	log := &txLog{}
+	commitErr := errors.New("serialization failure")
+	uow := UnitOfWork[TestMeta](newBegin(log, func(tx *fakeTx) { tx.commitErr = commitErr }))
+
+	result, err := run(uow, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		return "order-1", nil
+	})
+
+	if result != nil {
+		t.Errorf("Expected result to be discarded, got %v", result)
+	}
+	if !errors.Is(err, ErrCommit) || !errors.Is(err, commitErr) {
+		t.Fatalf("Expected commit error, got %v", err)
+	}
+	var ie *interceptor.InterceptorError
+	if !errors.As(err, &ie) || ie.InterceptorName != "unitofwork" {
+		t.Errorf("Expected error attributed to unitofwork, got %v", err)
+	}
+	if want := []string{"begin#1", "commit#1"}; !reflect.DeepEqual(log.calls, want) {
+		t.Errorf("Expected no rollback after a failed commit, got %v", log.calls)
+	}
+}
+
+func TestUnitOfWork_BeginFailure(t *testing.T) {
+	beginErr := errors.New("pool exhausted")
+	uow := UnitOfWork[TestMeta](func(context.Context) (*fakeTx, error) {
+		return nil, beginErr
+	})
+
+	called := false
+	_, err := run(uow, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+		called = true
+		return nil, nil
+	})
+
+	if !errors.Is(err, ErrBegin) || !errors.Is(err, beginErr) {
+		t.Errorf("Expected begin error, got %v", err)
+	}
+	if called {
+		t.Error("Expected handler not to run without a transaction")
+	}
+}
+
+func TestUnitOfWork_NestedPropagation(t *testing.T) {
+	tests := []struct {
+		name        string
+		propagation Propagation
+		wantInner   int
+		wantCalls   []string
+	}{
+		{"required joins", Required, 1, []string{"begin#1", "commit#1"}},
+		{"requires new", RequiresNew, 2, []string{"begin#1", "begin#2", "commit#2", "commit#1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			log := &txLog{}
+			begin := newBegin(log, nil)
+			outer := UnitOfWork[TestMeta](begin)
+			inner := UnitOfWork[TestMeta](begin, WithPropagation(tt.propagation))
+
+			var innerTx, outerAfter *fakeTx
+			_, err := run(outer, func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+				// Nested pipeline, e.g. an internal call dispatched from the handler
+				nested := ctx.Derive()
+				_, err := interceptor.Chain(func(ctx *interceptor.UniversalContext[TestMeta]) (any, error) {
+					innerTx, _ = TxFromContext[*fakeTx](ctx)
+					return nil, nil
+				}, inner)(nested)
+				outerAfter, _ = TxFromContext[*fakeTx](nested)
+				return nil, err
+			})
+
+			if err != nil {
+				t.Fatalf("Expected success, got %v", err)
+			}
+			if innerTx == nil || innerTx.id != tt.wantInner {
+				t.Errorf("Expected inner handler to see tx #%d, got %+v", tt.wantInner, innerTx)
+			}
+			if outerAfter == nil || outerAfter.id != 1 {
+				t.Errorf("Expected outer tx #1 to be restored, got %+v", outerAfter)
+			}
+			if !reflect.DeepEqual(log.calls, tt.wantCalls) {
+				t.Errorf("Expected %v, got %v", tt.wantCalls, log.calls)
+			}
+		})
+	}
+}
+
+func TestPropagation_String(t *testing.T) {
+	if Required.String() != "required" || RequiresNew.String() != "requires_new" {
+		t.Errorf("Unexpected names: %s, %s", Required, RequiresNew)
+	}
+}
@@ -0,0 +1,86 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// maxCorrelationIDLength bounds incoming IDs so a client cannot inflate logs and headers.
+const maxCorrelationIDLength = 128
+
+type correlationIDKey struct{}
+
+// CorrelationInterceptor propagates a correlation ID across protocols without full tracing.
+// It reads the incoming ID from Meta with extract, generates a new one when it is
+// missing or invalid, and stores it on the context for the rest of the chain.
+//
+// Parameters:
+//   - extract: reads the incoming ID from Meta (e.g. the X-Correlation-ID header or a Kafka header); may be nil
+//   - inject: optional extra hook to place the ID on the context (e.g. outgoing metadata for gRPC clients);
+//     the ID is always retrievable with CorrelationID regardless
+//
+// Incoming IDs longer than 128 bytes or containing characters other than
+// letters, digits, '-', '_', '.' and ':' are replaced with a generated one.
+//
+// Example:
+//
+//	correlation := interceptor.CorrelationInterceptor[GinMeta](
+//	    func(m GinMeta) string { return m.Headers["X-Correlation-ID"] },
+//	    nil,
+//	)
+//
+//	func handler(ctx *interceptor.UniversalContext[GinMeta]) (any, error) {
+//	    id := interceptor.CorrelationID(ctx)
+//	    ...
+//	}
+func CorrelationInterceptor[M any](
+	extract func(M) string,
+	inject func(ctx context.Context, id string) context.Context,
+) Interceptor[M] {
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		id := ""
+		if extract != nil {
+			id = extract(ctx.Meta)
+		}
+		if !validCorrelationID(id) {
+			id = NewCorrelationID()
+		}
+
+		ctx.Context = context.WithValue(ctx.Context, correlationIDKey{}, id)
+		if inject != nil {
+			ctx.Context = inject(ctx.Context, id)
+		}
+
+		return next(ctx)
+	})
+}
+
+// CorrelationID returns the correlation ID stored by CorrelationInterceptor, or "" if none.
+func CorrelationID(ctx context.Context) string {
+	id, _ := ctx.Value(correlationIDKey{}).(string)
+	return id
+}
+
+// NewCorrelationID generates a random 128-bit correlation ID as 32 hex characters.
+func NewCorrelationID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// validCorrelationID reports whether an incoming ID is safe to propagate.
+func validCorrelationID(id string) bool {
+	if id == "" || len(id) > maxCorrelationIDLength {
+		return false
+	}
+	for _, c := range id {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9':
+		case c == '-', c == '_', c == '.', c == ':':
+		default:
+			return false
+		}
+	}
+	return true
+}
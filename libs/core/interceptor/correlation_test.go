@@ -0,0 +1,73 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+type outgoingKey struct{}
+
+func runCorrelation(t *testing.T, incoming string) (stored, injected string) {
+	t.Helper()
+	correlation := CorrelationInterceptor[TestMeta](
+		func(m TestMeta) string { return m.UserID },
+		func(ctx context.Context, id string) context.Context {
+			return context.WithValue(ctx, outgoingKey{}, id)
+		},
+	)
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		stored = CorrelationID(ctx)
+		injected, _ = ctx.Value(outgoingKey{}).(string)
+		return nil, nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "GET /users", TestMeta{UserID: incoming})
+	if _, err := Chain(handler, correlation)(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return stored, injected
+}
+
+func TestCorrelationInterceptor_PreservesIncomingID(t *testing.T) {
+	stored, injected := runCorrelation(t, "req-abc-123")
+
+	if stored != "req-abc-123" {
+		t.Errorf("Expected incoming ID to be preserved, got %q", stored)
+	}
+	if injected != "req-abc-123" {
+		t.Errorf("Expected inject hook to receive the ID, got %q", injected)
+	}
+}
+
+func TestCorrelationInterceptor_GeneratesMissingID(t *testing.T) {
+	stored, injected := runCorrelation(t, "")
+
+	if len(stored) != 32 {
+		t.Errorf("Expected generated 32-char ID, got %q", stored)
+	}
+	if injected != stored {
+		t.Errorf("Expected inject hook to receive generated ID %q, got %q", stored, injected)
+	}
+
+	other, _ := runCorrelation(t, "")
+	if other == stored {
+		t.Error("Expected a fresh ID per request")
+	}
+}
+
+func TestCorrelationInterceptor_ReplacesInvalidID(t *testing.T) {
+	for _, incoming := range []string{"bad id\nwith newline", strings.Repeat("a", 129), "<script>"} {
+		stored, _ := runCorrelation(t, incoming)
+		if stored == incoming || len(stored) != 32 {
+			t.Errorf("Expected invalid ID %q to be replaced, got %q", incoming, stored)
+		}
+	}
+}
+
+func TestCorrelationID_Missing(t *testing.T) {
+	if id := CorrelationID(context.Background()); id != "" {
+		t.Errorf("Expected empty ID without interceptor, got %q", id)
+	}
+}
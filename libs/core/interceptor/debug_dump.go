@@ -0,0 +1,55 @@
+package interceptor
+
+import "time"
+
+// DebugLogger receives the entries written by DebugDumpInterceptor.
+// ISugaredLogger from the log library satisfies it.
+type DebugLogger interface {
+	Debugw(msg string, keysAndValues ...any)
+}
+
+// DebugDumpInterceptor creates a development interceptor that logs the full request Meta
+// before next runs and the handler's result (or error) after, at Debug level.
+// Use it to trace data flow locally; Meta and results may hold credentials or personal data.
+//
+// enabled is checked on every request, so a level change at runtime takes effect immediately;
+// when it returns false the interceptor only calls next and formats nothing.
+// A nil enabled always logs.
+//
+// Panics if logger is nil.
+//
+// Example:
+//
+//	dump := interceptor.DebugDumpInterceptor[GinMeta](logger, func() bool {
+//	    return logger.Level() <= core.DebugLevel
+//	})
+func DebugDumpInterceptor[M any](logger DebugLogger, enabled func() bool) Interceptor[M] {
+	if logger == nil {
+		panic("interceptor: DebugDumpInterceptor requires a logger")
+	}
+
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		if enabled != nil && !enabled() {
+			return next(ctx)
+		}
+
+		method := ctx.OperationName()
+		logger.Debugw("request",
+			"protocol", ctx.Protocol,
+			"method", method,
+			"meta", ctx.Meta,
+		)
+
+		start := time.Now()
+		result, err := next(ctx)
+
+		fields := []any{"method", method, "duration", time.Since(start)}
+		if err != nil {
+			fields = append(fields, "error", err)
+		} else {
+			fields = append(fields, "result", result)
+		}
+		logger.Debugw("response", fields...)
+		return result, err
+	})
+}
@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type debugEntry struct {
+	msg    string
+	fields map[string]any
+}
+
+// debugRecorder records Debugw calls; level mimics a logger's minimum level (-1 = debug, 0 = info)
+type debugRecorder struct {
+	level   int
+	entries []debugEntry
+}
+
+func (r *debugRecorder) Debugw(msg string, keysAndValues ...any) {
+	fields := make(map[string]any)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		fields[keysAndValues[i].(string)] = keysAndValues[i+1]
+	}
+	r.entries = append(r.entries, debugEntry{msg, fields})
+}
+
+func (r *debugRecorder) enabled() bool {
+	return r.level <= -1
+}
+
+func TestDebugDumpInterceptor_DebugLoggerLogsRequestAndResult(t *testing.T) {
+	logger := &debugRecorder{level: -1}
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) { return "user-42", nil }
+
+	ctx := NewUniversalContext(context.Background(), "http", "GET /users/42", TestMeta{UserID: "42", Role: "admin"})
+	result, err := Chain(handler, DebugDumpInterceptor[TestMeta](logger, logger.enabled))(ctx)
+
+	if err != nil || result != "user-42" {
+		t.Fatalf("Expected handler result, got %v, %v", result, err)
+	}
+	if len(logger.entries) != 2 {
+		t.Fatalf("Expected request and response entries, got %v", logger.entries)
+	}
+	request, response := logger.entries[0], logger.entries[1]
+	if request.msg != "request" || request.fields["meta"] != (TestMeta{UserID: "42", Role: "admin"}) || request.fields["method"] != "GET /users/42" {
+		t.Errorf("Unexpected request entry: %+v", request)
+	}
+	if response.msg != "response" || response.fields["result"] != "user-42" {
+		t.Errorf("Unexpected response entry: %+v", response)
+	}
+}
+
+func TestDebugDumpInterceptor_LogsError(t *testing.T) {
+	logger := &debugRecorder{level: -1}
+	errBoom := errors.New("boom")
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) { return nil, errBoom }
+
+	ctx := NewUniversalContext(context.Background(), "http", "GET /users", TestMeta{})
+	_, err := Chain(handler, DebugDumpInterceptor[TestMeta](logger, nil))(ctx)
+
+	if !errors.Is(err, errBoom) {
+		t.Fatalf("Expected handler error, got %v", err)
+	}
+	if response := logger.entries[len(logger.entries)-1]; response.fields["error"] != errBoom {
+		t.Errorf("Expected error in response entry, got %+v", response)
+	}
+}
+
+func TestDebugDumpInterceptor_InfoLoggerLogsNothing(t *testing.T) {
+	logger := &debugRecorder{level: 0}
+	called := false
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		called = true
+		return "ok", nil
+	}
+
+	chain := Chain(handler, DebugDumpInterceptor[TestMeta](logger, logger.enabled))
+	ctx := NewUniversalContext(context.Background(), "http", "GET /users", TestMeta{UserID: "42"})
+	result, _ := chain(ctx)
+
+	if !called || result != "ok" {
+		t.Errorf("Expected handler to run, got called=%v result=%v", called, result)
+	}
+	if len(logger.entries) != 0 {
+		t.Errorf("Expected no entries above debug level, got %v", logger.entries)
+	}
+
+	// Verify: lowering the level at runtime enables the dump
+	logger.level = -1
+	chain(ctx)
+	if len(logger.entries) != 2 {
+		t.Errorf("Expected entries after switching to debug, got %v", logger.entries)
+	}
+}
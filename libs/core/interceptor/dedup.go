@@ -0,0 +1,240 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrDuplicate is matched (via errors.Is) by every DuplicateError.
+// Message bridges should treat it as "ack without processing": commit the offset
+// or ack the delivery, but do not retry or dead-letter it.
+var ErrDuplicate = errors.New("duplicate message")
+
+// DuplicateError is returned by Dedup when a message ID was already seen within the window.
+type DuplicateError struct {
+	ID string
+}
+
+// Error implements the error interface.
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("duplicate message: %s", e.ID)
+}
+
+// Is makes errors.Is(err, ErrDuplicate) match.
+func (e *DuplicateError) Is(target error) bool {
+	return target == ErrDuplicate
+}
+
+// SeenStore remembers message IDs for Dedup.
+// Implementations must be safe for concurrent use.
+//
+// A Redis-backed store maps directly onto the interface:
+//
+//	MarkSeen: SET dedup:<id> 1 NX PX <window>  → alreadySeen = key existed
+//	Forget:   DEL dedup:<id>
+type SeenStore interface {
+	// MarkSeen records id as seen for at least window and reports whether it
+	// was already seen. Checking and recording must be atomic.
+	MarkSeen(ctx context.Context, id string, window time.Duration) (alreadySeen bool, err error)
+
+	// Forget removes id, so the next delivery of the message is processed again.
+	Forget(ctx context.Context, id string) error
+}
+
+// DedupOption configures the Dedup interceptor.
+type DedupOption func(*dedupOptions)
+
+type dedupOptions struct {
+	keepOnError bool
+	failClosed  bool
+	onDuplicate func(method, id string)
+}
+
+// WithKeepOnError keeps a message ID marked as seen when the handler fails.
+// By default the ID is forgotten so a redelivery is processed again; keeping it turns
+// a failed message into a skipped one (at-most-once), trading lost messages for no retries.
+func WithKeepOnError() DedupOption {
+	return func(o *dedupOptions) {
+		o.keepOnError = true
+	}
+}
+
+// WithFailClosed rejects messages when the SeenStore fails.
+// By default a store error lets the message through (fail open), accepting a possible
+// duplicate rather than dropping a message.
+func WithFailClosed() DedupOption {
+	return func(o *dedupOptions) {
+		o.failClosed = true
+	}
+}
+
+// WithDuplicateHook registers a callback invoked for every skipped duplicate,
+// e.g. to increment a duplicates_skipped counter.
+func WithDuplicateHook(fn func(method, id string)) DedupOption {
+	return func(o *dedupOptions) {
+		o.onDuplicate = fn
+	}
+}
+
+// Dedup creates an interceptor that skips messages whose ID was already seen within window,
+// for at-least-once transports (Kafka, SQS) that redeliver messages.
+// Unlike an idempotency cache, no result is replayed: the duplicate returns a *DuplicateError
+// without calling next.
+//
+// Parameters:
+//   - store: where IDs are remembered (MemorySeenStore for one instance, Redis for many)
+//   - idFn: extracts the message ID; messages without one (false or "") are always processed
+//   - window: how long an ID is remembered
+//
+// Panics if store or idFn is nil, or window is not positive.
+//
+// Example:
+//
+//	dedup := interceptor.Dedup[KafkaMeta](
+//	    interceptor.NewMemorySeenStore(10),
+//	    func(ctx *interceptor.UniversalContext[KafkaMeta]) (string, bool) {
+//	        return ctx.Meta.Headers["message-id"], true
+//	    },
+//	    10*time.Minute,
+//	    interceptor.WithDuplicateHook(func(method, id string) { duplicatesSkipped.Inc() }),
+//	)
+func Dedup[M any](store SeenStore, idFn func(*UniversalContext[M]) (string, bool), window time.Duration, opts ...DedupOption) Interceptor[M] {
+	if store == nil || idFn == nil {
+		panic("interceptor: Dedup requires a store and an idFn")
+	}
+	if window <= 0 {
+		panic("interceptor: Dedup requires a positive window")
+	}
+
+	var o dedupOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (result any, err error) {
+		id, ok := idFn(ctx)
+		if !ok || id == "" {
+			return next(ctx)
+		}
+
+		seen, storeErr := store.MarkSeen(ctx, id, window)
+		if storeErr != nil {
+			if o.failClosed {
+				return nil, NewInterceptorError("dedup", storeErr)
+			}
+			return next(ctx)
+		}
+		if seen {
+			if o.onDuplicate != nil {
+				o.onDuplicate(ctx.OperationName(), id)
+			}
+			return nil, &DuplicateError{ID: id}
+		}
+
+		if o.keepOnError {
+			return next(ctx)
+		}
+
+		completed := false
+		defer func() {
+			if !completed || err != nil {
+				// Forget with a fresh context: ctx may already be canceled
+				_ = store.Forget(context.WithoutCancel(ctx), id)
+			}
+		}()
+		result, err = next(ctx)
+		completed = true
+		return result, err
+	})
+}
+
+// MemorySeenStore is an in-process SeenStore backed by a ring of time buckets.
+// Each bucket holds the IDs first seen during one slice of the window; expired buckets
+// are dropped whole, so eviction costs nothing per ID.
+// IDs are remembered for at least the window and at most window + window/buckets.
+//
+// The bucket width is derived from the window passed to MarkSeen;
+// share one store only between Dedup interceptors with the same window.
+type MemorySeenStore struct {
+	mu        sync.Mutex
+	buckets   []map[string]struct{}
+	head      int
+	headStart time.Time
+	now       func() time.Time
+}
+
+// NewMemorySeenStore creates a MemorySeenStore that splits the window into the given
+// number of buckets (minimum 1). More buckets mean more precise expiry.
+func NewMemorySeenStore(buckets int) *MemorySeenStore {
+	if buckets < 1 {
+		buckets = 1
+	}
+	// One extra bucket so the oldest slice is kept for a full window
+	s := &MemorySeenStore{
+		buckets: make([]map[string]struct{}, buckets+1),
+		now:     time.Now,
+	}
+	for i := range s.buckets {
+		s.buckets[i] = make(map[string]struct{})
+	}
+	return s
+}
+
+// MarkSeen implements SeenStore.
+func (s *MemorySeenStore) MarkSeen(_ context.Context, id string, window time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.rotate(window / time.Duration(len(s.buckets)-1))
+
+	for _, bucket := range s.buckets {
+		if _, ok := bucket[id]; ok {
+			return true, nil
+		}
+	}
+	s.buckets[s.head][id] = struct{}{}
+	return false, nil
+}
+
+// Forget implements SeenStore.
+func (s *MemorySeenStore) Forget(_ context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, bucket := range s.buckets {
+		delete(bucket, id)
+	}
+	return nil
+}
+
+// rotate advances the head bucket by the number of widths elapsed, clearing the buckets it reuses.
+func (s *MemorySeenStore) rotate(width time.Duration) {
+	now := s.now()
+	if width <= 0 || s.headStart.IsZero() {
+		if s.headStart.IsZero() {
+			s.headStart = now
+		}
+		return
+	}
+
+	steps := int(now.Sub(s.headStart) / width)
+	if steps <= 0 {
+		return
+	}
+	if steps >= len(s.buckets) {
+		for i := range s.buckets {
+			s.buckets[i] = make(map[string]struct{})
+		}
+		s.headStart = now
+		return
+	}
+
+	for i := 0; i < steps; i++ {
+		s.head = (s.head + 1) % len(s.buckets)
+		s.buckets[s.head] = make(map[string]struct{})
+	}
+	s.headStart = s.headStart.Add(time.Duration(steps) * width)
+}
@@ -0,0 +1,144 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// MessageMeta carries the broker message ID
+type MessageMeta struct {
+	MessageID string
+}
+
+// fakeClock drives MemorySeenStore expiry in tests
+type fakeClock struct{ t time.Time }
+
+func (c *fakeClock) now() time.Time          { return c.t }
+func (c *fakeClock) advance(d time.Duration) { c.t = c.t.Add(d) }
+
+func newTestSeenStore(buckets int) (*MemorySeenStore, *fakeClock) {
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	store := NewMemorySeenStore(buckets)
+	store.now = clock.now
+	return store, clock
+}
+
+func messageID(ctx *UniversalContext[MessageMeta]) (string, bool) {
+	return ctx.Meta.MessageID, ctx.Meta.MessageID != ""
+}
+
+func TestDedup_RedeliveryInsideWindowIsSkipped(t *testing.T) {
+	store, clock := newTestSeenStore(4)
+
+	var skipped []string
+	dedup := Dedup[MessageMeta](store, messageID, time.Minute,
+		WithDuplicateHook(func(method, id string) { skipped = append(skipped, method+":"+id) }),
+	)
+
+	handlerCalls := 0
+	pipeline := Chain(func(ctx *UniversalContext[MessageMeta]) (any, error) {
+		handlerCalls++
+		return "processed", nil
+	}, dedup)
+
+	deliver := func() (any, error) {
+		return pipeline(NewUniversalContext(context.Background(), "kafka", "orders.created", MessageMeta{MessageID: "msg-1"}))
+	}
+
+	if result, err := deliver(); err != nil || result != "processed" {
+		t.Fatalf("Expected first delivery to be processed, got %v (err: %v)", result, err)
+	}
+
+	clock.advance(59 * time.Second)
+	_, err := deliver()
+
+	var dupErr *DuplicateError
+	if !errors.Is(err, ErrDuplicate) || !errors.As(err, &dupErr) || dupErr.ID != "msg-1" {
+		t.Errorf("Expected DuplicateError for msg-1, got %v", err)
+	}
+	if handlerCalls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", handlerCalls)
+	}
+	if len(skipped) != 1 || skipped[0] != "orders.created:msg-1" {
+		t.Errorf("Expected duplicate hook call, got %v", skipped)
+	}
+}
+
+func TestDedup_RedeliveryOutsideWindowIsProcessed(t *testing.T) {
+	store, clock := newTestSeenStore(4)
+	dedup := Dedup[MessageMeta](store, messageID, time.Minute)
+
+	handlerCalls := 0
+	pipeline := Chain(func(ctx *UniversalContext[MessageMeta]) (any, error) {
+		handlerCalls++
+		return nil, nil
+	}, dedup)
+
+	for _, wait := range []time.Duration{0, 76 * time.Second, 10 * time.Minute} {
+		clock.advance(wait)
+		ctx := NewUniversalContext(context.Background(), "kafka", "orders.created", MessageMeta{MessageID: "msg-1"})
+		if _, err := pipeline(ctx); err != nil {
+			t.Fatalf("Expected delivery after %v to be processed, got %v", wait, err)
+		}
+	}
+	if handlerCalls != 3 {
+		t.Errorf("Expected handler to run for every delivery, ran %d times", handlerCalls)
+	}
+}
+
+func TestDedup_FailedHandlerIsRetried(t *testing.T) {
+	store, _ := newTestSeenStore(4)
+	failure := errors.New("db down")
+
+	calls := 0
+	handler := func(ctx *UniversalContext[MessageMeta]) (any, error) {
+		calls++
+		if calls == 1 {
+			return nil, failure
+		}
+		return "processed", nil
+	}
+	ctx := NewUniversalContext(context.Background(), "kafka", "orders.created", MessageMeta{MessageID: "msg-1"})
+
+	pipeline := Chain(handler, Dedup[MessageMeta](store, messageID, time.Minute))
+	if _, err := pipeline(ctx); !errors.Is(err, failure) {
+		t.Fatalf("Expected handler error, got %v", err)
+	}
+	if result, err := pipeline(ctx); err != nil || result != "processed" {
+		t.Errorf("Expected redelivery after failure to be processed, got %v (err: %v)", result, err)
+	}
+
+	// Verify: WithKeepOnError skips the redelivery instead
+	calls = 0
+	ctx.Meta.MessageID = "msg-2"
+	pipeline = Chain(handler, Dedup[MessageMeta](store, messageID, time.Minute, WithKeepOnError()))
+	pipeline(ctx)
+	if _, err := pipeline(ctx); !errors.Is(err, ErrDuplicate) {
+		t.Errorf("Expected duplicate with WithKeepOnError, got %v", err)
+	}
+}
+
+// failingSeenStore always fails
+type failingSeenStore struct{}
+
+func (failingSeenStore) MarkSeen(context.Context, string, time.Duration) (bool, error) {
+	return false, errors.New("redis unavailable")
+}
+func (failingSeenStore) Forget(context.Context, string) error { return nil }
+
+func TestDedup_StoreErrorPolicy(t *testing.T) {
+	handler := func(ctx *UniversalContext[MessageMeta]) (any, error) { return "processed", nil }
+	ctx := NewUniversalContext(context.Background(), "kafka", "orders.created", MessageMeta{MessageID: "msg-1"})
+
+	if result, err := Chain(handler, Dedup[MessageMeta](failingSeenStore{}, messageID, time.Minute))(ctx); err != nil || result != "processed" {
+		t.Errorf("Expected fail-open to process the message, got %v (err: %v)", result, err)
+	}
+
+	_, err := Chain(handler, Dedup[MessageMeta](failingSeenStore{}, messageID, time.Minute, WithFailClosed()))(ctx)
+	var interceptorErr *InterceptorError
+	if !errors.As(err, &interceptorErr) || interceptorErr.InterceptorName != "dedup" {
+		t.Errorf("Expected dedup InterceptorError with WithFailClosed, got %v", err)
+	}
+}
@@ -0,0 +1,110 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrDraining is returned by Drainer for requests arriving after BeginDrain.
+// Bridges can map it to 503 Service Unavailable / UNAVAILABLE.
+var ErrDraining = errors.New("draining")
+
+// Drainer counts in-flight pipelines so shutdown can stop taking new requests
+// while letting the ones already running finish. Register it as the first interceptor,
+// so the count covers the whole chain. Safe for concurrent use.
+//
+// Example:
+//
+//	drainer := interceptor.NewDrainer[GinMeta]()
+//	resolver := interceptor.NewSimpleResolver[GinMeta](drainer, auth, logging)
+//
+//	// OnStop
+//	if remaining, err := drainer.BeginDrain(ctx); err != nil {
+//	    log.Printf("shutdown with %d requests in flight: %v", remaining, err)
+//	}
+type Drainer[M any] struct {
+	mu       sync.Mutex
+	inFlight int
+	draining bool
+	idle     chan struct{} // closed when inFlight reaches 0 while draining
+}
+
+// NewDrainer creates a Drainer accepting requests.
+func NewDrainer[M any]() *Drainer[M] {
+	return &Drainer[M]{}
+}
+
+// Intercept implements Interceptor.
+// After BeginDrain it rejects the request with ErrDraining (wrapped in an InterceptorError)
+// without calling next. The request stops counting as in flight when next returns or panics.
+func (d *Drainer[M]) Intercept(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+	d.mu.Lock()
+	if d.draining {
+		d.mu.Unlock()
+		return nil, NewInterceptorError("drain", ErrDraining)
+	}
+	d.inFlight++
+	d.mu.Unlock()
+
+	defer d.done()
+	return next(ctx)
+}
+
+// done marks one request finished, signalling BeginDrain when it was the last one.
+func (d *Drainer[M]) done() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.inFlight--
+	if d.inFlight == 0 && d.idle != nil {
+		close(d.idle)
+		d.idle = nil
+	}
+}
+
+// BeginDrain rejects new requests from now on and blocks until every in-flight request
+// has finished, or ctx is done. On timeout it returns the number of requests still in flight
+// and ctx.Err(). Calling it again (e.g. with a longer deadline) keeps waiting for the same requests.
+func (d *Drainer[M]) BeginDrain(ctx context.Context) (int, error) {
+	d.mu.Lock()
+	d.draining = true
+	if d.inFlight == 0 {
+		d.mu.Unlock()
+		return 0, nil
+	}
+	if d.idle == nil {
+		d.idle = make(chan struct{})
+	}
+	idle := d.idle
+	d.mu.Unlock()
+
+	select {
+	case <-idle:
+		return 0, nil
+	case <-ctx.Done():
+		return d.InFlight(), ctx.Err()
+	}
+}
+
+// Resume accepts new requests again after BeginDrain, e.g. when a stopped adapter is started again.
+// Requests still in flight keep counting, and a BeginDrain still waiting for them keeps waiting.
+func (d *Drainer[M]) Resume() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.draining = false
+}
+
+// Draining reports whether BeginDrain has been called since the last Resume.
+func (d *Drainer[M]) Draining() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.draining
+}
+
+// InFlight returns the number of requests currently running through the Drainer.
+func (d *Drainer[M]) InFlight() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.inFlight
+}
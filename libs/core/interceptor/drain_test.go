@@ -0,0 +1,164 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// startSlow launches n pipelines through d that block until release is closed
+// and waits until d counts them in flight.
+func startSlow(t *testing.T, d *Drainer[TestMeta], n int, release chan struct{}) *sync.WaitGroup {
+	t.Helper()
+	var wg sync.WaitGroup
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		<-release
+		return "done", nil
+	}
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := NewUniversalContext[TestMeta](nil, "http", "slow", TestMeta{})
+			if _, err := Chain(handler, d)(ctx); err != nil {
+				t.Errorf("Expected in-flight request to finish, got: %v", err)
+			}
+		}()
+	}
+
+	deadline := time.Now().Add(time.Second)
+	for d.InFlight() < n {
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected %d in-flight requests, got %d", n, d.InFlight())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return &wg
+}
+
+func TestDrainer_WaitsForInFlight(t *testing.T) {
+	d := NewDrainer[TestMeta]()
+	release := make(chan struct{})
+	wg := startSlow(t, d, 3, release)
+
+	drained := make(chan int)
+	go func() {
+		remaining, err := d.BeginDrain(context.Background())
+		if err != nil {
+			t.Errorf("Expected BeginDrain to succeed, got: %v", err)
+		}
+		drained <- remaining
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for !d.Draining() {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected drain to begin")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// New requests are rejected without running the handler
+	called := false
+	ctx := NewUniversalContext[TestMeta](nil, "http", "new", TestMeta{})
+	_, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) {
+		called = true
+		return nil, nil
+	}, d)(ctx)
+	if !errors.Is(err, ErrDraining) || called {
+		t.Errorf("Expected ErrDraining without calling the handler, got %v (called=%v)", err, called)
+	}
+
+	select {
+	case <-drained:
+		t.Fatal("Expected BeginDrain to block while requests are in flight")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	close(release)
+	wg.Wait()
+
+	select {
+	case remaining := <-drained:
+		if remaining != 0 {
+			t.Errorf("Expected 0 remaining, got %d", remaining)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected BeginDrain to return after in-flight requests finished")
+	}
+}
+
+func TestDrainer_Timeout(t *testing.T) {
+	d := NewDrainer[TestMeta]()
+	release := make(chan struct{})
+	wg := startSlow(t, d, 2, release)
+	defer func() {
+		close(release)
+		wg.Wait()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	remaining, err := d.BeginDrain(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected DeadlineExceeded, got: %v", err)
+	}
+	if remaining != 2 {
+		t.Errorf("Expected 2 requests still in flight, got %d", remaining)
+	}
+}
+
+func TestDrainer_NothingInFlight(t *testing.T) {
+	d := NewDrainer[TestMeta]()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // returns immediately, even with a done context
+
+	if remaining, err := d.BeginDrain(ctx); remaining != 0 || err != nil {
+		t.Errorf("Expected 0, nil; got %d, %v", remaining, err)
+	}
+	if !d.Draining() {
+		t.Error("Expected Draining() after BeginDrain")
+	}
+}
+
+func TestDrainer_Resume(t *testing.T) {
+	d := NewDrainer[TestMeta]()
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) { return "ok", nil }
+
+	d.BeginDrain(context.Background())
+	ctx := NewUniversalContext[TestMeta](nil, "http", "ping", TestMeta{})
+	if _, err := Chain(handler, d)(ctx); !errors.Is(err, ErrDraining) {
+		t.Fatalf("Expected ErrDraining while draining, got: %v", err)
+	}
+
+	d.Resume()
+	if d.Draining() {
+		t.Error("Expected Draining() to be false after Resume")
+	}
+	ctx = NewUniversalContext[TestMeta](nil, "http", "ping", TestMeta{})
+	if result, err := Chain(handler, d)(ctx); err != nil || result != "ok" {
+		t.Errorf("Expected requests to run after Resume, got %v, %v", result, err)
+	}
+}
+
+func TestDrainer_PanicReleasesCount(t *testing.T) {
+	d := NewDrainer[TestMeta]()
+
+	func() {
+		defer func() { recover() }()
+		ctx := NewUniversalContext[TestMeta](nil, "http", "panic", TestMeta{})
+		Chain(func(ctx *UniversalContext[TestMeta]) (any, error) {
+			panic("boom")
+		}, d)(ctx)
+	}()
+
+	if d.InFlight() != 0 {
+		t.Errorf("Expected a panicking request to stop counting, got %d in flight", d.InFlight())
+	}
+}
@@ -0,0 +1,118 @@
+package interceptor
+
+import "sync"
+
+type dynamicEntry[M any] struct {
+	name        string
+	interceptor Interceptor[M]
+}
+
+// DynamicResolver is an InterceptorResolver whose interceptors can be added, removed and replaced
+// at runtime, e.g. by an admin endpoint that turns on debug logging without a restart.
+// Interceptors are identified by name and applied to every request in the order they were added.
+// Safe for concurrent use.
+//
+// Every change builds a new list, so Resolve returns a snapshot without copying: a request
+// keeps the interceptors it resolved even if the set changes while it runs.
+//
+// Example:
+//
+//	resolver := interceptor.NewDynamicResolver[GinMeta]().
+//	    Add("auth", authInterceptor).
+//	    Add("logging", loggingInterceptor)
+//
+//	// admin endpoint
+//	resolver.Replace("logging", debugLoggingInterceptor)
+//	resolver.Remove("rate-limit")
+type DynamicResolver[M any] struct {
+	mu      sync.RWMutex
+	entries []dynamicEntry[M]
+	chain   []Interceptor[M] // interceptors of entries, rebuilt on every change
+}
+
+// NewDynamicResolver creates an empty DynamicResolver.
+func NewDynamicResolver[M any]() *DynamicResolver[M] {
+	return &DynamicResolver[M]{}
+}
+
+// Add appends a named interceptor. An interceptor already added under name is replaced in place.
+// Returns *DynamicResolver[M] to support method chaining.
+func (d *DynamicResolver[M]) Add(name string, interceptor Interceptor[M]) *DynamicResolver[M] {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.replaceLocked(name, interceptor) {
+		d.update(append(d.cloneEntries(), dynamicEntry[M]{name: name, interceptor: interceptor}))
+	}
+	return d
+}
+
+// Remove removes the interceptor added under name. Returns false if there is none.
+func (d *DynamicResolver[M]) Remove(name string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for i, entry := range d.entries {
+		if entry.name == name {
+			entries := d.cloneEntries()
+			d.update(append(entries[:i], entries[i+1:]...))
+			return true
+		}
+	}
+	return false
+}
+
+// Replace swaps the interceptor added under name, keeping its position. Returns false if there is none.
+func (d *DynamicResolver[M]) Replace(name string, interceptor Interceptor[M]) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.replaceLocked(name, interceptor)
+}
+
+// Names returns the names of the current interceptors, in order.
+func (d *DynamicResolver[M]) Names() []string {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	names := make([]string, len(d.entries))
+	for i, entry := range d.entries {
+		names[i] = entry.name
+	}
+	return names
+}
+
+// Resolve implements InterceptorResolver.
+// The returned slice is shared with other requests and must not be modified.
+func (d *DynamicResolver[M]) Resolve(ctx *UniversalContext[M], handlerKey string) []Interceptor[M] {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	return d.chain
+}
+
+func (d *DynamicResolver[M]) replaceLocked(name string, interceptor Interceptor[M]) bool {
+	for i, entry := range d.entries {
+		if entry.name == name {
+			entries := d.cloneEntries()
+			entries[i].interceptor = interceptor
+			d.update(entries)
+			return true
+		}
+	}
+	return false
+}
+
+func (d *DynamicResolver[M]) cloneEntries() []dynamicEntry[M] {
+	return append([]dynamicEntry[M](nil), d.entries...)
+}
+
+// update installs entries and rebuilds the chain snapshot.
+func (d *DynamicResolver[M]) update(entries []dynamicEntry[M]) {
+	chain := make([]Interceptor[M], len(entries))
+	for i, entry := range entries {
+		chain[i] = entry.interceptor
+	}
+	d.entries = entries
+	d.chain = chain
+}
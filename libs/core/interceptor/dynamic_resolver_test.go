@@ -0,0 +1,145 @@
+package interceptor
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func runResolved(resolver InterceptorResolver[MockMeta]) error {
+	ctx := NewUniversalContext[MockMeta](nil, "http", "GET /users", MockMeta{})
+	_, err := Chain(func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return nil, nil
+	}, resolver.Resolve(ctx, "/users")...)(ctx)
+	return err
+}
+
+func TestDynamicResolver_AddRemoveReplace(t *testing.T) {
+	var calls []string
+	resolver := NewDynamicResolver[MockMeta]().
+		Add("auth", namedPassThrough("auth", &calls)).
+		Add("logging", namedPassThrough("logging", &calls))
+
+	runResolved(resolver)
+	if want := []string{"auth", "logging"}; !reflect.DeepEqual(calls, want) {
+		t.Fatalf("Expected %v, got %v", want, calls)
+	}
+
+	calls = nil
+	if !resolver.Replace("auth", namedPassThrough("auth-v2", &calls)) {
+		t.Fatal("Expected Replace to find auth")
+	}
+	resolver.Add("debug", namedPassThrough("debug", &calls))
+	runResolved(resolver)
+	if want := []string{"auth-v2", "logging", "debug"}; !reflect.DeepEqual(calls, want) {
+		t.Fatalf("Expected replaced interceptor in place and added one last, got %v", calls)
+	}
+
+	calls = nil
+	if !resolver.Remove("logging") {
+		t.Fatal("Expected Remove to find logging")
+	}
+	runResolved(resolver)
+	if want := []string{"auth-v2", "debug"}; !reflect.DeepEqual(calls, want) {
+		t.Fatalf("Expected %v, got %v", want, calls)
+	}
+	if want := []string{"auth", "debug"}; !reflect.DeepEqual(resolver.Names(), want) {
+		t.Errorf("Expected names %v, got %v", want, resolver.Names())
+	}
+}
+
+func TestDynamicResolver_MissingName(t *testing.T) {
+	var calls []string
+	resolver := NewDynamicResolver[MockMeta]()
+
+	if resolver.Remove("missing") {
+		t.Error("Expected Remove to report a missing name")
+	}
+	if resolver.Replace("missing", namedPassThrough("x", &calls)) {
+		t.Error("Expected Replace to report a missing name")
+	}
+	if len(resolver.Names()) != 0 {
+		t.Errorf("Expected Replace not to add, got %v", resolver.Names())
+	}
+}
+
+func TestDynamicResolver_AddExistingNameReplaces(t *testing.T) {
+	var calls []string
+	resolver := NewDynamicResolver[MockMeta]().
+		Add("logging", namedPassThrough("info", &calls)).
+		Add("auth", namedPassThrough("auth", &calls)).
+		Add("logging", namedPassThrough("debug", &calls))
+
+	runResolved(resolver)
+	if want := []string{"debug", "auth"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("Expected %v, got %v", want, calls)
+	}
+}
+
+func TestDynamicResolver_SnapshotUnaffectedByChanges(t *testing.T) {
+	var calls []string
+	resolver := NewDynamicResolver[MockMeta]().Add("auth", namedPassThrough("auth", &calls))
+	ctx := NewUniversalContext[MockMeta](nil, "http", "GET /users", MockMeta{})
+
+	snapshot := resolver.Resolve(ctx, "/users")
+	resolver.Add("logging", namedPassThrough("logging", &calls))
+	resolver.Remove("auth")
+
+	if len(snapshot) != 1 {
+		t.Errorf("Expected an in-flight snapshot to keep 1 interceptor, got %d", len(snapshot))
+	}
+	if got := resolver.Resolve(ctx, "/users"); len(got) != 1 {
+		t.Errorf("Expected the new set to have 1 interceptor, got %d", len(got))
+	}
+}
+
+func TestDynamicResolver_ConcurrentResolveAndAdd(t *testing.T) {
+	resolver := NewDynamicResolver[MockMeta]()
+	var mu sync.Mutex
+	var calls []string
+	passThrough := func(name string) Interceptor[MockMeta] {
+		return InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+			mu.Lock()
+			calls = append(calls, name)
+			mu.Unlock()
+			return next(ctx)
+		})
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				name := fmt.Sprintf("w%d-%d", i, j)
+				resolver.Add(name, passThrough(name))
+				if j%2 == 0 {
+					resolver.Remove(name)
+				}
+			}
+		}(i)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if err := runResolved(resolver); err != nil {
+					t.Error(err)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := len(resolver.Names()); got != 100 {
+		t.Errorf("Expected 100 interceptors after the writers finish, got %d", got)
+	}
+
+	mu.Lock()
+	calls = nil
+	mu.Unlock()
+	runResolved(resolver)
+	if len(calls) != 100 {
+		t.Errorf("Expected every added interceptor in a later resolution, got %d calls", len(calls))
+	}
+}
@@ -0,0 +1,46 @@
+package interceptor
+
+import "context"
+
+type featureFlagsKey struct{}
+
+// FeatureFlagInterceptor attaches the feature flags enabled for this request to the context,
+// so handlers can branch with FeatureEnabled.
+//
+// flags is called once per request, e.g. reading a map from the loaded config, so toggling
+// the source (a config reload) applies to the next request. The enabled flags are copied:
+// a request sees the same values from start to finish.
+//
+// Example:
+//
+//	flags := interceptor.FeatureFlagInterceptor[GinMeta](func() map[string]bool {
+//	    return cfg.Get().Features // features: {new-checkout: true}
+//	})
+//
+//	func handler(ctx *interceptor.UniversalContext[GinMeta]) (any, error) {
+//	    if interceptor.FeatureEnabled(ctx, "new-checkout") {
+//	        return newCheckout(ctx)
+//	    }
+//	    return checkout(ctx)
+//	}
+func FeatureFlagInterceptor[M any](flags func() map[string]bool) Interceptor[M] {
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		enabled := make(map[string]struct{})
+		for name, on := range flags() {
+			if on {
+				enabled[name] = struct{}{}
+			}
+		}
+
+		ctx.Context = context.WithValue(ctx.Context, featureFlagsKey{}, enabled)
+		return next(ctx)
+	})
+}
+
+// FeatureEnabled reports whether FeatureFlagInterceptor found flag name enabled for this request.
+// Returns false for unknown flags and when the interceptor did not run.
+func FeatureEnabled(ctx context.Context, name string) bool {
+	enabled, _ := ctx.Value(featureFlagsKey{}).(map[string]struct{})
+	_, ok := enabled[name]
+	return ok
+}
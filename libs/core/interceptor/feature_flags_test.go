@@ -0,0 +1,87 @@
+package interceptor
+
+import (
+	"context"
+	"sync"
+	"testing"
+)
+
+// runWithFlags runs a handler reading flag name through flags and returns what it observed
+func runWithFlags(t *testing.T, flags Interceptor[TestMeta], name string) bool {
+	t.Helper()
+	var observed bool
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		observed = FeatureEnabled(ctx, name)
+		return nil, nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "POST /checkout", TestMeta{})
+	if _, err := Chain(handler, flags)(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	return observed
+}
+
+func TestFeatureFlagInterceptor_HandlerReadsEnabledFlags(t *testing.T) {
+	flags := FeatureFlagInterceptor[TestMeta](func() map[string]bool {
+		return map[string]bool{"new-checkout": true, "dark-mode": false}
+	})
+
+	if !runWithFlags(t, flags, "new-checkout") {
+		t.Error("Expected new-checkout to be enabled")
+	}
+	if runWithFlags(t, flags, "dark-mode") {
+		t.Error("Expected dark-mode to be disabled")
+	}
+	if runWithFlags(t, flags, "unknown") {
+		t.Error("Expected unknown flag to be disabled")
+	}
+}
+
+func TestFeatureFlagInterceptor_ToggleAppliesToNextRequest(t *testing.T) {
+	var mu sync.Mutex
+	source := map[string]bool{"new-checkout": false}
+	flags := FeatureFlagInterceptor[TestMeta](func() map[string]bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return map[string]bool{"new-checkout": source["new-checkout"]}
+	})
+
+	if runWithFlags(t, flags, "new-checkout") {
+		t.Error("Expected new-checkout to start disabled")
+	}
+
+	mu.Lock()
+	source["new-checkout"] = true
+	mu.Unlock()
+
+	if !runWithFlags(t, flags, "new-checkout") {
+		t.Error("Expected toggled flag to be enabled on the next request")
+	}
+}
+
+func TestFeatureFlagInterceptor_SnapshotPerRequest(t *testing.T) {
+	source := map[string]bool{"new-checkout": true}
+	flags := FeatureFlagInterceptor[TestMeta](func() map[string]bool { return source })
+
+	var before, after bool
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		before = FeatureEnabled(ctx, "new-checkout")
+		source["new-checkout"] = false // toggled mid-request
+		after = FeatureEnabled(ctx, "new-checkout")
+		return nil, nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "POST /checkout", TestMeta{})
+	Chain(handler, flags)(ctx)
+
+	if !before || !after {
+		t.Errorf("Expected the flag to stay enabled for the whole request, got %v then %v", before, after)
+	}
+}
+
+func TestFeatureEnabled_WithoutInterceptor(t *testing.T) {
+	if FeatureEnabled(context.Background(), "new-checkout") {
+		t.Error("Expected false without FeatureFlagInterceptor")
+	}
+}
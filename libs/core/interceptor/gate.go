@@ -0,0 +1,85 @@
+package interceptor
+
+import (
+	"errors"
+	"path"
+	"sync/atomic"
+)
+
+// ErrServiceUnavailable is returned by MaintenanceGate while maintenance mode is enabled.
+var ErrServiceUnavailable = errors.New("service unavailable")
+
+// Gate is an optional interface a resolver can implement to veto a request
+// before any interceptor is resolved or chained.
+// ExecutePipeline checks it first; a non-nil error skips the chain entirely
+// and is passed straight to the bridge's OnError hook.
+type Gate[M any] interface {
+	// Allow returns nil to let the request through, or the error to reject it with.
+	Allow(ctx *UniversalContext[M], handlerKey string) error
+}
+
+// MaintenanceGate wraps a resolver with a maintenance switch.
+// While enabled, every request is rejected with ErrServiceUnavailable
+// unless its method or operation matches one of the allowlist patterns (e.g. health checks).
+// Patterns use path.Match syntax: "GET /health", "/internal/*".
+//
+// Example:
+//
+//	gate := interceptor.NewMaintenanceGate[GinMeta](resolver, "GET /health", "GET /ready")
+//	gate.Enable()
+//	interceptor.ExecutePipeline(bridge, gate, c, key, handler)
+type MaintenanceGate[M any] struct {
+	InterceptorResolver[M]
+	allowlist []string
+	enabled   atomic.Bool
+}
+
+// NewMaintenanceGate creates a MaintenanceGate (disabled by default) around resolver.
+func NewMaintenanceGate[M any](resolver InterceptorResolver[M], allowlist ...string) *MaintenanceGate[M] {
+	return &MaintenanceGate[M]{
+		InterceptorResolver: resolver,
+		allowlist:           allowlist,
+	}
+}
+
+// Enable turns maintenance mode on. Safe for concurrent use.
+func (g *MaintenanceGate[M]) Enable() {
+	g.enabled.Store(true)
+}
+
+// Disable turns maintenance mode off. Safe for concurrent use.
+func (g *MaintenanceGate[M]) Disable() {
+	g.enabled.Store(false)
+}
+
+// Enabled reports whether maintenance mode is on.
+func (g *MaintenanceGate[M]) Enabled() bool {
+	return g.enabled.Load()
+}
+
+// Allow implements Gate.
+// When maintenance mode is off, the wrapped resolver's own Gate (if any) decides.
+func (g *MaintenanceGate[M]) Allow(ctx *UniversalContext[M], handlerKey string) error {
+	if g.enabled.Load() && !g.allowed(ctx.Method) && !g.allowed(ctx.Operation) {
+		return NewInterceptorError("maintenance", ErrServiceUnavailable)
+	}
+
+	if inner, ok := g.InterceptorResolver.(Gate[M]); ok {
+		return inner.Allow(ctx, handlerKey)
+	}
+
+	return nil
+}
+
+// allowed reports whether method matches one of the allowlist patterns.
+func (g *MaintenanceGate[M]) allowed(method string) bool {
+	if method == "" {
+		return false
+	}
+	for _, pattern := range g.allowlist {
+		if matched, _ := path.Match(pattern, method); matched {
+			return true
+		}
+	}
+	return false
+}
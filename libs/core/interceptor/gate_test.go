@@ -0,0 +1,171 @@
+package interceptor
+
+import (
+	"errors"
+	"testing"
+)
+
+type denyGate struct {
+	SimpleResolver[MockMeta]
+	err error
+}
+
+func (d *denyGate) Allow(ctx *UniversalContext[MockMeta], handlerKey string) error {
+	return d.err
+}
+
+func newGateTestBridge(onError func(*MockNativeContext, error)) *BaseBridge[MockMeta, *MockNativeContext] {
+	return &BaseBridge[MockMeta, *MockNativeContext]{
+		Protocol: "http",
+		GetMethodFn: func(nc *MockNativeContext) string {
+			return nc.Method + " " + nc.Path
+		},
+		OnErrorFn: onError,
+	}
+}
+
+func TestExecutePipeline_GateRejects(t *testing.T) {
+	gateErr := errors.New("rejected")
+	var capturedError error
+	var interceptorCalled, handlerCalled bool
+
+	bridge := newGateTestBridge(func(nc *MockNativeContext, err error) {
+		capturedError = err
+	})
+
+	resolver := &denyGate{err: gateErr}
+	resolver.Interceptors = []Interceptor[MockMeta]{
+		InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+			interceptorCalled = true
+			return next(ctx)
+		}),
+	}
+
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) {
+		handlerCalled = true
+		return "success", nil
+	}
+
+	nativeCtx := &MockNativeContext{Path: "/api/users", Method: "GET"}
+	result, err := ExecutePipeline(bridge, resolver, nativeCtx, "/api/users", handler)
+
+	if err != gateErr {
+		t.Errorf("Expected error %v, got %v", gateErr, err)
+	}
+	if result != nil {
+		t.Errorf("Expected nil result, got %v", result)
+	}
+	if capturedError != gateErr {
+		t.Errorf("Expected OnError to receive %v, got %v", gateErr, capturedError)
+	}
+	if interceptorCalled || handlerCalled {
+		t.Error("Expected chain to be skipped when gate rejects")
+	}
+}
+
+func TestMaintenanceGate_Disabled(t *testing.T) {
+	gate := NewMaintenanceGate[MockMeta](&SimpleResolver[MockMeta]{})
+	bridge := newGateTestBridge(nil)
+
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return "ok", nil
+	}
+
+	nativeCtx := &MockNativeContext{Path: "/api/users", Method: "GET"}
+	result, err := ExecutePipeline(bridge, gate, nativeCtx, "/api/users", handler)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result != "ok" {
+		t.Errorf("Expected 'ok', got %v", result)
+	}
+}
+
+func TestMaintenanceGate_Enabled(t *testing.T) {
+	gate := NewMaintenanceGate[MockMeta](&SimpleResolver[MockMeta]{}, "GET /health")
+	gate.Enable()
+
+	var onErrorCalled bool
+	bridge := newGateTestBridge(func(nc *MockNativeContext, err error) {
+		onErrorCalled = true
+	})
+
+	handlerCalled := false
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) {
+		handlerCalled = true
+		return "ok", nil
+	}
+
+	nativeCtx := &MockNativeContext{Path: "/api/users", Method: "GET"}
+	_, err := ExecutePipeline(bridge, gate, nativeCtx, "/api/users", handler)
+
+	if !errors.Is(err, ErrServiceUnavailable) {
+		t.Errorf("Expected ErrServiceUnavailable, got %v", err)
+	}
+	if !onErrorCalled {
+		t.Error("Expected OnError to be called")
+	}
+	if handlerCalled {
+		t.Error("Expected handler not to be called in maintenance mode")
+	}
+}
+
+func TestMaintenanceGate_Allowlist(t *testing.T) {
+	gate := NewMaintenanceGate[MockMeta](&SimpleResolver[MockMeta]{}, "GET /health", "GET /internal/*")
+	gate.Enable()
+	bridge := newGateTestBridge(nil)
+
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return "ok", nil
+	}
+
+	for _, path := range []string{"/health", "/internal/ready"} {
+		nativeCtx := &MockNativeContext{Path: path, Method: "GET"}
+		result, err := ExecutePipeline(bridge, gate, nativeCtx, path, handler)
+
+		if err != nil {
+			t.Errorf("%s: expected no error, got %v", path, err)
+		}
+		if result != "ok" {
+			t.Errorf("%s: expected 'ok', got %v", path, result)
+		}
+	}
+}
+
+func TestMaintenanceGate_ToggleAtRuntime(t *testing.T) {
+	gate := NewMaintenanceGate[MockMeta](&SimpleResolver[MockMeta]{})
+	bridge := newGateTestBridge(nil)
+
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return "ok", nil
+	}
+	nativeCtx := &MockNativeContext{Path: "/api/users", Method: "GET"}
+
+	if _, err := ExecutePipeline(bridge, gate, nativeCtx, "/api/users", handler); err != nil {
+		t.Fatalf("Expected no error before Enable, got %v", err)
+	}
+
+	gate.Enable()
+	if !gate.Enabled() {
+		t.Error("Expected gate to be enabled")
+	}
+	if _, err := ExecutePipeline(bridge, gate, nativeCtx, "/api/users", handler); !errors.Is(err, ErrServiceUnavailable) {
+		t.Fatalf("Expected ErrServiceUnavailable after Enable, got %v", err)
+	}
+
+	gate.Disable()
+	if _, err := ExecutePipeline(bridge, gate, nativeCtx, "/api/users", handler); err != nil {
+		t.Fatalf("Expected no error after Disable, got %v", err)
+	}
+}
+
+func TestMaintenanceGate_DelegatesToInnerGate(t *testing.T) {
+	innerErr := errors.New("inner rejected")
+	gate := NewMaintenanceGate[MockMeta](&denyGate{err: innerErr})
+
+	ctx := NewUniversalContext[MockMeta](nil, "http", "GET /", MockMeta{})
+	if err := gate.Allow(ctx, "/"); err != innerErr {
+		t.Errorf("Expected inner gate error %v, got %v", innerErr, err)
+	}
+}
@@ -0,0 +1,137 @@
+package interceptor
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// WildcardHandlerKey registers a fallback handler in a HandlerRegistry,
+// used by Lookup for keys with no handler of their own.
+const WildcardHandlerKey = "*"
+
+// ErrNoHandler is matched (via errors.Is) by every NoHandlerError.
+// Bridges can map it to 404 Not Found / UNIMPLEMENTED.
+var ErrNoHandler = errors.New("no handler")
+
+// ErrHandlerExists is returned by HandlerRegistry.Register for a key that already has a handler.
+var ErrHandlerExists = errors.New("handler already registered")
+
+// NoHandlerError is returned by ExecuteRegistered when no handler is registered for a key.
+type NoHandlerError struct {
+	HandlerKey string
+}
+
+// Error implements the error interface.
+func (e *NoHandlerError) Error() string {
+	return fmt.Sprintf("no handler: %s", e.HandlerKey)
+}
+
+// Is makes errors.Is(err, ErrNoHandler) match.
+func (e *NoHandlerError) Is(target error) bool {
+	return target == ErrNoHandler
+}
+
+// HandlerRegistry maps handler keys to business handlers, so message-driven adapters
+// register handlers once and look them up per message (see ExecuteRegistered).
+// Safe for concurrent use.
+type HandlerRegistry[M any] struct {
+	mu       sync.RWMutex
+	handlers map[string]NextFunc[M]
+}
+
+// NewHandlerRegistry creates an empty HandlerRegistry.
+//
+// Example:
+//
+//	handlers := interceptor.NewHandlerRegistry[KafkaMeta]()
+//	handlers.Register("orders.created", onOrderCreated)
+//	handlers.Register(interceptor.WildcardHandlerKey, deadLetter) // optional fallback
+func NewHandlerRegistry[M any]() *HandlerRegistry[M] {
+	return &HandlerRegistry[M]{handlers: make(map[string]NextFunc[M])}
+}
+
+// Register adds handler for handlerKey.
+// Use WildcardHandlerKey to register the fallback handler.
+// Returns an error matching ErrHandlerExists if handlerKey already has a handler.
+//
+// Panics:
+//   - If handler is nil
+func (r *HandlerRegistry[M]) Register(handlerKey string, handler NextFunc[M]) error {
+	if handler == nil {
+		panic("interceptor: handler cannot be nil")
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.handlers[handlerKey]; exists {
+		return fmt.Errorf("%w: %s", ErrHandlerExists, handlerKey)
+	}
+	r.handlers[handlerKey] = handler
+	return nil
+}
+
+// Deregister removes the handler for handlerKey and reports whether there was one.
+func (r *HandlerRegistry[M]) Deregister(handlerKey string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, exists := r.handlers[handlerKey]
+	delete(r.handlers, handlerKey)
+	return exists
+}
+
+// Lookup returns the handler for handlerKey, or the WildcardHandlerKey handler if there is none.
+// Returns false if neither is registered.
+func (r *HandlerRegistry[M]) Lookup(handlerKey string) (NextFunc[M], bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if handler, ok := r.handlers[handlerKey]; ok {
+		return handler, true
+	}
+	handler, ok := r.handlers[WildcardHandlerKey]
+	return handler, ok
+}
+
+// Keys returns the registered handler keys, sorted.
+func (r *HandlerRegistry[M]) Keys() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	keys := make([]string, 0, len(r.handlers))
+	for key := range r.handlers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// ExecuteRegistered is like ExecutePipeline, but looks the business handler up in registry
+// by handlerKey instead of taking it as an argument.
+//
+// If no handler (and no wildcard fallback) is registered, it returns a NoHandlerError
+// (errors.Is(err, ErrNoHandler)) through bridge.OnError without running any interceptor.
+//
+// Example:
+//
+//	func (c *Consumer) onMessage(msg *kafka.Message) {
+//	    interceptor.ExecuteRegistered(bridge, resolver, handlers, msg, *msg.TopicPartition.Topic)
+//	}
+func ExecuteRegistered[M any, NativeCtx any](
+	bridge Bridge[M, NativeCtx],
+	resolver InterceptorResolver[M],
+	registry *HandlerRegistry[M],
+	nativeCtx NativeCtx,
+	handlerKey string,
+) (any, error) {
+	handler, ok := registry.Lookup(handlerKey)
+	if !ok {
+		err := &NoHandlerError{HandlerKey: handlerKey}
+		bridge.OnError(nativeCtx, err)
+		return nil, err
+	}
+	return ExecutePipeline(bridge, resolver, nativeCtx, handlerKey, handler)
+}
@@ -0,0 +1,161 @@
+package interceptor
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// newRegistryBridge returns a bridge using the native context's Path as Method,
+// recording the error passed to OnError
+func newRegistryBridge(onError *error) *BaseBridge[MockMeta, *MockNativeContext] {
+	return &BaseBridge[MockMeta, *MockNativeContext]{
+		Protocol:    "kafka",
+		GetMethodFn: func(nc *MockNativeContext) string { return nc.Path },
+		OnErrorFn:   func(_ *MockNativeContext, err error) { *onError = err },
+	}
+}
+
+func handlerReturning(result string) NextFunc[MockMeta] {
+	return func(ctx *UniversalContext[MockMeta]) (any, error) {
+		return result, nil
+	}
+}
+
+func TestExecuteRegistered_LookupHit(t *testing.T) {
+	registry := NewHandlerRegistry[MockMeta]()
+	if err := registry.Register("orders.created", handlerReturning("created")); err != nil {
+		t.Fatalf("Register failed: %v", err)
+	}
+
+	var intercepted []string
+	logging := InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		intercepted = append(intercepted, ctx.Method)
+		return next(ctx)
+	})
+
+	var onError error
+	result, err := ExecuteRegistered[MockMeta, *MockNativeContext](newRegistryBridge(&onError), NewSimpleResolver(logging), registry,
+		&MockNativeContext{Path: "orders.created"}, "orders.created")
+
+	if err != nil || result != "created" {
+		t.Errorf("Expected created, got %v (err=%v)", result, err)
+	}
+	if !reflect.DeepEqual(intercepted, []string{"orders.created"}) {
+		t.Errorf("Expected the interceptor chain to run, got %v", intercepted)
+	}
+}
+
+func TestExecuteRegistered_LookupMiss(t *testing.T) {
+	registry := NewHandlerRegistry[MockMeta]()
+
+	ran := false
+	guard := InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		ran = true
+		return next(ctx)
+	})
+
+	var onError error
+	_, err := ExecuteRegistered[MockMeta, *MockNativeContext](newRegistryBridge(&onError), NewSimpleResolver(guard), registry,
+		&MockNativeContext{Path: "orders.deleted"}, "orders.deleted")
+
+	if !errors.Is(err, ErrNoHandler) {
+		t.Fatalf("Expected ErrNoHandler, got %v", err)
+	}
+	var noHandler *NoHandlerError
+	if !errors.As(err, &noHandler) || noHandler.HandlerKey != "orders.deleted" {
+		t.Errorf("Expected NoHandlerError for orders.deleted, got %v", err)
+	}
+	if onError != err {
+		t.Errorf("Expected OnError to receive the error, got %v", onError)
+	}
+	if ran {
+		t.Error("Expected no interceptor to run without a handler")
+	}
+}
+
+func TestExecuteRegistered_WildcardFallback(t *testing.T) {
+	registry := NewHandlerRegistry[MockMeta]()
+	registry.Register("orders.created", handlerReturning("created"))
+	registry.Register(WildcardHandlerKey, handlerReturning("dead-letter"))
+
+	var onError error
+	bridge := newRegistryBridge(&onError)
+	resolver := NewSimpleResolver[MockMeta]()
+
+	if result, _ := ExecuteRegistered[MockMeta, *MockNativeContext](bridge, resolver, registry, &MockNativeContext{}, "orders.created"); result != "created" {
+		t.Errorf("Expected exact key to win over the wildcard, got %v", result)
+	}
+	if result, _ := ExecuteRegistered[MockMeta, *MockNativeContext](bridge, resolver, registry, &MockNativeContext{}, "orders.unknown"); result != "dead-letter" {
+		t.Errorf("Expected wildcard fallback, got %v", result)
+	}
+
+	registry.Deregister(WildcardHandlerKey)
+	if _, err := ExecuteRegistered[MockMeta, *MockNativeContext](bridge, resolver, registry, &MockNativeContext{}, "orders.unknown"); !errors.Is(err, ErrNoHandler) {
+		t.Errorf("Expected ErrNoHandler after removing the wildcard, got %v", err)
+	}
+}
+
+func TestHandlerRegistry_RegisterAndDeregister(t *testing.T) {
+	registry := NewHandlerRegistry[MockMeta]()
+	registry.Register("a", handlerReturning("a"))
+
+	if err := registry.Register("a", handlerReturning("again")); !errors.Is(err, ErrHandlerExists) {
+		t.Errorf("Expected ErrHandlerExists on duplicate, got %v", err)
+	}
+	handler, _ := registry.Lookup("a")
+	if result, _ := handler(nil); result != "a" {
+		t.Errorf("Expected the first handler to be kept, got %v", result)
+	}
+
+	if !registry.Deregister("a") {
+		t.Error("Expected Deregister to report the removed handler")
+	}
+	if registry.Deregister("a") {
+		t.Error("Expected a second Deregister to report nothing removed")
+	}
+	if _, ok := registry.Lookup("a"); ok {
+		t.Error("Expected no handler after Deregister")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected Register to panic on a nil handler")
+		}
+	}()
+	registry.Register("b", nil)
+}
+
+func TestHandlerRegistry_ConcurrentRegistration(t *testing.T) {
+	registry := NewHandlerRegistry[MockMeta]()
+
+	const workers, keys = 8, 50
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	registered := 0
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := 0; k < keys; k++ {
+				key := fmt.Sprintf("topic.%d", k)
+				if err := registry.Register(key, handlerReturning(key)); err == nil {
+					mu.Lock()
+					registered++
+					mu.Unlock()
+				}
+				registry.Lookup(key)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if registered != keys {
+		t.Errorf("Expected each key to be registered exactly once, got %d successful registrations", registered)
+	}
+	if got := len(registry.Keys()); got != keys {
+		t.Errorf("Expected %d keys, got %d", keys, got)
+	}
+}
@@ -0,0 +1,188 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// IdempotencyStore keeps handler results by idempotency key for IdempotencyInterceptor.
+// Implementations must be safe for concurrent use and decide how long results are kept (the TTL).
+//
+// A Redis-backed store maps directly onto the interface, with results serialized by the caller's codec:
+//
+//	Get: GET idem:<key>          → ok = key exists
+//	Put: SET idem:<key> <result> PX <ttl>
+type IdempotencyStore interface {
+	// Get returns the result stored for key, or ok == false if there is none (or it expired).
+	Get(ctx context.Context, key string) (result any, ok bool, err error)
+
+	// Put stores result for key.
+	Put(ctx context.Context, key string, result any) error
+}
+
+// idempotencyCall is an execution in progress for one key; duplicates wait on done.
+type idempotencyCall struct {
+	done   chan struct{}
+	result any
+	err    error
+}
+
+// IdempotencyInterceptor creates an interceptor for write endpoints that replays the stored
+// result of a repeated idempotency key instead of running the handler again.
+//
+// Parameters:
+//   - store: where results are kept, and for how long (MemoryIdempotencyStore for one instance)
+//   - key: extracts the idempotency key (e.g. the Idempotency-Key header); "" bypasses the interceptor
+//
+// The first request with a key runs next and, if it succeeds, stores its result.
+// Failed requests store nothing, so the client can retry them. Concurrent requests with the same key
+// wait for the one in progress and share its result or error instead of running next themselves
+// (within this process; across instances the store's contents are the only guard).
+// A store error on lookup rejects the request rather than risking a second execution;
+// a store error after the handler succeeded is ignored and the result returned.
+//
+// Panics if store or key is nil.
+//
+// Example:
+//
+//	idempotency := interceptor.IdempotencyInterceptor[GinMeta](
+//	    interceptor.NewMemoryIdempotencyStore(24*time.Hour),
+//	    func(ctx *interceptor.UniversalContext[GinMeta]) string {
+//	        return ctx.Meta.Headers["Idempotency-Key"]
+//	    },
+//	)
+//	registry.Register("idempotency", idempotency, interceptor.OnPatterns("POST /payments"))
+func IdempotencyInterceptor[M any](store IdempotencyStore, key func(*UniversalContext[M]) string) Interceptor[M] {
+	if store == nil || key == nil {
+		panic("interceptor: IdempotencyInterceptor requires a store and a key function")
+	}
+
+	var mu sync.Mutex
+	calls := make(map[string]*idempotencyCall)
+
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		k := key(ctx)
+		if k == "" {
+			return next(ctx)
+		}
+
+		mu.Lock()
+		if call, ok := calls[k]; ok {
+			mu.Unlock()
+			select {
+			case <-call.done:
+				return call.result, call.err
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		call := &idempotencyCall{done: make(chan struct{})}
+		calls[k] = call
+		mu.Unlock()
+
+		// Finish the call even if next panics, so duplicates waiting on it are released
+		defer func() {
+			mu.Lock()
+			delete(calls, k)
+			mu.Unlock()
+			close(call.done)
+		}()
+		call.err = errPanicked
+
+		// Looked up while holding the key, so a result stored by a call that just finished is seen
+		stored, ok, err := store.Get(ctx, k)
+		if err != nil {
+			call.err = NewInterceptorError("idempotency", err)
+			return nil, call.err
+		}
+		if ok {
+			call.result, call.err = stored, nil
+			return stored, nil
+		}
+
+		call.result, call.err = next(ctx)
+		if call.err == nil {
+			// Store with a fresh context: ctx may already be canceled
+			_ = store.Put(context.WithoutCancel(ctx), k, call.result)
+		}
+		return call.result, call.err
+	})
+}
+
+// errPanicked is what duplicates waiting on a call receive when the handler panicked.
+var errPanicked = NewInterceptorError("idempotency", errors.New("request with the same key did not complete"))
+
+// MemoryIdempotencyStore is an in-process IdempotencyStore keeping results for a fixed TTL.
+// Expired results are dropped when looked up, and swept in bulk as the store grows.
+type MemoryIdempotencyStore struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu        sync.Mutex
+	entries   map[string]idempotencyEntry
+	sweepSize int // sweep expired entries when the map reaches this size
+}
+
+type idempotencyEntry struct {
+	result  any
+	expires time.Time
+}
+
+// NewMemoryIdempotencyStore creates a MemoryIdempotencyStore keeping results for ttl.
+//
+// Panics if ttl is not positive.
+func NewMemoryIdempotencyStore(ttl time.Duration) *MemoryIdempotencyStore {
+	if ttl <= 0 {
+		panic("interceptor: NewMemoryIdempotencyStore requires a positive ttl")
+	}
+	return &MemoryIdempotencyStore{
+		ttl:       ttl,
+		now:       time.Now,
+		entries:   make(map[string]idempotencyEntry),
+		sweepSize: 64,
+	}
+}
+
+// Get implements IdempotencyStore.
+func (s *MemoryIdempotencyStore) Get(_ context.Context, key string) (any, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, ok := s.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if !s.now().Before(entry.expires) {
+		delete(s.entries, key)
+		return nil, false, nil
+	}
+	return entry.result, true, nil
+}
+
+// Put implements IdempotencyStore.
+func (s *MemoryIdempotencyStore) Put(_ context.Context, key string, result any) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if len(s.entries) >= s.sweepSize {
+		for k, entry := range s.entries {
+			if !now.Before(entry.expires) {
+				delete(s.entries, k)
+			}
+		}
+		s.sweepSize = max(64, 2*len(s.entries))
+	}
+
+	s.entries[key] = idempotencyEntry{result: result, expires: now.Add(s.ttl)}
+	return nil
+}
+
+// Len returns the number of stored results, expired ones not yet dropped included.
+func (s *MemoryIdempotencyStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
@@ -0,0 +1,184 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// idempotencyKey uses UserID as the idempotency key
+func idempotencyKey(ctx *UniversalContext[TestMeta]) string {
+	return ctx.Meta.UserID
+}
+
+func TestIdempotencyInterceptor_ReplaysResult(t *testing.T) {
+	idem := IdempotencyInterceptor[TestMeta](NewMemoryIdempotencyStore(time.Minute), idempotencyKey)
+
+	var calls atomic.Int32
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return calls.Add(1), nil
+	}
+
+	for i := 0; i < 3; i++ {
+		ctx := NewUniversalContext[TestMeta](nil, "http", "POST /payments", TestMeta{UserID: "key-1"})
+		result, err := Chain(handler, idem)(ctx)
+		if err != nil || result != int32(1) {
+			t.Errorf("Expected replayed result 1, got %v, %v", result, err)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected the handler to run once, ran %d times", calls.Load())
+	}
+
+	// Another key runs the handler again
+	ctx := NewUniversalContext[TestMeta](nil, "http", "POST /payments", TestMeta{UserID: "key-2"})
+	if result, _ := Chain(handler, idem)(ctx); result != int32(2) {
+		t.Errorf("Expected a new key to run the handler, got %v", result)
+	}
+}
+
+func TestIdempotencyInterceptor_ConcurrentDuplicates(t *testing.T) {
+	idem := IdempotencyInterceptor[TestMeta](NewMemoryIdempotencyStore(time.Minute), idempotencyKey)
+
+	var calls atomic.Int32
+	release := make(chan struct{})
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		calls.Add(1)
+		<-release
+		return "charged", nil
+	}
+
+	const n = 10
+	var wg sync.WaitGroup
+	results := make(chan any, n)
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx := NewUniversalContext[TestMeta](nil, "http", "POST /payments", TestMeta{UserID: "key-1"})
+			result, err := Chain(handler, idem)(ctx)
+			if err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			results <- result
+		}()
+	}
+
+	time.Sleep(20 * time.Millisecond) // let the duplicates queue up behind the first request
+	close(release)
+	wg.Wait()
+	close(results)
+
+	if calls.Load() != 1 {
+		t.Errorf("Expected the handler to run once, ran %d times", calls.Load())
+	}
+	for result := range results {
+		if result != "charged" {
+			t.Errorf("Expected every duplicate to get the shared result, got %v", result)
+		}
+	}
+}
+
+func TestIdempotencyInterceptor_FailuresAreNotStored(t *testing.T) {
+	idem := IdempotencyInterceptor[TestMeta](NewMemoryIdempotencyStore(time.Minute), idempotencyKey)
+	failure := errors.New("card declined")
+
+	var calls atomic.Int32
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		if calls.Add(1) == 1 {
+			return nil, failure
+		}
+		return "charged", nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "POST /payments", TestMeta{UserID: "key-1"})
+	if _, err := Chain(handler, idem)(ctx); !errors.Is(err, failure) {
+		t.Fatalf("Expected the handler error, got %v", err)
+	}
+	ctx = NewUniversalContext[TestMeta](nil, "http", "POST /payments", TestMeta{UserID: "key-1"})
+	if result, err := Chain(handler, idem)(ctx); err != nil || result != "charged" {
+		t.Errorf("Expected the retry to run the handler, got %v, %v", result, err)
+	}
+}
+
+func TestIdempotencyInterceptor_NoKey(t *testing.T) {
+	idem := IdempotencyInterceptor[TestMeta](NewMemoryIdempotencyStore(time.Minute), idempotencyKey)
+
+	var calls atomic.Int32
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return calls.Add(1), nil
+	}
+	for i := 0; i < 2; i++ {
+		Chain(handler, idem)(NewUniversalContext[TestMeta](nil, "http", "POST /payments", TestMeta{}))
+	}
+	if calls.Load() != 2 {
+		t.Errorf("Expected requests without a key to always run, ran %d times", calls.Load())
+	}
+}
+
+// failingIdempotencyStore fails every lookup
+type failingIdempotencyStore struct{}
+
+func (failingIdempotencyStore) Get(context.Context, string) (any, bool, error) {
+	return nil, false, errors.New("store down")
+}
+
+func (failingIdempotencyStore) Put(context.Context, string, any) error {
+	return nil
+}
+
+func TestIdempotencyInterceptor_StoreError(t *testing.T) {
+	idem := IdempotencyInterceptor[TestMeta](failingIdempotencyStore{}, idempotencyKey)
+
+	called := false
+	ctx := NewUniversalContext[TestMeta](nil, "http", "POST /payments", TestMeta{UserID: "key-1"})
+	_, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) {
+		called = true
+		return nil, nil
+	}, idem)(ctx)
+
+	var ie *InterceptorError
+	if !errors.As(err, &ie) || called {
+		t.Errorf("Expected an InterceptorError without running the handler, got %v (called=%v)", err, called)
+	}
+}
+
+func TestMemoryIdempotencyStore_TTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	store := NewMemoryIdempotencyStore(time.Minute)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	store.Put(ctx, "key-1", "result")
+	if result, ok, _ := store.Get(ctx, "key-1"); !ok || result != "result" {
+		t.Errorf("Expected stored result, got %v, %v", result, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok, _ := store.Get(ctx, "key-1"); ok {
+		t.Error("Expected the result to expire after the TTL")
+	}
+	if store.Len() != 0 {
+		t.Errorf("Expected the expired result to be dropped, got %d entries", store.Len())
+	}
+}
+
+func TestMemoryIdempotencyStore_Sweep(t *testing.T) {
+	now := time.Unix(0, 0)
+	store := NewMemoryIdempotencyStore(time.Minute)
+	store.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for i := 0; i < 64; i++ {
+		store.Put(ctx, string(rune('a'+i)), i)
+	}
+	now = now.Add(time.Hour)
+	store.Put(ctx, "fresh", 1)
+
+	if store.Len() != 1 {
+		t.Errorf("Expected expired results to be swept as the store grows, got %d entries", store.Len())
+	}
+}
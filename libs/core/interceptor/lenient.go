@@ -0,0 +1,86 @@
+package interceptor
+
+import "fmt"
+
+// LenientLogger receives the failures swallowed by Lenient.
+// ISugaredLogger from the log library satisfies it.
+type LenientLogger interface {
+	Warnw(msg string, keysAndValues ...any)
+}
+
+// Lenient wraps an interceptor so its failures never abort the request.
+// Panics and errors raised by inner itself are recovered, logged to logger at Warn level,
+// and the chain continues to next as if inner were absent.
+// Useful for fire-and-forget concerns such as metrics or audit logging.
+//
+// Errors and panics coming from further down the chain (next) are not swallowed;
+// they propagate as usual.
+//
+// Panics if logger is nil.
+//
+// Example:
+//
+//	pipeline := interceptor.Chain(handler,
+//	    interceptor.Lenient[GinMeta](metricsInterceptor, logger),
+//	    authInterceptor,
+//	)
+func Lenient[M any](inner Interceptor[M], logger LenientLogger) Interceptor[M] {
+	if logger == nil {
+		panic("interceptor: Lenient requires a logger")
+	}
+
+	return LenientWithHandler(inner, func(ctx *UniversalContext[M], err error) {
+		logger.Warnw("lenient interceptor failed",
+			"protocol", ctx.Protocol,
+			"method", ctx.OperationName(),
+			"error", err,
+		)
+	})
+}
+
+// LenientWithHandler is like Lenient but reports failures to onFailure instead of a logger.
+// A nil onFailure drops them.
+func LenientWithHandler[M any](inner Interceptor[M], onFailure func(ctx *UniversalContext[M], err error)) Interceptor[M] {
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (result any, err error) {
+		var (
+			nextCalled   bool
+			nextPanicked bool
+			nextResult   any
+			nextErr      error
+		)
+
+		trackedNext := func(c *UniversalContext[M]) (any, error) {
+			nextCalled = true
+			nextPanicked = true
+			nextResult, nextErr = next(c)
+			nextPanicked = false
+			return nextResult, nextErr
+		}
+
+		// continueChain resumes the request after inner failed
+		continueChain := func(failure error) (any, error) {
+			if onFailure != nil {
+				onFailure(ctx, NewInterceptorError("lenient", failure))
+			}
+			if nextCalled {
+				return nextResult, nextErr
+			}
+			return next(ctx)
+		}
+
+		defer func() {
+			if r := recover(); r != nil {
+				if nextPanicked {
+					panic(r)
+				}
+				result, err = continueChain(fmt.Errorf("panic: %v", r))
+			}
+		}()
+
+		result, err = inner.Intercept(ctx, trackedNext)
+		if err != nil && !(nextCalled && err == nextErr) {
+			return continueChain(err)
+		}
+		return result, err
+	})
+}
@@ -0,0 +1,146 @@
+package interceptor
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLenient_PanicBeforeNextStillRunsHandler(t *testing.T) {
+	var reported error
+	panicking := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		panic("metrics backend down")
+	})
+
+	handlerCalls := 0
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		handlerCalls++
+		return "success", nil
+	}
+
+	lenient := LenientWithHandler[TestMeta](panicking, func(ctx *UniversalContext[TestMeta], err error) {
+		reported = err
+	})
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	result, err := Chain(handler, lenient)(ctx)
+
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if result != "success" {
+		t.Errorf("Expected 'success', got %v", result)
+	}
+	if handlerCalls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", handlerCalls)
+	}
+
+	var interceptorErr *InterceptorError
+	if !errors.As(reported, &interceptorErr) || interceptorErr.InterceptorName != "lenient" {
+		t.Errorf("Expected reported InterceptorError 'lenient', got %v", reported)
+	}
+}
+
+func TestLenient_PanicAfterNextKeepsResult(t *testing.T) {
+	panicking := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		next(ctx)
+		panic("failed after handler")
+	})
+
+	handlerCalls := 0
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		handlerCalls++
+		return "success", nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	result, err := Chain(handler, LenientWithHandler[TestMeta](panicking, nil))(ctx)
+
+	if err != nil || result != "success" {
+		t.Errorf("Expected 'success' without error, got %v (err: %v)", result, err)
+	}
+	if handlerCalls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", handlerCalls)
+	}
+}
+
+func TestLenient_InnerErrorIsSwallowed(t *testing.T) {
+	failing := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		return nil, errors.New("audit failed")
+	})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "success", nil
+	}
+
+	logger := &warnRecorder{}
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	result, err := Chain(handler, Lenient[TestMeta](failing, logger))(ctx)
+
+	if err != nil || result != "success" {
+		t.Errorf("Expected 'success' without error, got %v (err: %v)", result, err)
+	}
+	if len(logger.entries) != 1 || logger.entries[0] != "lenient interceptor failed" {
+		t.Errorf("Expected one warning for the swallowed error, got %v", logger.entries)
+	}
+}
+
+func TestLenient_HandlerErrorPropagates(t *testing.T) {
+	handlerErr := errors.New("not found")
+	passthrough := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		return next(ctx)
+	})
+
+	reported := false
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return nil, handlerErr
+	}
+
+	lenient := LenientWithHandler[TestMeta](passthrough, func(ctx *UniversalContext[TestMeta], err error) {
+		reported = true
+	})
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	_, err := Chain(handler, lenient)(ctx)
+
+	if err != handlerErr {
+		t.Errorf("Expected handler error %v, got %v", handlerErr, err)
+	}
+	if reported {
+		t.Error("Expected handler error not to be reported as interceptor failure")
+	}
+}
+
+func TestLenient_HandlerPanicPropagates(t *testing.T) {
+	passthrough := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		return next(ctx)
+	})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		panic("handler bug")
+	}
+
+	defer func() {
+		if r := recover(); r != "handler bug" {
+			t.Errorf("Expected handler panic to propagate, got %v", r)
+		}
+	}()
+
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	Chain(handler, Lenient[TestMeta](passthrough, &warnRecorder{}))(ctx)
+}
+
+// warnRecorder records Warnw messages
+type warnRecorder struct {
+	entries []string
+}
+
+func (r *warnRecorder) Warnw(msg string, keysAndValues ...any) {
+	r.entries = append(r.entries, msg)
+}
+
+func TestLenient_PanicsOnNilLogger(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for nil logger")
+		}
+	}()
+	Lenient[TestMeta](InterceptorFunc[TestMeta](nil), nil)
+}
@@ -0,0 +1,30 @@
+package interceptor
+
+import "reflect"
+
+// hasReferenceKinds reports whether values of t share state when copied:
+// pointers, maps, slices, channels, functions and interfaces, directly or inside structs and arrays.
+func hasReferenceKinds(t reflect.Type) bool {
+	return hasReferenceKindsSeen(t, make(map[reflect.Type]bool))
+}
+
+func hasReferenceKindsSeen(t reflect.Type, seen map[reflect.Type]bool) bool {
+	if seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	switch t.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface, reflect.UnsafePointer:
+		return true
+	case reflect.Array:
+		return hasReferenceKindsSeen(t.Elem(), seen)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if hasReferenceKindsSeen(t.Field(i).Type, seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
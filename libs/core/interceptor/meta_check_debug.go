@@ -0,0 +1,21 @@
+//go:build interceptordebug
+
+package interceptor
+
+import (
+	"log"
+	"reflect"
+	"sync"
+)
+
+// warnedMetaTypes records the Meta types warnSharedMeta has already reported.
+var warnedMetaTypes sync.Map
+
+// warnSharedMeta logs once per M when Derive shares reference kinds between contexts.
+func warnSharedMeta[M any]() {
+	t := reflect.TypeOf((*M)(nil)).Elem()
+	if _, warned := warnedMetaTypes.LoadOrStore(t, true); warned || !hasReferenceKinds(t) {
+		return
+	}
+	log.Printf("interceptor: Meta type %s holds pointers, maps or slices but has no WithMetaClone; derived contexts share them", t)
+}
@@ -0,0 +1,6 @@
+//go:build !interceptordebug
+
+package interceptor
+
+// warnSharedMeta is a no-op without the interceptordebug build tag.
+func warnSharedMeta[M any]() {}
@@ -0,0 +1,106 @@
+package interceptor
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+type taggedMeta struct {
+	Tags map[string]string
+}
+
+func cloneTaggedMeta(m taggedMeta) taggedMeta {
+	m.Tags = maps.Clone(m.Tags)
+	return m
+}
+
+// tagWriters returns n interceptors that each write their own key into Meta.Tags.
+func tagWriters(n int, mu *sync.Mutex) []Interceptor[taggedMeta] {
+	writers := make([]Interceptor[taggedMeta], n)
+	for i := range writers {
+		key := fmt.Sprintf("branch-%d", i)
+		writers[i] = InterceptorFunc[taggedMeta](func(ctx *UniversalContext[taggedMeta], next NextFunc[taggedMeta]) (any, error) {
+			if mu != nil {
+				mu.Lock()
+			}
+			for j := 0; j < 100; j++ {
+				ctx.Meta.Tags[key] = fmt.Sprint(j)
+			}
+			if mu != nil {
+				mu.Unlock()
+			}
+			return next(ctx)
+		})
+	}
+	return writers
+}
+
+func TestParallel_MetaCloneIsolatesBranches(t *testing.T) {
+	ctx := NewUniversalContext(nil, "http", "GET /items", taggedMeta{Tags: map[string]string{"origin": "request"}},
+		WithMetaClone(cloneTaggedMeta))
+
+	// No locking: each branch mutates its own copy, so -race stays quiet
+	_, err := Chain(func(*UniversalContext[taggedMeta]) (any, error) { return nil, nil },
+		Parallel(tagWriters(8, nil)...))(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if want := map[string]string{"origin": "request"}; !reflect.DeepEqual(ctx.Meta.Tags, want) {
+		t.Errorf("Expected the parent Meta untouched, got %v", ctx.Meta.Tags)
+	}
+}
+
+func TestParallel_WithoutMetaCloneSharesReferences(t *testing.T) {
+	ctx := NewUniversalContext(nil, "http", "GET /items", taggedMeta{Tags: map[string]string{}})
+
+	// The map is shared, so branches must lock to avoid a race
+	var mu sync.Mutex
+	_, err := Chain(func(*UniversalContext[taggedMeta]) (any, error) { return nil, nil },
+		Parallel(tagWriters(8, &mu)...))(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(ctx.Meta.Tags) != 8 {
+		t.Errorf("Expected every branch to write the shared map, got %v", ctx.Meta.Tags)
+	}
+}
+
+func TestBaseBridge_MetaClone(t *testing.T) {
+	bridge := &BaseBridge[taggedMeta, *MockNativeContext]{
+		ExtractMetaFn: func(*MockNativeContext) taggedMeta { return taggedMeta{Tags: map[string]string{"a": "1"}} },
+		MetaClone:     cloneTaggedMeta,
+	}
+
+	parent := bridge.CreateUniversalContext(&MockNativeContext{})
+	child := parent.Derive()
+	child.Meta.Tags["a"] = "2"
+
+	if parent.Meta.Tags["a"] != "1" {
+		t.Errorf("Expected the bridge's clone to isolate Meta, got %v", parent.Meta.Tags)
+	}
+}
+
+func TestHasReferenceKinds(t *testing.T) {
+	type inner struct{ IDs []int }
+	tests := []struct {
+		value any
+		want  bool
+	}{
+		{TestMeta{}, false},
+		{[2]int{}, false},
+		{taggedMeta{}, true},
+		{struct{ In inner }{}, true},
+		{struct{ P *int }{}, true},
+		{struct{ E error }{}, true},
+	}
+	for _, tt := range tests {
+		if got := hasReferenceKinds(reflect.TypeOf(tt.value)); got != tt.want {
+			t.Errorf("hasReferenceKinds(%T) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package interceptor
+
+import "sync"
+
+// MethodNormalizer maps a transport-specific method to a canonical operation name,
+// so "GET /v1/users/{id}" (HTTP) and "/users.UserService/GetUser" (gRPC)
+// can share resolvers, limits and metric labels.
+type MethodNormalizer interface {
+	// NormalizeMethod returns the operation name and true, or false if method is unknown.
+	NormalizeMethod(protocol, method string) (string, bool)
+}
+
+// NormalizerFunc is a function adapter for the MethodNormalizer interface.
+type NormalizerFunc func(protocol, method string) (string, bool)
+
+// NormalizeMethod implements MethodNormalizer.
+func (f NormalizerFunc) NormalizeMethod(protocol, method string) (string, bool) {
+	return f(protocol, method)
+}
+
+// OperationTable is a MethodNormalizer backed by a registered lookup table.
+// Safe for concurrent use.
+//
+// Example:
+//
+//	ops := interceptor.NewOperationTable().
+//	    Register("http", "GET /v1/users/{id}", "users.get").
+//	    Register("grpc", "/users.UserService/GetUser", "users.get")
+type OperationTable struct {
+	mu    sync.RWMutex
+	table map[operationKey]string
+}
+
+type operationKey struct {
+	protocol string
+	method   string
+}
+
+// NewOperationTable creates an empty OperationTable.
+func NewOperationTable() *OperationTable {
+	return &OperationTable{
+		table: make(map[operationKey]string),
+	}
+}
+
+// Register maps protocol + method to operation.
+// Returns *OperationTable to support method chaining.
+func (t *OperationTable) Register(protocol, method, operation string) *OperationTable {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.table[operationKey{protocol: protocol, method: method}] = operation
+	return t
+}
+
+// NormalizeMethod implements MethodNormalizer.
+func (t *OperationTable) NormalizeMethod(protocol, method string) (string, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	operation, ok := t.table[operationKey{protocol: protocol, method: method}]
+	return operation, ok
+}
+
+// normalizeOperation fills ctx.Operation using bridge if it implements MethodNormalizer
+// and the operation is not already set.
+func normalizeOperation[M any](bridge any, ctx *UniversalContext[M]) {
+	if ctx.Operation != "" {
+		return
+	}
+	if normalizer, ok := bridge.(MethodNormalizer); ok {
+		if operation, ok := normalizer.NormalizeMethod(ctx.Protocol, ctx.Method); ok {
+			ctx.Operation = operation
+		}
+	}
+}
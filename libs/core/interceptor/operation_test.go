@@ -0,0 +1,128 @@
+package interceptor
+
+import (
+	"testing"
+)
+
+// operationResolver resolves interceptors keyed by ctx.OperationName()
+type operationResolver struct {
+	byOperation map[string][]Interceptor[MockMeta]
+}
+
+func (r *operationResolver) Resolve(ctx *UniversalContext[MockMeta], handlerKey string) []Interceptor[MockMeta] {
+	return r.byOperation[ctx.OperationName()]
+}
+
+func TestOperationTable_NormalizeMethod(t *testing.T) {
+	ops := NewOperationTable().
+		Register("http", "GET /v1/users/{id}", "users.get").
+		Register("grpc", "/users.UserService/GetUser", "users.get")
+
+	if op, ok := ops.NormalizeMethod("http", "GET /v1/users/{id}"); !ok || op != "users.get" {
+		t.Errorf("Expected users.get for http, got %q (ok=%v)", op, ok)
+	}
+	if op, ok := ops.NormalizeMethod("grpc", "/users.UserService/GetUser"); !ok || op != "users.get" {
+		t.Errorf("Expected users.get for grpc, got %q (ok=%v)", op, ok)
+	}
+	if _, ok := ops.NormalizeMethod("grpc", "GET /v1/users/{id}"); ok {
+		t.Error("Expected mapping to be protocol-specific")
+	}
+}
+
+func TestUniversalContext_OperationName(t *testing.T) {
+	ctx := NewUniversalContext[MockMeta](nil, "http", "GET /", MockMeta{})
+	if ctx.OperationName() != "GET /" {
+		t.Errorf("Expected fallback to Method, got %q", ctx.OperationName())
+	}
+
+	ctx.Operation = "root"
+	if ctx.OperationName() != "root" {
+		t.Errorf("Expected Operation, got %q", ctx.OperationName())
+	}
+}
+
+func TestExecutePipeline_DualProtocolOperation(t *testing.T) {
+	ops := NewOperationTable().
+		Register("http", "GET /v1/users/{id}", "users.get").
+		Register("grpc", "/users.UserService/GetUser", "users.get")
+
+	var calls []string
+	var labels []string
+	recordOp := InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		calls = append(calls, ctx.Protocol+":"+ctx.Method)
+		return next(ctx)
+	})
+	bulkhead := Bulkhead[MockMeta](nil, 10, 0, WithOccupancyHook(func(method string, inUse, limit int) {
+		labels = append(labels, method)
+	}))
+
+	resolver := &operationResolver{byOperation: map[string][]Interceptor[MockMeta]{
+		"users.get": {recordOp, bulkhead},
+	}}
+
+	httpBridge := &BaseBridge[MockMeta, *MockNativeContext]{
+		Protocol:   "http",
+		Normalizer: ops,
+		GetMethodFn: func(nc *MockNativeContext) string {
+			return nc.Method + " " + nc.Path
+		},
+	}
+	grpcBridge := &BaseBridge[MockMeta, *MockNativeContext]{
+		Protocol:   "grpc",
+		Normalizer: ops,
+		GetMethodFn: func(nc *MockNativeContext) string {
+			return nc.Path
+		},
+	}
+
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) {
+		if ctx.Operation != "users.get" {
+			t.Errorf("Expected Operation users.get, got %q", ctx.Operation)
+		}
+		return "ok", nil
+	}
+
+	ExecutePipeline(httpBridge, resolver, &MockNativeContext{Method: "GET", Path: "/v1/users/{id}"}, "users", handler)
+	ExecutePipeline(grpcBridge, resolver, &MockNativeContext{Path: "/users.UserService/GetUser"}, "users", handler)
+
+	expectedCalls := []string{"http:GET /v1/users/{id}", "grpc:/users.UserService/GetUser"}
+	if !equalSlices(calls, expectedCalls) {
+		t.Errorf("Expected both protocols to resolve the same interceptors, got calls %v", calls)
+	}
+
+	for _, label := range labels {
+		if label != "users.get" {
+			t.Errorf("Expected metric label users.get, got %q", label)
+		}
+	}
+	if len(labels) != 4 {
+		t.Errorf("Expected 4 occupancy reports, got %d", len(labels))
+	}
+}
+
+// normalizingBridge is a custom bridge implementing MethodNormalizer directly
+type normalizingBridge struct {
+	BaseBridge[MockMeta, *MockNativeContext]
+}
+
+func (n *normalizingBridge) NormalizeMethod(protocol, method string) (string, bool) {
+	return "custom." + method, true
+}
+
+func TestExecutePipeline_BridgeImplementsNormalizer(t *testing.T) {
+	bridge := &normalizingBridge{}
+	bridge.Protocol = "kafka"
+	bridge.GetMethodFn = func(nc *MockNativeContext) string { return nc.Path }
+
+	var operation string
+	handler := func(ctx *UniversalContext[MockMeta]) (any, error) {
+		operation = ctx.Operation
+		return nil, nil
+	}
+
+	ExecutePipeline[MockMeta, *MockNativeContext](bridge, NewSimpleResolver[MockMeta](), &MockNativeContext{Path: "orders"}, "orders", handler)
+
+	if operation != "custom.orders" {
+		t.Errorf("Expected Operation custom.orders, got %q", operation)
+	}
+}
@@ -0,0 +1,135 @@
+package interceptor
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// parallelArrival is sent once per interceptor, when it calls next or returns without calling it.
+type parallelArrival struct {
+	index int
+	err   error // error returned (or panic raised) before next was called
+}
+
+// Parallel runs independent interceptors concurrently around a single call to next.
+// Each interceptor's pre-next logic starts at the same time; once all of them have
+// reached next, next runs exactly once, then their post-next logic runs concurrently
+// with next's result and error.
+//
+// Only safe for side-effect-only interceptors (metrics, tracing, audit):
+//   - Each interceptor receives its own copy of ctx (see UniversalContext.Derive); changes to it
+//     are not visible to next or to the other interceptors. Install WithMetaClone when Meta
+//     holds maps or pointers the interceptors mutate
+//   - Results returned by the interceptors are ignored; the result of next is returned
+//   - Interceptors must not depend on each other's ordering
+//
+// Errors are aggregated with errors.Join: next's error plus any different error returned
+// by an interceptor. If any interceptor fails (or panics) before calling next, next is
+// skipped and the others see the aggregated error from their next call.
+// A panic in next is re-raised after all interceptors have finished.
+//
+// Example:
+//
+//	pipeline := interceptor.Chain(handler,
+//	    interceptor.Parallel[GinMeta](metricsInterceptor, auditInterceptor),
+//	    authInterceptor,
+//	)
+func Parallel[M any](interceptors ...Interceptor[M]) Interceptor[M] {
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		if len(interceptors) == 0 {
+			return next(ctx)
+		}
+
+		var (
+			wg          sync.WaitGroup
+			arrived     = make(chan parallelArrival, len(interceptors))
+			release     = make(chan struct{})
+			errs        = make([]error, len(interceptors))
+			nextResult  any
+			nextErr     error
+			nextPanic   any
+			nextSkipped bool
+		)
+
+		for i, current := range interceptors {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				reached := false
+				defer func() {
+					if r := recover(); r != nil {
+						errs[i] = fmt.Errorf("panic: %v", r)
+					}
+					if !reached {
+						arrived <- parallelArrival{index: i, err: errs[i]}
+					}
+				}()
+
+				local := ctx.Derive()
+				_, errs[i] = current.Intercept(local, func(*UniversalContext[M]) (any, error) {
+					if !reached {
+						reached = true
+						arrived <- parallelArrival{index: i}
+					}
+					<-release
+					return nextResult, nextErr
+				})
+			}()
+		}
+
+		early := make([]bool, len(interceptors))
+		var preErrs []error
+		for range interceptors {
+			a := <-arrived
+			if a.err != nil {
+				early[a.index] = true
+				preErrs = append(preErrs, a.err)
+			}
+		}
+
+		if len(preErrs) > 0 {
+			nextSkipped = true
+			nextErr = errors.Join(preErrs...)
+			close(release)
+		} else {
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						nextPanic = r
+						nextErr = fmt.Errorf("panic: %v", r)
+					}
+					close(release)
+				}()
+				nextResult, nextErr = next(ctx)
+			}()
+		}
+
+		wg.Wait()
+		if nextPanic != nil {
+			panic(nextPanic)
+		}
+
+		var all []error
+		if nextSkipped {
+			all = append(all, preErrs...)
+		} else if nextErr != nil {
+			all = append(all, nextErr)
+		}
+		for i, err := range errs {
+			if err != nil && !early[i] && err != nextErr {
+				all = append(all, err)
+			}
+		}
+
+		switch len(all) {
+		case 0:
+			return nextResult, nil
+		case 1:
+			return nextResult, all[0]
+		default:
+			return nextResult, errors.Join(all...)
+		}
+	})
+}
@@ -0,0 +1,134 @@
+package interceptor
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// barrierInterceptor blocks before next until every interceptor sharing the barrier has started,
+// so it only completes when the interceptors run concurrently.
+func barrierInterceptor(barrier *sync.WaitGroup, seen *atomic.Int32, postErr error) Interceptor[TestMeta] {
+	return InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		seen.Add(1)
+		barrier.Done()
+
+		done := make(chan struct{})
+		go func() { barrier.Wait(); close(done) }()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			return nil, errors.New("interceptors did not run concurrently")
+		}
+
+		if _, err := next(ctx); err != nil {
+			return nil, err
+		}
+		return nil, postErr
+	})
+}
+
+func TestParallel_BothObserveRequest(t *testing.T) {
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+	var seen atomic.Int32
+
+	handlerCalls := 0
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		handlerCalls++
+		if seen.Load() != 2 {
+			t.Errorf("Expected both interceptors to run before handler, got %d", seen.Load())
+		}
+		return "success", nil
+	}
+
+	parallel := Parallel(barrierInterceptor(&barrier, &seen, nil), barrierInterceptor(&barrier, &seen, nil))
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	result, err := Chain(handler, parallel)(ctx)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != "success" {
+		t.Errorf("Expected 'success', got %v", result)
+	}
+	if handlerCalls != 1 {
+		t.Errorf("Expected handler to run once, ran %d times", handlerCalls)
+	}
+}
+
+func TestParallel_AggregatesErrors(t *testing.T) {
+	var barrier sync.WaitGroup
+	barrier.Add(2)
+	var seen atomic.Int32
+
+	errAudit := errors.New("audit sink down")
+	errMetrics := errors.New("metrics sink down")
+	errHandler := errors.New("handler failed")
+
+	// Verify: Post-next errors from both interceptors are joined with the handler error
+	parallel := Parallel(barrierInterceptor(&barrier, &seen, errAudit), barrierInterceptor(&barrier, &seen, errMetrics))
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	_, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) { return "success", nil }, parallel)(ctx)
+	if !errors.Is(err, errAudit) || !errors.Is(err, errMetrics) {
+		t.Errorf("Expected both interceptor errors, got %v", err)
+	}
+
+	// Verify: A propagated handler error is reported once
+	barrier.Add(2)
+	_, err = Chain(func(ctx *UniversalContext[TestMeta]) (any, error) { return nil, errHandler }, parallel)(ctx)
+	if err != errHandler {
+		t.Errorf("Expected handler error unchanged, got %v", err)
+	}
+}
+
+func TestParallel_ErrorBeforeNextSkipsHandler(t *testing.T) {
+	errDenied := errors.New("denied")
+	failing := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		return nil, errDenied
+	})
+
+	var observed error
+	observer := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		_, observed = next(ctx)
+		return nil, nil
+	})
+
+	handlerCalls := 0
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		handlerCalls++
+		return "success", nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	_, err := Chain(handler, Parallel[TestMeta](failing, observer))(ctx)
+
+	if !errors.Is(err, errDenied) {
+		t.Errorf("Expected errDenied, got %v", err)
+	}
+	if !errors.Is(observed, errDenied) {
+		t.Errorf("Expected other interceptor to see errDenied from next, got %v", observed)
+	}
+	if handlerCalls != 0 {
+		t.Errorf("Expected handler to be skipped, ran %d times", handlerCalls)
+	}
+}
+
+func TestParallel_PanicInInterceptorBecomesError(t *testing.T) {
+	panicking := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		next(ctx)
+		panic("exporter crashed")
+	})
+
+	ctx := NewUniversalContext[TestMeta](nil, "test", "method", TestMeta{})
+	result, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) { return "success", nil }, Parallel[TestMeta](panicking))(ctx)
+
+	if result != "success" {
+		t.Errorf("Expected handler result to be kept, got %v", result)
+	}
+	if err == nil || err.Error() != "panic: exporter crashed" {
+		t.Errorf("Expected panic converted to error, got %v", err)
+	}
+}
@@ -0,0 +1,56 @@
+package interceptor
+
+import (
+	"context"
+	"runtime/pprof"
+	"testing"
+)
+
+func TestPprofLabels_SetsLabels(t *testing.T) {
+	var requestID, method string
+	var requestIDOK bool
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		requestID, requestIDOK = pprof.Label(ctx, "request_id")
+		method, _ = pprof.Label(ctx, "method")
+		return "ok", nil
+	}
+
+	labels := PprofLabels[TestMeta](func(ctx *UniversalContext[TestMeta]) string {
+		return "req-" + ctx.Meta.UserID
+	})
+
+	ctx := NewUniversalContext(context.Background(), "http", "GET /users", TestMeta{UserID: "42"})
+	ctx.Operation = "users.list"
+	result, err := Chain(handler, labels)(ctx)
+
+	if err != nil || result != "ok" {
+		t.Fatalf("Expected handler result, got %v, %v", result, err)
+	}
+	if !requestIDOK || requestID != "req-42" {
+		t.Errorf("Expected request_id=req-42, got %q", requestID)
+	}
+	if method != "users.list" {
+		t.Errorf("Expected method=users.list (operation name), got %q", method)
+	}
+
+	// Verify: Original context is restored after the chain
+	if _, ok := pprof.Label(ctx, "method"); ok {
+		t.Error("Expected labels to be removed from ctx after the chain")
+	}
+}
+
+func TestPprofLabels_OmitsEmptyRequestID(t *testing.T) {
+	var ok bool
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		_, ok = pprof.Label(ctx, "request_id")
+		return nil, nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "GET /users", TestMeta{})
+	Chain(handler, PprofLabels[TestMeta](nil))(ctx)
+
+	if ok {
+		t.Error("Expected no request_id label when extractor is nil")
+	}
+}
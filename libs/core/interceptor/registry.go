@@ -0,0 +1,129 @@
+package interceptor
+
+import (
+	"path"
+	"slices"
+	"sync"
+)
+
+// InterceptorInfo describes one interceptor registered in a Registry.
+// Empty Protocols or Patterns mean the interceptor applies to every protocol or method.
+type InterceptorInfo struct {
+	Name      string
+	Protocols []string
+	Patterns  []string
+}
+
+// ScopeOption limits where a registered interceptor applies.
+type ScopeOption func(*InterceptorInfo)
+
+// OnProtocols limits the interceptor to the given protocols ("http", "grpc", ...).
+func OnProtocols(protocols ...string) ScopeOption {
+	return func(info *InterceptorInfo) {
+		info.Protocols = append(info.Protocols, protocols...)
+	}
+}
+
+// OnPatterns limits the interceptor to methods matching one of the patterns.
+// Patterns use path.Match syntax and are checked against the operation name,
+// the method and the handler key: "GET /users/*", "/admin/*".
+func OnPatterns(patterns ...string) ScopeOption {
+	return func(info *InterceptorInfo) {
+		info.Patterns = append(info.Patterns, patterns...)
+	}
+}
+
+type registryEntry[M any] struct {
+	info        InterceptorInfo
+	interceptor Interceptor[M]
+}
+
+// Registry is an InterceptorResolver whose interceptors are registered with a name and a scope.
+// Resolve returns matching interceptors in registration order; List describes them all,
+// e.g. for an admin endpoint. Safe for concurrent use.
+//
+// Example:
+//
+//	reg := interceptor.NewRegistry[GinMeta]().
+//	    Register("logging", loggingInterceptor).
+//	    Register("auth", authInterceptor, interceptor.OnProtocols("http")).
+//	    Register("rate-limit", rateLimitInterceptor, interceptor.OnPatterns("/api/*"))
+//
+//	interceptor.ExecutePipeline(bridge, reg, c, "/api/users", handler)
+type Registry[M any] struct {
+	mu      sync.RWMutex
+	entries []registryEntry[M]
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry[M any]() *Registry[M] {
+	return &Registry[M]{}
+}
+
+// Register adds a named interceptor. Without scope options it applies to every request.
+// Returns *Registry[M] to support method chaining.
+func (r *Registry[M]) Register(name string, interceptor Interceptor[M], scope ...ScopeOption) *Registry[M] {
+	info := InterceptorInfo{Name: name}
+	for _, opt := range scope {
+		opt(&info)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, registryEntry[M]{info: info, interceptor: interceptor})
+	return r
+}
+
+// Resolve implements InterceptorResolver.
+func (r *Registry[M]) Resolve(ctx *UniversalContext[M], handlerKey string) []Interceptor[M] {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var interceptors []Interceptor[M]
+	for _, entry := range r.entries {
+		if inScope(entry.info, ctx, handlerKey) {
+			interceptors = append(interceptors, entry.interceptor)
+		}
+	}
+	return interceptors
+}
+
+// List returns every registered interceptor in registration order.
+// The returned slice is a copy and can be modified freely.
+func (r *Registry[M]) List() []InterceptorInfo {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	infos := make([]InterceptorInfo, len(r.entries))
+	for i, entry := range r.entries {
+		infos[i] = InterceptorInfo{
+			Name:      entry.info.Name,
+			Protocols: append([]string(nil), entry.info.Protocols...),
+			Patterns:  append([]string(nil), entry.info.Patterns...),
+		}
+	}
+	return infos
+}
+
+// inScope reports whether info's protocols and patterns cover the request.
+func inScope[M any](info InterceptorInfo, ctx *UniversalContext[M], handlerKey string) bool {
+	if len(info.Protocols) > 0 && !slices.Contains(info.Protocols, ctx.Protocol) {
+		return false
+	}
+	if len(info.Patterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range info.Patterns {
+		for _, candidate := range []string{ctx.Operation, ctx.Method, handlerKey} {
+			if candidate == "" {
+				continue
+			}
+			if matched, _ := path.Match(pattern, candidate); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
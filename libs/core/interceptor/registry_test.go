@@ -0,0 +1,79 @@
+package interceptor
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func namedPassThrough(name string, calls *[]string) Interceptor[MockMeta] {
+	return InterceptorFunc[MockMeta](func(ctx *UniversalContext[MockMeta], next NextFunc[MockMeta]) (any, error) {
+		*calls = append(*calls, name)
+		return next(ctx)
+	})
+}
+
+func TestRegistry_ListReportsNameAndScope(t *testing.T) {
+	var calls []string
+	reg := NewRegistry[MockMeta]().
+		Register("logging", namedPassThrough("logging", &calls)).
+		Register("admin-auth", namedPassThrough("admin-auth", &calls), OnProtocols("http"), OnPatterns("/admin/*"))
+
+	expected := []InterceptorInfo{
+		{Name: "logging", Protocols: nil, Patterns: nil},
+		{Name: "admin-auth", Protocols: []string{"http"}, Patterns: []string{"/admin/*"}},
+	}
+	if got := reg.List(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, got)
+	}
+}
+
+func TestRegistry_ListReturnsCopy(t *testing.T) {
+	var calls []string
+	reg := NewRegistry[MockMeta]().Register("auth", namedPassThrough("auth", &calls), OnPatterns("/admin/*"))
+
+	reg.List()[0].Patterns[0] = "*"
+
+	if got := reg.List()[0].Patterns[0]; got != "/admin/*" {
+		t.Errorf("Expected registry to be unaffected by List mutation, got %q", got)
+	}
+}
+
+func TestRegistry_ResolveByScope(t *testing.T) {
+	var calls []string
+	reg := NewRegistry[MockMeta]().
+		Register("logging", namedPassThrough("logging", &calls)).
+		Register("grpc-only", namedPassThrough("grpc-only", &calls), OnProtocols("grpc")).
+		Register("admin-auth", namedPassThrough("admin-auth", &calls), OnPatterns("/admin/*"))
+
+	tests := []struct {
+		protocol, method string
+		expected         []string
+	}{
+		{"http", "/admin/users", []string{"logging", "admin-auth"}},
+		{"http", "/api/users", []string{"logging"}},
+		{"grpc", "/pkg.Svc/Get", []string{"logging", "grpc-only"}},
+	}
+
+	for _, tt := range tests {
+		calls = nil
+		ctx := NewUniversalContext(context.Background(), tt.protocol, tt.method, MockMeta{})
+		handler := Chain(func(ctx *UniversalContext[MockMeta]) (any, error) { return nil, nil }, reg.Resolve(ctx, "")...)
+		if _, err := handler(ctx); err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !reflect.DeepEqual(calls, tt.expected) {
+			t.Errorf("%s %s: expected %v, got %v", tt.protocol, tt.method, tt.expected, calls)
+		}
+	}
+}
+
+func TestRegistry_PatternMatchesHandlerKey(t *testing.T) {
+	var calls []string
+	reg := NewRegistry[MockMeta]().Register("auth", namedPassThrough("auth", &calls), OnPatterns("admin.*"))
+
+	ctx := NewUniversalContext(context.Background(), "http", "", MockMeta{})
+	if got := reg.Resolve(ctx, "admin.users"); len(got) != 1 {
+		t.Errorf("Expected handler key to match pattern, got %d interceptors", len(got))
+	}
+}
@@ -0,0 +1,51 @@
+package interceptor
+
+import "context"
+
+// ScopedValue is a typed key for a value visible only to the sub-chain below the
+// interceptor that sets it (see Scope). Unlike assigning ctx.Context = context.WithValue(...),
+// the value is removed when next returns, so the interceptor's own post-next logic,
+// earlier interceptors and Parallel siblings never see it.
+//
+// Create one per value with NewScopedValue and keep it in a package variable.
+type ScopedValue[T any] struct {
+	name string
+}
+
+// NewScopedValue creates a ScopedValue; name is only used by String.
+//
+// Example:
+//
+//	var tenantDB = interceptor.NewScopedValue[*sql.DB]("tenantDB")
+func NewScopedValue[T any](name string) *ScopedValue[T] {
+	return &ScopedValue[T]{name: name}
+}
+
+// String returns the name given to NewScopedValue.
+func (s *ScopedValue[T]) String() string {
+	return s.name
+}
+
+// Get returns the value set by the innermost enclosing Scope, and false if there is none.
+func (s *ScopedValue[T]) Get(ctx context.Context) (T, bool) {
+	v, ok := ctx.Value(s).(T)
+	return v, ok
+}
+
+// Scope pushes value for key, calls next, and pops it when next returns (or panics),
+// restoring ctx.Context. Nested scopes for the same key shadow the outer value until they return.
+//
+// Example:
+//
+//	func TenantDB(pool *Pool) interceptor.Interceptor[GinMeta] {
+//	    return interceptor.InterceptorFunc[GinMeta](func(ctx *interceptor.UniversalContext[GinMeta], next interceptor.NextFunc[GinMeta]) (any, error) {
+//	        return interceptor.Scope(ctx, tenantDB, pool.For(ctx.Meta.Tenant), next)
+//	    })
+//	}
+func Scope[M, T any](ctx *UniversalContext[M], key *ScopedValue[T], value T, next NextFunc[M]) (any, error) {
+	parent := ctx.Context
+	defer func() { ctx.Context = parent }()
+
+	ctx.Context = context.WithValue(parent, key, value)
+	return next(ctx)
+}
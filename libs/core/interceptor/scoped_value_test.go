@@ -0,0 +1,75 @@
+package interceptor
+
+import (
+	"testing"
+)
+
+var testScoped = NewScopedValue[string]("testScoped")
+
+func TestScope_VisibleDownstreamOnly(t *testing.T) {
+	var seenByNext, seenByInner, seenAfter bool
+
+	scoping := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		result, err := Scope(ctx, testScoped, "tenant-a", next)
+		_, seenAfter = testScoped.Get(ctx)
+		return result, err
+	})
+	inner := InterceptorFunc[TestMeta](func(ctx *UniversalContext[TestMeta], next NextFunc[TestMeta]) (any, error) {
+		v, ok := testScoped.Get(ctx)
+		seenByInner = ok && v == "tenant-a"
+		return next(ctx)
+	})
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		v, ok := testScoped.Get(ctx)
+		seenByNext = ok && v == "tenant-a"
+		return nil, nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "GET /", TestMeta{})
+	if _, err := Chain(handler, scoping, inner)(ctx); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if !seenByInner || !seenByNext {
+		t.Errorf("Expected downstream to see the value (inner=%v, handler=%v)", seenByInner, seenByNext)
+	}
+	if seenAfter {
+		t.Error("Expected the value to be removed after next returns")
+	}
+	if _, ok := testScoped.Get(ctx); ok {
+		t.Error("Expected the caller's context to be restored")
+	}
+}
+
+func TestScope_NestedShadowing(t *testing.T) {
+	ctx := NewUniversalContext[TestMeta](nil, "http", "GET /", TestMeta{})
+
+	var inner, outerAfter string
+	Scope(ctx, testScoped, "outer", func(ctx *UniversalContext[TestMeta]) (any, error) {
+		Scope(ctx, testScoped, "inner", func(ctx *UniversalContext[TestMeta]) (any, error) {
+			inner, _ = testScoped.Get(ctx)
+			return nil, nil
+		})
+		outerAfter, _ = testScoped.Get(ctx)
+		return nil, nil
+	})
+
+	if inner != "inner" || outerAfter != "outer" {
+		t.Errorf("Expected inner shadowing then outer restored, got inner=%q outer=%q", inner, outerAfter)
+	}
+}
+
+func TestScope_PopsOnPanic(t *testing.T) {
+	ctx := NewUniversalContext[TestMeta](nil, "http", "GET /", TestMeta{})
+
+	func() {
+		defer func() { recover() }()
+		Scope(ctx, testScoped, "v", func(*UniversalContext[TestMeta]) (any, error) {
+			panic("boom")
+		})
+	}()
+
+	if _, ok := testScoped.Get(ctx); ok {
+		t.Error("Expected the value to be popped after a panic")
+	}
+}
@@ -0,0 +1,142 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"sync"
+	"time"
+)
+
+// ErrShadowPanic is matched (via errors.Is) by the shadow error passed to ShadowOptions.Compare
+// when the shadow handler panicked.
+var ErrShadowPanic = errors.New("shadow panicked")
+
+// ShadowOptions configures Shadow.
+type ShadowOptions struct {
+	// Percent of requests (0-100) also sent to the shadow handler.
+	Percent float64
+	// Compare receives both outcomes once the primary and the shadow have finished.
+	// It runs on a background goroutine; a panic in it is recovered and ignored.
+	Compare func(primary, shadow any, perr, serr error)
+	// Timeout bounds the shadow handler; when it expires serr is context.DeadlineExceeded.
+	// 0 means no timeout.
+	Timeout time.Duration
+	// Source drives sampling; seed it for deterministic sampling in tests.
+	// nil uses the global math/rand/v2 source.
+	Source rand.Source
+}
+
+// shadowOutcome is the result of one side of a shadowed request.
+type shadowOutcome struct {
+	result any
+	err    error
+}
+
+// Shadow mirrors a sample of requests to shadowHandler, e.g. a replacement implementation,
+// and reports both outcomes to opts.Compare. The shadow's result is always discarded.
+//
+// Behavior:
+//   - The primary (next) runs normally; its result, error and latency are never affected by the shadow
+//   - Sampled requests run shadowHandler on a copy of ctx taken before the primary runs
+//     (see UniversalContext.Derive; install WithMetaClone when Meta holds maps or pointers)
+//   - The shadow's context keeps ctx's values but not its cancellation, so it outlives the request;
+//     opts.Timeout bounds it instead
+//   - Shadow errors and panics (see ErrShadowPanic) only reach opts.Compare
+//   - A primary panic is re-raised; opts.Compare still runs, with a "panic: ..." perr
+//   - A shadow that ignores its context and never returns leaks its goroutine;
+//     Compare is still called with context.DeadlineExceeded when opts.Timeout is set
+//
+// Example:
+//
+//	shadow := interceptor.Shadow[GinMeta](newPricing.Quote, interceptor.ShadowOptions{
+//	    Percent: 5,
+//	    Timeout: 2 * time.Second,
+//	    Compare: func(primary, shadow any, perr, serr error) {
+//	        if !reflect.DeepEqual(primary, shadow) || (perr == nil) != (serr == nil) {
+//	            metrics.Inc("pricing_shadow_mismatch")
+//	        }
+//	    },
+//	})
+func Shadow[M any](shadowHandler NextFunc[M], opts ShadowOptions) Interceptor[M] {
+	sample := shadowSampler(opts.Percent, opts.Source)
+
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		if !sample() {
+			return next(ctx)
+		}
+
+		shadowCtx := ctx.Derive()
+		shadowCtx.Context = context.WithoutCancel(ctx.Context)
+		primary := make(chan shadowOutcome, 1)
+		go runShadow(shadowCtx, shadowHandler, opts, primary)
+
+		defer func() {
+			if r := recover(); r != nil {
+				primary <- shadowOutcome{err: fmt.Errorf("panic: %v", r)}
+				panic(r)
+			}
+		}()
+
+		result, err := next(ctx)
+		primary <- shadowOutcome{result: result, err: err}
+		return result, err
+	})
+}
+
+// runShadow runs shadowHandler within opts.Timeout, waits for the primary outcome
+// and passes both to opts.Compare.
+func runShadow[M any](ctx *UniversalContext[M], shadowHandler NextFunc[M], opts ShadowOptions, primary <-chan shadowOutcome) {
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx.Context, cancel = context.WithTimeout(ctx.Context, opts.Timeout)
+		defer cancel()
+	}
+
+	done := make(chan shadowOutcome, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- shadowOutcome{err: fmt.Errorf("%w: %v", ErrShadowPanic, r)}
+			}
+		}()
+		result, err := shadowHandler(ctx)
+		done <- shadowOutcome{result: result, err: err}
+	}()
+
+	var shadow shadowOutcome
+	select {
+	case shadow = <-done:
+	case <-ctx.Done():
+		shadow = shadowOutcome{err: ctx.Err()}
+	}
+
+	p := <-primary
+	if opts.Compare == nil {
+		return
+	}
+	defer func() { _ = recover() }()
+	opts.Compare(p.result, shadow.result, p.err, shadow.err)
+}
+
+// shadowSampler returns a function reporting whether the next request is shadowed.
+func shadowSampler(percent float64, source rand.Source) func() bool {
+	switch {
+	case percent <= 0:
+		return func() bool { return false }
+	case percent >= 100:
+		return func() bool { return true }
+	case source == nil:
+		return func() bool { return rand.Float64()*100 < percent }
+	}
+
+	// rand.Rand is not safe for concurrent use
+	var mu sync.Mutex
+	r := rand.New(source)
+	return func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return r.Float64()*100 < percent
+	}
+}
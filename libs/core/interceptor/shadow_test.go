@@ -0,0 +1,245 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// shadowComparison is one call of ShadowOptions.Compare
+type shadowComparison struct {
+	primary, shadow any
+	perr, serr      error
+}
+
+// recordCompare returns a Compare callback sending every call to the returned channel
+func recordCompare() (func(primary, shadow any, perr, serr error), chan shadowComparison) {
+	calls := make(chan shadowComparison, 100)
+	return func(primary, shadow any, perr, serr error) {
+		calls <- shadowComparison{primary, shadow, perr, serr}
+	}, calls
+}
+
+func waitComparison(t *testing.T, calls chan shadowComparison) shadowComparison {
+	t.Helper()
+	select {
+	case c := <-calls:
+		return c
+	case <-time.After(time.Second):
+		t.Fatal("Expected Compare to be called")
+		return shadowComparison{}
+	}
+}
+
+func TestShadow_ComparesPrimaryAndShadow(t *testing.T) {
+	compare, calls := recordCompare()
+	shadow := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "new", nil
+	}, ShadowOptions{Percent: 100, Compare: compare})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "old", nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "quote", TestMeta{})
+	result, err := Chain(handler, shadow)(ctx)
+	if err != nil || result != "old" {
+		t.Fatalf("Expected primary result old, got %v, %v", result, err)
+	}
+
+	c := waitComparison(t, calls)
+	if c.primary != "old" || c.shadow != "new" || c.perr != nil || c.serr != nil {
+		t.Errorf("Expected old/new without errors, got %+v", c)
+	}
+}
+
+func TestShadow_SamplingIsDeterministicWithSeededSource(t *testing.T) {
+	sampled := func() []bool {
+		var hits atomic.Int32
+		shadow := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+			return nil, nil
+		}, ShadowOptions{Percent: 30, Source: rand.NewPCG(1, 2), Compare: func(_, _ any, _, _ error) {}})
+
+		var pattern []bool
+		for i := 0; i < 50; i++ {
+			before := hits.Load()
+			handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+				return nil, nil
+			}
+			// The shadow copy is taken synchronously, so Derive marks the sample before Chain returns
+			ctx := NewUniversalContext[TestMeta](nil, "http", "quote", TestMeta{},
+				WithMetaClone(func(m TestMeta) TestMeta {
+					hits.Add(1)
+					return m
+				}))
+			Chain(handler, shadow)(ctx)
+			pattern = append(pattern, hits.Load() > before)
+		}
+		return pattern
+	}
+
+	first, second := sampled(), sampled()
+	count := 0
+	for i := range first {
+		if first[i] != second[i] {
+			t.Fatalf("Expected identical sampling with the same seed, differs at request %d", i)
+		}
+		if first[i] {
+			count++
+		}
+	}
+	if count == 0 || count == len(first) {
+		t.Errorf("Expected some but not all requests sampled at 30%%, got %d of %d", count, len(first))
+	}
+}
+
+func TestShadow_ZeroPercentNeverShadows(t *testing.T) {
+	var calls atomic.Int32
+	shadow := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+		calls.Add(1)
+		return nil, nil
+	}, ShadowOptions{Percent: 0})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "ok", nil
+	}
+	for i := 0; i < 20; i++ {
+		ctx := NewUniversalContext[TestMeta](nil, "http", "quote", TestMeta{})
+		Chain(handler, shadow)(ctx)
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	if calls.Load() != 0 {
+		t.Errorf("Expected no shadow calls at 0%%, got %d", calls.Load())
+	}
+}
+
+func TestShadow_HangingShadowDoesNotBlockPrimary(t *testing.T) {
+	compare, calls := recordCompare()
+	release := make(chan struct{})
+	defer close(release)
+
+	shadow := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+		<-release // ignores its context
+		return nil, nil
+	}, ShadowOptions{Percent: 100, Timeout: 200 * time.Millisecond, Compare: compare})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "old", nil
+	}
+
+	start := time.Now()
+	ctx := NewUniversalContext[TestMeta](nil, "http", "quote", TestMeta{})
+	result, err := Chain(handler, shadow)(ctx)
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("Expected primary to return without waiting for the shadow, took %v", elapsed)
+	}
+	if err != nil || result != "old" {
+		t.Fatalf("Expected primary result old, got %v, %v", result, err)
+	}
+
+	c := waitComparison(t, calls)
+	if !errors.Is(c.serr, context.DeadlineExceeded) {
+		t.Errorf("Expected shadow timeout, got %v", c.serr)
+	}
+	if c.primary != "old" {
+		t.Errorf("Expected primary result in comparison, got %v", c.primary)
+	}
+}
+
+func TestShadow_ShadowOutlivesPrimaryCancellation(t *testing.T) {
+	compare, calls := recordCompare()
+	shadow := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+		time.Sleep(10 * time.Millisecond)
+		return "new", ctx.Err()
+	}, ShadowOptions{Percent: 100, Compare: compare})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "old", nil
+	}
+
+	base, cancel := context.WithCancel(context.Background())
+	ctx := NewUniversalContext[TestMeta](base, "http", "quote", TestMeta{})
+	Chain(handler, shadow)(ctx)
+	cancel() // request finished
+
+	if c := waitComparison(t, calls); c.serr != nil || c.shadow != "new" {
+		t.Errorf("Expected shadow to finish after the request was cancelled, got %+v", c)
+	}
+}
+
+func TestShadow_ErrorsAndPanicsReachCompareOnly(t *testing.T) {
+	compare, calls := recordCompare()
+	shadowErr := errors.New("shadow failed")
+
+	failing := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return nil, shadowErr
+	}, ShadowOptions{Percent: 100, Compare: compare})
+	panicking := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+		panic("boom")
+	}, ShadowOptions{Percent: 100, Compare: compare})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "old", nil
+	}
+
+	for _, tc := range []struct {
+		shadow Interceptor[TestMeta]
+		want   error
+	}{{failing, shadowErr}, {panicking, ErrShadowPanic}} {
+		ctx := NewUniversalContext[TestMeta](nil, "http", "quote", TestMeta{})
+		if result, err := Chain(handler, tc.shadow)(ctx); err != nil || result != "old" {
+			t.Errorf("Expected primary result old, got %v, %v", result, err)
+		}
+		if c := waitComparison(t, calls); !errors.Is(c.serr, tc.want) {
+			t.Errorf("Expected %v in comparison, got %v", tc.want, c.serr)
+		}
+	}
+}
+
+func TestShadow_ShadowSeesRequestBeforePrimaryChangesIt(t *testing.T) {
+	compare, calls := recordCompare()
+	shadow := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return ctx.Meta.Role, nil
+	}, ShadowOptions{Percent: 100, Compare: compare})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		ctx.Meta.Role = "changed"
+		return ctx.Meta.Role, nil
+	}
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "quote", TestMeta{Role: "user"})
+	Chain(handler, shadow)(ctx)
+
+	if c := waitComparison(t, calls); c.shadow != "user" || c.primary != "changed" {
+		t.Errorf("Expected shadow to see the original request, got %+v", c)
+	}
+}
+
+func TestShadow_PrimaryPanicIsReraisedAndCompared(t *testing.T) {
+	compare, calls := recordCompare()
+	shadow := Shadow[TestMeta](func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "new", nil
+	}, ShadowOptions{Percent: 100, Compare: compare})
+
+	handler := func(ctx *UniversalContext[TestMeta]) (any, error) {
+		panic("primary boom")
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r != "primary boom" {
+				t.Errorf("Expected primary panic to be re-raised, got %v", r)
+			}
+		}()
+		ctx := NewUniversalContext[TestMeta](nil, "http", "quote", TestMeta{})
+		Chain(handler, shadow)(ctx)
+	}()
+
+	if c := waitComparison(t, calls); c.perr == nil || c.shadow != "new" {
+		t.Errorf("Expected primary panic error and shadow result, got %+v", c)
+	}
+}
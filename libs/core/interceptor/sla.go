@@ -0,0 +1,33 @@
+package interceptor
+
+import "time"
+
+// SLAInterceptor times next and calls onBreach when it takes longer than threshold,
+// so slow requests can be alerted on. The request itself is not affected:
+// its result and error are returned unchanged, however long it took.
+//
+// Behavior:
+//   - onBreach runs synchronously after next returns, once per slow request, with the elapsed time
+//   - Requests that fail are timed too; requests that panic are not
+//   - threshold <= 0 disables the check
+//
+// Example:
+//
+//	sla := interceptor.SLAInterceptor[GinMeta](500*time.Millisecond, func(ctx *interceptor.UniversalContext[GinMeta], elapsed time.Duration) {
+//	    logger.Warnw("slow request", "method", ctx.OperationName(), "elapsed", elapsed)
+//	    metrics.Inc("sla_breaches_total", "method", ctx.OperationName())
+//	})
+func SLAInterceptor[M any](threshold time.Duration, onBreach func(ctx *UniversalContext[M], elapsed time.Duration)) Interceptor[M] {
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		if threshold <= 0 || onBreach == nil {
+			return next(ctx)
+		}
+
+		start := time.Now()
+		result, err := next(ctx)
+		if elapsed := time.Since(start); elapsed > threshold {
+			onBreach(ctx, elapsed)
+		}
+		return result, err
+	})
+}
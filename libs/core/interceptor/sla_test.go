@@ -0,0 +1,73 @@
+package interceptor
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSLAInterceptor_SlowHandlerBreaches(t *testing.T) {
+	var breaches []time.Duration
+	var breachedMethod string
+	sla := SLAInterceptor[TestMeta](10*time.Millisecond, func(ctx *UniversalContext[TestMeta], elapsed time.Duration) {
+		breaches = append(breaches, elapsed)
+		breachedMethod = ctx.Method
+	})
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "GET /reports", TestMeta{})
+	result, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) {
+		time.Sleep(30 * time.Millisecond)
+		return "report", nil
+	}, sla)(ctx)
+
+	if err != nil || result != "report" {
+		t.Fatalf("Expected request to succeed despite the breach, got %v, %v", result, err)
+	}
+	if len(breaches) != 1 {
+		t.Fatalf("Expected onBreach once, got %d calls", len(breaches))
+	}
+	if breaches[0] < 30*time.Millisecond {
+		t.Errorf("Expected elapsed >= 30ms, got %v", breaches[0])
+	}
+	if breachedMethod != "GET /reports" {
+		t.Errorf("Expected breach for GET /reports, got %q", breachedMethod)
+	}
+}
+
+func TestSLAInterceptor_FastHandlerDoesNotBreach(t *testing.T) {
+	called := false
+	sla := SLAInterceptor[TestMeta](time.Second, func(ctx *UniversalContext[TestMeta], elapsed time.Duration) {
+		called = true
+	})
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "GET /health", TestMeta{})
+	if _, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) {
+		return "ok", nil
+	}, sla)(ctx); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if called {
+		t.Error("Expected onBreach not to be called for a fast handler")
+	}
+}
+
+func TestSLAInterceptor_ErrorPassesThrough(t *testing.T) {
+	handlerErr := errors.New("upstream failed")
+	breaches := 0
+	sla := SLAInterceptor[TestMeta](time.Millisecond, func(ctx *UniversalContext[TestMeta], elapsed time.Duration) {
+		breaches++
+	})
+
+	ctx := NewUniversalContext[TestMeta](nil, "http", "GET /reports", TestMeta{})
+	_, err := Chain(func(ctx *UniversalContext[TestMeta]) (any, error) {
+		time.Sleep(5 * time.Millisecond)
+		return nil, handlerErr
+	}, sla)(ctx)
+
+	if !errors.Is(err, handlerErr) {
+		t.Errorf("Expected handler error unchanged, got %v", err)
+	}
+	if breaches != 1 {
+		t.Errorf("Expected slow failing request to breach once, got %d", breaches)
+	}
+}
@@ -0,0 +1,38 @@
+package interceptor
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidRequest is matched (via errors.Is) by every error returned by ValidateInterceptor.
+// Bridges can map it to 400 Bad Request / INVALID_ARGUMENT.
+var ErrInvalidRequest = errors.New("invalid request")
+
+// Validator checks a value. It has the same shape as the config library's core.Validator[T],
+// so config validators (including composite and named ones) can be passed to ValidateInterceptor as is.
+type Validator[T any] interface {
+	Validate(*T) error
+}
+
+// ValidateInterceptor validates a value projected from ctx.Meta before calling next,
+// so request structs can reuse the validation rules already written for config.
+//
+// A validation failure skips next and returns an InterceptorError named "validate"
+// that wraps both ErrInvalidRequest and the validator's error.
+//
+// Example:
+//
+//	// limitsValidator also validates the Limits section of the service config
+//	validate := interceptor.ValidateInterceptor[GinMeta](limitsValidator, func(m GinMeta) Limits {
+//	    return m.Request.Limits
+//	})
+func ValidateInterceptor[M, T any](validator Validator[T], project func(meta M) T) Interceptor[M] {
+	return InterceptorFunc[M](func(ctx *UniversalContext[M], next NextFunc[M]) (any, error) {
+		value := project(ctx.Meta)
+		if err := validator.Validate(&value); err != nil {
+			return nil, NewInterceptorError("validate", fmt.Errorf("%w: %w", ErrInvalidRequest, err))
+		}
+		return next(ctx)
+	})
+}
@@ -0,0 +1,95 @@
+package interceptor
+
+import (
+	"errors"
+	"testing"
+)
+
+type pageRequest struct {
+	Limit int
+}
+
+// limitValidator has the shape of a config validator shared with request validation.
+type limitValidator struct {
+	max int
+}
+
+func (v limitValidator) Validate(p *pageRequest) error {
+	if p.Limit < 1 || p.Limit > v.max {
+		return errors.New("limit out of range")
+	}
+	return nil
+}
+
+// pageMeta carries the per-request struct.
+type pageMeta struct {
+	Page pageRequest
+}
+
+func TestValidateInterceptor_RejectsInvalidProjection(t *testing.T) {
+	validate := ValidateInterceptor[pageMeta](limitValidator{max: 100}, func(m pageMeta) pageRequest {
+		return m.Page
+	})
+
+	called := false
+	handler := func(ctx *UniversalContext[pageMeta]) (any, error) {
+		called = true
+		return "ok", nil
+	}
+
+	ctx := NewUniversalContext(nil, "http", "GET /items", pageMeta{Page: pageRequest{Limit: 500}})
+	_, err := Chain(handler, validate)(ctx)
+
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("Expected ErrInvalidRequest, got %v", err)
+	}
+	var ie *InterceptorError
+	if !errors.As(err, &ie) || ie.InterceptorName != "validate" {
+		t.Errorf("Expected InterceptorError named validate, got %v", err)
+	}
+	if called {
+		t.Error("Expected handler to be skipped")
+	}
+}
+
+func TestValidateInterceptor_PassesValidProjection(t *testing.T) {
+	validate := ValidateInterceptor[pageMeta](limitValidator{max: 100}, func(m pageMeta) pageRequest {
+		return m.Page
+	})
+
+	handler := func(ctx *UniversalContext[pageMeta]) (any, error) {
+		return "ok", nil
+	}
+
+	ctx := NewUniversalContext(nil, "http", "GET /items", pageMeta{Page: pageRequest{Limit: 20}})
+	result, err := Chain(handler, validate)(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result != "ok" {
+		t.Errorf("Expected handler result, got %v", result)
+	}
+}
+
+func TestValidateInterceptor_PipelineError(t *testing.T) {
+	validate := ValidateInterceptor[pageMeta](limitValidator{max: 100}, func(m pageMeta) pageRequest {
+		return m.Page
+	})
+
+	var bridgeErr error
+	bridge := &BaseBridge[pageMeta, *pageRequest]{
+		Protocol:      "http",
+		ExtractMetaFn: func(p *pageRequest) pageMeta { return pageMeta{Page: *p} },
+		OnErrorFn:     func(_ *pageRequest, err error) { bridgeErr = err },
+	}
+
+	_, err := ExecutePipeline[pageMeta](bridge, NewSimpleResolver(validate), &pageRequest{Limit: 0}, "list",
+		func(ctx *UniversalContext[pageMeta]) (any, error) { return "ok", nil })
+
+	if !errors.Is(err, ErrInvalidRequest) {
+		t.Fatalf("Expected ErrInvalidRequest from pipeline, got %v", err)
+	}
+	if !errors.Is(bridgeErr, ErrInvalidRequest) {
+		t.Errorf("Expected bridge OnError to receive ErrInvalidRequest, got %v", bridgeErr)
+	}
+}
@@ -0,0 +1,103 @@
+package interceptor
+
+import (
+	"math"
+	"sync/atomic"
+	"time"
+)
+
+// WarmupOption configures NewWarmup.
+type WarmupOption func(*WarmupController)
+
+// WarmupFromCreation starts the warm-up window when the controller is created (process start)
+// instead of at the first request.
+func WarmupFromCreation() WarmupOption {
+	return func(w *WarmupController) {
+		w.Start()
+	}
+}
+
+// WarmupController is a time-boxed warm-up window shared by interceptors that relax their limits
+// right after a deploy, while caches are cold (see WithWarmup).
+// Create with NewWarmup. Safe for concurrent use.
+type WarmupController struct {
+	duration time.Duration
+	clock    func() time.Time
+	started  atomic.Pointer[time.Time] // when the window started; nil until Start
+}
+
+// NewWarmup creates a warm-up window of duration. It starts at the first request handled by an
+// interceptor using it (see Start and WarmupFromCreation). clock replaces time.Now, e.g. with a
+// fake clock in tests; nil uses time.Now. duration <= 0 disables the warm-up.
+//
+// Example:
+//
+//	warmup := interceptor.NewWarmup(2*time.Minute, nil)
+//	bulkhead := interceptor.Bulkhead[GinMeta](limits, 50, 100*time.Millisecond,
+//	    interceptor.WithWarmup(warmup, 3, 1), // 3x the limits at first, normal after 2 minutes
+//	)
+func NewWarmup(duration time.Duration, clock func() time.Time, opts ...WarmupOption) *WarmupController {
+	if clock == nil {
+		clock = time.Now
+	}
+	w := &WarmupController{duration: duration, clock: clock}
+	for _, opt := range opts {
+		opt(w)
+	}
+	return w
+}
+
+// Start starts the window now unless it has already started. Interceptors call it on every request.
+func (w *WarmupController) Start() {
+	if w.started.Load() == nil {
+		now := w.clock()
+		w.started.CompareAndSwap(nil, &now)
+	}
+}
+
+// Active reports whether the warm-up is not over yet, including before it has started.
+func (w *WarmupController) Active() bool {
+	return w.Progress() < 1
+}
+
+// Progress returns how far the window has run, from 0 (not started or just started) to 1 (over).
+func (w *WarmupController) Progress() float64 {
+	if w.duration <= 0 {
+		return 1
+	}
+	started := w.started.Load()
+	if started == nil {
+		return 0
+	}
+	elapsed := w.clock().Sub(*started)
+	return math.Min(math.Max(float64(elapsed)/float64(w.duration), 0), 1)
+}
+
+// Factor returns the multiplier for the current point of the window, moving linearly from
+// start to end: start before and at the beginning of the window, end once it is over.
+func (w *WarmupController) Factor(start, end float64) float64 {
+	return start + (end-start)*w.Progress()
+}
+
+// warmupScale scales a limit by a WarmupController's factor. The zero value leaves limits unchanged.
+type warmupScale struct {
+	controller  *WarmupController
+	factorStart float64
+	factorEnd   float64
+}
+
+// begin starts the window at the first request.
+func (s warmupScale) begin() {
+	if s.controller != nil {
+		s.controller.Start()
+	}
+}
+
+// apply returns limit scaled by the current factor, rounded up and at least 1.
+func (s warmupScale) apply(limit int) int {
+	if s.controller == nil || limit <= 0 {
+		return limit
+	}
+	scaled := int(math.Ceil(float64(limit) * s.controller.Factor(s.factorStart, s.factorEnd)))
+	return max(scaled, 1)
+}
@@ -0,0 +1,82 @@
+package interceptor
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWarmup_StartsAtFirstRequest(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	w := NewWarmup(time.Minute, clock.now)
+
+	clock.advance(time.Hour) // process has been up for a while before the first request
+	if !w.Active() || w.Progress() != 0 {
+		t.Fatalf("Expected warm-up pending before Start, got active=%v progress=%v", w.Active(), w.Progress())
+	}
+
+	w.Start()
+	clock.advance(30 * time.Second)
+	w.Start() // later requests do not restart the window
+	if got := w.Factor(3, 1); got != 2 {
+		t.Errorf("Expected factor 2 halfway through, got %v", got)
+	}
+
+	clock.advance(30 * time.Second)
+	if w.Active() || w.Factor(3, 1) != 1 {
+		t.Errorf("Expected warm-up over with factor 1, got active=%v factor=%v", w.Active(), w.Factor(3, 1))
+	}
+}
+
+func TestWarmup_FromCreation(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	w := NewWarmup(time.Minute, clock.now, WarmupFromCreation())
+
+	clock.advance(time.Minute)
+	w.Start()
+	if w.Active() {
+		t.Error("Expected the window to run from creation, not from the first Start")
+	}
+}
+
+func TestWarmup_StartsAtUnixEpoch(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(0, 0)}
+	w := NewWarmup(time.Minute, clock.now)
+
+	w.Start()
+	clock.advance(30 * time.Second)
+	if got := w.Progress(); got != 0.5 {
+		t.Errorf("Expected a window started at Unix 0 to be halfway through, got progress %v", got)
+	}
+}
+
+func TestWarmup_Disabled(t *testing.T) {
+	w := NewWarmup(0, nil)
+	if w.Active() || w.Factor(3, 1) != 1 {
+		t.Errorf("Expected a zero duration to disable warm-up, got active=%v factor=%v", w.Active(), w.Factor(3, 1))
+	}
+}
+
+func TestWarmup_ConcurrentReads(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1700000000, 0)}
+	w := NewWarmup(time.Minute, clock.now)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				w.Start()
+				_ = w.Active()
+				_ = w.Factor(3, 1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	clock.advance(15 * time.Second)
+	if got := w.Progress(); got != 0.25 {
+		t.Errorf("Expected the window started once at the first Start, got progress %v", got)
+	}
+}
@@ -2,24 +2,51 @@ package greetings
 
 import "fmt"
 
-// Hello returns a greeting message for the given name
-func Hello(name string) string {
-	if name == "" {
-		name = "World"
+// DefaultName is used in place of an empty name
+const DefaultName = "World"
+
+// Greeter builds greeting messages
+// Create with NewGreeter
+type Greeter struct {
+	defaultName string
+}
+
+// Option configures a Greeter
+type Option func(*Greeter)
+
+// WithDefaultName sets the name used when Hello or Goodbye gets an empty name
+// An empty defaultName keeps DefaultName
+func WithDefaultName(name string) Option {
+	return func(g *Greeter) {
+		if name != "" {
+			g.defaultName = name
+		}
 	}
-	return fmt.Sprintf("Hello, %s!", name)
 }
 
-// Goodbye returns a goodbye message for the given name
-func Goodbye(name string) string {
-	if name == "" {
-		name = "World"
+// NewGreeter returns a Greeter configured by opts
+func NewGreeter(opts ...Option) *Greeter {
+	g := &Greeter{defaultName: DefaultName}
+	for _, opt := range opts {
+		opt(g)
 	}
-	return fmt.Sprintf("Goodbye, %s!", name)
+	return g
+}
+
+var defaultGreeter = NewGreeter()
+
+// Hello returns a greeting message for the given name
+func (g *Greeter) Hello(name string) string {
+	return fmt.Sprintf("Hello, %s!", g.name(name))
+}
+
+// Goodbye returns a goodbye message for the given name
+func (g *Greeter) Goodbye(name string) string {
+	return fmt.Sprintf("Goodbye, %s!", g.name(name))
 }
 
 // Welcome returns a welcome message for multiple names
-func Welcome(names ...string) string {
+func (g *Greeter) Welcome(names ...string) string {
 	if len(names) == 0 {
 		return "Welcome, everyone!"
 	}
@@ -37,3 +64,26 @@ func Welcome(names ...string) string {
 	}
 	return message + "!"
 }
+
+// name returns name, or the default name if it is empty
+func (g *Greeter) name(name string) string {
+	if name == "" {
+		return g.defaultName
+	}
+	return name
+}
+
+// Hello returns a greeting message for the given name
+func Hello(name string) string {
+	return defaultGreeter.Hello(name)
+}
+
+// Goodbye returns a goodbye message for the given name
+func Goodbye(name string) string {
+	return defaultGreeter.Goodbye(name)
+}
+
+// Welcome returns a welcome message for multiple names
+func Welcome(names ...string) string {
+	return defaultGreeter.Welcome(names...)
+}
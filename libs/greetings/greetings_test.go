@@ -0,0 +1,32 @@
+package greetings
+
+import "testing"
+
+func TestGreeter_WithDefaultName(t *testing.T) {
+	g := NewGreeter(WithDefaultName("Stranger"))
+
+	if got := g.Hello(""); got != "Hello, Stranger!" {
+		t.Errorf("Hello(\"\") = %q; want %q", got, "Hello, Stranger!")
+	}
+	if got := g.Goodbye(""); got != "Goodbye, Stranger!" {
+		t.Errorf("Goodbye(\"\") = %q; want %q", got, "Goodbye, Stranger!")
+	}
+	if got := g.Hello("Alice"); got != "Hello, Alice!" {
+		t.Errorf("Hello(\"Alice\") = %q; want %q", got, "Hello, Alice!")
+	}
+}
+
+func TestPackageFunctions_DefaultToWorld(t *testing.T) {
+	// Other Greeters don't change the package-level default
+	NewGreeter(WithDefaultName("Stranger"))
+
+	if got := Hello(""); got != "Hello, World!" {
+		t.Errorf("Hello(\"\") = %q; want %q", got, "Hello, World!")
+	}
+	if got := Goodbye(""); got != "Goodbye, World!" {
+		t.Errorf("Goodbye(\"\") = %q; want %q", got, "Goodbye, World!")
+	}
+	if got := NewGreeter(WithDefaultName("")).Hello(""); got != "Hello, World!" {
+		t.Errorf("empty WithDefaultName: Hello(\"\") = %q; want %q", got, "Hello, World!")
+	}
+}
@@ -3,6 +3,7 @@ package zap
 import (
 	"github.com/phongthien99/monorepo-lib/libs/log/core"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
 )
 
 // zapAdapter wraps zap.SugaredLogger to implement our ISugaredLogger interface
@@ -11,20 +12,27 @@ type zapAdapter struct {
 	level  core.Level
 }
 
+var _ core.ISugaredLogger = (*zapAdapter)(nil)
+
 // NewZapAdapter creates a new adapter that wraps zap.SugaredLogger
+// Fatal entries exit through core.Exit so tests can capture them with core.CaptureFatal
 func NewZapAdapter(zapLogger *zap.SugaredLogger, level core.Level) core.ISugaredLogger {
 	return &zapAdapter{
-		logger: zapLogger,
+		logger: zapLogger.WithOptions(zap.WithFatalHook(exitHook{})),
 		level:  level,
 	}
 }
 
 // NewZapAdapterFromLogger creates a new adapter from zap.Logger
 func NewZapAdapterFromLogger(zapLogger *zap.Logger, level core.Level) core.ISugaredLogger {
-	return &zapAdapter{
-		logger: zapLogger.Sugar(),
-		level:  level,
-	}
+	return NewZapAdapter(zapLogger.Sugar(), level)
+}
+
+// exitHook routes zap's Fatal path through core.Exit instead of calling os.Exit directly
+type exitHook struct{}
+
+func (exitHook) OnWrite(*zapcore.CheckedEntry, []zapcore.Field) {
+	core.Exit(1)
 }
 
 // IBasicLogger implementation
@@ -89,38 +97,38 @@ func (z *zapAdapter) Logf(level core.Level, template string, args ...any) {
 	z.logger.Logf(coreToZapLevel(level), template, args...)
 }
 
-// IStructuredLogger implementation
+// IStructuredLogger implementation - core.Lazy values are resolved at encode time (see lazyFields)
 func (z *zapAdapter) Debugw(msg string, keysAndValues ...any) {
-	z.logger.Debugw(msg, keysAndValues...)
+	z.logger.Debugw(msg, lazyFields(keysAndValues)...)
 }
 
 func (z *zapAdapter) Infow(msg string, keysAndValues ...any) {
-	z.logger.Infow(msg, keysAndValues...)
+	z.logger.Infow(msg, lazyFields(keysAndValues)...)
 }
 
 func (z *zapAdapter) Warnw(msg string, keysAndValues ...any) {
-	z.logger.Warnw(msg, keysAndValues...)
+	z.logger.Warnw(msg, lazyFields(keysAndValues)...)
 }
 
 func (z *zapAdapter) Errorw(msg string, keysAndValues ...any) {
-	z.logger.Errorw(msg, keysAndValues...)
+	z.logger.Errorw(msg, lazyFields(keysAndValues)...)
 }
 
 func (z *zapAdapter) DPanicw(msg string, keysAndValues ...any) {
-	z.logger.DPanicw(msg, keysAndValues...)
+	z.logger.DPanicw(msg, lazyFields(keysAndValues)...)
 }
 
 func (z *zapAdapter) Panicw(msg string, keysAndValues ...any) {
-	z.logger.Panicw(msg, keysAndValues...)
+	z.logger.Panicw(msg, lazyFields(keysAndValues)...)
 }
 
 func (z *zapAdapter) Fatalw(msg string, keysAndValues ...any) {
-	z.logger.Fatalw(msg, keysAndValues...)
+	z.logger.Fatalw(msg, lazyFields(keysAndValues)...)
 }
 
 func (z *zapAdapter) Logw(level core.Level, msg string, keysAndValues ...any) {
 
-	z.logger.Logw(coreToZapLevel(level), msg, keysAndValues...)
+	z.logger.Logw(coreToZapLevel(level), msg, lazyFields(keysAndValues)...)
 }
 
 // ILineLogger implementation
@@ -159,14 +167,14 @@ func (z *zapAdapter) Logln(level core.Level, args ...any) {
 // IContextualLogger implementation
 func (z *zapAdapter) With(args ...any) core.ISugaredLogger {
 	return &zapAdapter{
-		logger: z.logger.With(args...),
+		logger: z.logger.With(lazyFields(args)...),
 		level:  z.level,
 	}
 }
 
 func (z *zapAdapter) WithLazy(args ...any) core.ISugaredLogger {
 	return &zapAdapter{
-		logger: z.logger.WithLazy(args...),
+		logger: z.logger.WithLazy(lazyFields(args)...),
 		level:  z.level,
 	}
 }
@@ -219,3 +219,34 @@ func BenchmarkZapAdapter_Infow(b *testing.B) {
 		logger.Infow("benchmark message", "iteration", i)
 	}
 }
+
+func TestZapAdapter_FatalIsCapturable(t *testing.T) {
+	logger := NewNop()
+	reached := false
+
+	code, exited := core.CaptureExit(func() {
+		logger.Fatal("cannot continue")
+		reached = true
+	})
+
+	if !exited || code != 1 {
+		t.Errorf("Expected Fatal to exit with code 1, got exited=%v code=%d", exited, code)
+	}
+	if reached {
+		t.Error("Expected code after Fatal not to run")
+	}
+
+	// Every Fatal variant routes through the captured exit
+	fatals := map[string]func(){
+		"Fatalf":  func() { logger.Fatalf("fatal: %s", "x") },
+		"Fatalw":  func() { logger.Fatalw("fatal", "key", "value") },
+		"Fatalln": func() { logger.Fatalln("fatal") },
+		"Logf":    func() { logger.Logf(core.FatalLevel, "fatal") },
+		"With":    func() { logger.With("key", "value").Fatal("fatal") },
+	}
+	for name, fn := range fatals {
+		if !core.CaptureFatal(fn) {
+			t.Errorf("%s: expected captured exit", name)
+		}
+	}
+}
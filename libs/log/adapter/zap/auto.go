@@ -0,0 +1,34 @@
+package zap
+
+import (
+	"os"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// isTerminal reports whether f is an interactive terminal. Swapped in tests.
+var isTerminal = func(f *os.File) bool {
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
+
+// NewAuto creates a logger suited to where stdout goes: DevelopmentConfig (console, debug)
+// when stdout is a terminal, DefaultConfig (JSON, info) when it is piped or redirected,
+// as in containers and CI. Options are applied on top of the chosen config.
+//
+// Example:
+//
+//	logger, _ := zap.NewAuto(zap.WithServiceInfo(info))
+func NewAuto(opts ...Option) (core.ISugaredLogger, error) {
+	cfg := DefaultConfig()
+	if isTerminal(os.Stdout) {
+		cfg = DevelopmentConfig()
+	}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return NewWithConfig(cfg)
+}
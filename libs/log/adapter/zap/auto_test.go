@@ -0,0 +1,75 @@
+package zap
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// withTerminal makes isTerminal report tty for the duration of the test
+func withTerminal(t *testing.T, tty bool) {
+	t.Helper()
+	original := isTerminal
+	isTerminal = func(*os.File) bool { return tty }
+	t.Cleanup(func() { isTerminal = original })
+}
+
+func TestNewAuto_Terminal(t *testing.T) {
+	withTerminal(t, true)
+
+	var buf bytes.Buffer
+	logger, err := NewAuto(WithWriter(&buf))
+	if err != nil {
+		t.Fatalf("NewAuto() error = %v", err)
+	}
+	if logger.Level() != core.DebugLevel {
+		t.Errorf("Expected debug level on a terminal, got %v", logger.Level())
+	}
+
+	logger.Debug("hello")
+	logger.Sync()
+	if line := buf.String(); line == "" || json.Valid(bytes.TrimSpace(buf.Bytes())) || !strings.Contains(line, "hello") {
+		t.Errorf("Expected a console entry, got %q", line)
+	}
+}
+
+func TestNewAuto_Piped(t *testing.T) {
+	withTerminal(t, false)
+
+	var buf bytes.Buffer
+	logger, err := NewAuto(WithWriter(&buf))
+	if err != nil {
+		t.Fatalf("NewAuto() error = %v", err)
+	}
+	if logger.Level() != core.InfoLevel {
+		t.Errorf("Expected info level when piped, got %v", logger.Level())
+	}
+
+	logger.Debug("hidden")
+	logger.Info("hello")
+	logger.Sync()
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Expected one JSON entry, got %q: %v", buf.String(), err)
+	}
+	if entry["msg"] != "hello" {
+		t.Errorf("Expected msg=hello, got %v", entry["msg"])
+	}
+}
+
+func TestNewAuto_OptionsOverride(t *testing.T) {
+	withTerminal(t, true)
+
+	logger, err := NewAuto(WithWriter(&bytes.Buffer{}), WithLevel(core.WarnLevel))
+	if err != nil {
+		t.Fatalf("NewAuto() error = %v", err)
+	}
+	if logger.Level() != core.WarnLevel {
+		t.Errorf("Expected options to override the auto level, got %v", logger.Level())
+	}
+}
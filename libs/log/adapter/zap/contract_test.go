@@ -0,0 +1,62 @@
+package zap
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/contract"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// produceEntries logs at every non-fatal level through root, derived and named loggers
+func produceEntries(l core.ISugaredLogger) {
+	l.Debug("debug")
+	l.Infow("order placed", "order_id", 42, "paid", true)
+	l.Warnf("retry %d", 2)
+	l.With("request_id", "r-1").Errorw("failed", "error", errors.New("boom"))
+	l.Named("db").Infoln("connected")
+}
+
+// newContractLogger returns a constructor for a debug JSON logger with service metadata
+func newContractLogger(opts ...Option) func(w io.Writer) (core.ISugaredLogger, error) {
+	return func(w io.Writer) (core.ISugaredLogger, error) {
+		base := []Option{
+			WithWriter(w),
+			WithLevel(core.DebugLevel),
+			WithServiceInfo(ServiceInfo{Name: "orders", Version: "1.2.3", Commit: "abc123", Env: "staging", Instance: "orders-0"}),
+		}
+		return NewWithOptions(append(base, opts...)...)
+	}
+}
+
+func TestContract_DefaultFieldNames(t *testing.T) {
+	p := contract.DefaultProfile()
+	contract.Verify(t, produceEntries, p.Required, p.Format(newContractLogger()))
+}
+
+func TestContract_ECSFieldNames(t *testing.T) {
+	p := contract.ECSProfile()
+	contract.Verify(t, produceEntries, p.Required, p.Format(newContractLogger(WithFieldNames(ECSFieldNames()))))
+}
+
+func TestContract_WithTruncation(t *testing.T) {
+	p := contract.DefaultProfile()
+	contract.Verify(t, produceEntries, p.Required, p.Format(newContractLogger(WithMaxFieldLength(4), WithJSONTruncation())))
+}
+
+func TestZapAdapter_ImplementsSugaredLogger(t *testing.T) {
+	contract.AssertSugaredLogger(t, NewNop())
+
+	logger, err := NewDevelopmentWithOptions(WithWriter(io.Discard))
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+	contract.AssertSugaredLogger(t, logger)
+}
+
+func TestContract_LazyFields(t *testing.T) {
+	contract.AssertLazyFields(t, func(w io.Writer) (core.ISugaredLogger, error) {
+		return NewWithOptions(WithWriter(w), WithLevel(core.InfoLevel))
+	})
+}
@@ -0,0 +1,161 @@
+package zap
+
+import (
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/buffer"
+	"go.uber.org/zap/zapcore"
+)
+
+// EncoderOptions holds options applied on top of the base encoder
+type EncoderOptions struct {
+	// MaxFieldLength truncates string field values longer than this many bytes (0 = no limit)
+	MaxFieldLength int
+	// FieldLengths overrides MaxFieldLength for specific keys
+	FieldLengths map[string]int
+	// TruncateKeys restricts MaxFieldLength to these keys only (empty = all keys)
+	TruncateKeys []string
+	// TruncateJSON applies truncation to JSON encoding too (console only by default)
+	TruncateJSON bool
+}
+
+// truncationEnabled reports whether truncation applies to the given encoding
+func (o EncoderOptions) truncationEnabled(encoding string) bool {
+	if o.MaxFieldLength <= 0 && len(o.FieldLengths) == 0 {
+		return false
+	}
+	return encoding == "console" || o.TruncateJSON
+}
+
+// limitFor returns the truncation limit for a key (0 = no truncation)
+func (o EncoderOptions) limitFor(key string) int {
+	if limit, ok := o.FieldLengths[key]; ok {
+		return limit
+	}
+	if len(o.TruncateKeys) == 0 {
+		return o.MaxFieldLength
+	}
+	for _, k := range o.TruncateKeys {
+		if k == key {
+			return o.MaxFieldLength
+		}
+	}
+	return 0
+}
+
+// truncate shortens val to the key's limit, appending "…(+N bytes)"
+func (o EncoderOptions) truncate(key, val string) string {
+	limit := o.limitFor(key)
+	if limit <= 0 || len(val) <= limit {
+		return val
+	}
+
+	// Don't cut a multi-byte rune in half
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(val[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s…(+%d bytes)", val[:cut], len(val)-cut)
+}
+
+// truncatingEncoder wraps a zapcore.Encoder and truncates long string fields
+type truncatingEncoder struct {
+	zapcore.Encoder
+	opts EncoderOptions
+}
+
+// AddString truncates context fields added via With
+func (e *truncatingEncoder) AddString(key, val string) {
+	e.Encoder.AddString(key, e.opts.truncate(key, val))
+}
+
+// Clone keeps the wrapper so derived loggers also truncate
+func (e *truncatingEncoder) Clone() zapcore.Encoder {
+	return &truncatingEncoder{
+		Encoder: e.Encoder.Clone(),
+		opts:    e.opts,
+	}
+}
+
+// EncodeEntry truncates per-entry string fields before encoding
+func (e *truncatingEncoder) EncodeEntry(ent zapcore.Entry, fields []zapcore.Field) (*buffer.Buffer, error) {
+	truncated := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		if f.Type == zapcore.StringType {
+			f.String = e.opts.truncate(f.Key, f.String)
+		}
+		truncated[i] = f
+	}
+	return e.Encoder.EncodeEntry(ent, truncated)
+}
+
+// buildLogger builds a zap.Logger, wrapping the encoder when truncation is enabled,
+// teeing level-filtered cores when routes are set, sending to syslog when sys is set
+// and writing to w when it is set.
+// Mirrors zap.Config.Build for the options this package exposes.
+func buildLogger(zapConfig zap.Config, encOpts EncoderOptions, routes []Route, sys *SyslogConfig, w io.Writer) (*zap.Logger, error) {
+	if len(routes) == 0 && sys == nil && w == nil && !encOpts.truncationEnabled(zapConfig.Encoding) {
+		return zapConfig.Build()
+	}
+
+	var (
+		zcore  zapcore.Core
+		closer func()
+		err    error
+	)
+	switch {
+	case len(routes) > 0:
+		zcore, closer, err = newRoutedCore(routes, zapConfig, encOpts)
+	case sys != nil:
+		zcore, closer, err = newSyslogCore(*sys, zapConfig, encOpts)
+	case w != nil:
+		zcore, closer = zapcore.NewCore(newEncoder(zapConfig.Encoding, zapConfig.EncoderConfig, encOpts), zapcore.AddSync(w), zapConfig.Level), func() {}
+	default:
+		zcore, closer, err = newCore(zapConfig.Encoding, zapConfig.OutputPaths, zapConfig.Level, zapConfig.EncoderConfig, encOpts)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	errSink, _, err := zap.Open(zapConfig.ErrorOutputPaths...)
+	if err != nil {
+		closer()
+		return nil, err
+	}
+
+	stackLevel := zapcore.ErrorLevel
+	opts := []zap.Option{zap.ErrorOutput(errSink), zap.AddCaller()}
+	if zapConfig.Development {
+		stackLevel = zapcore.WarnLevel
+		opts = append(opts, zap.Development())
+	}
+	opts = append(opts, zap.AddStacktrace(stackLevel))
+
+	return zap.New(zcore, opts...), nil
+}
+
+// newEncoder creates the encoder for encoding, wrapped for truncation when enabled
+func newEncoder(encoding string, encCfg zapcore.EncoderConfig, encOpts EncoderOptions) zapcore.Encoder {
+	var enc zapcore.Encoder
+	if encoding == "console" {
+		enc = zapcore.NewConsoleEncoder(encCfg)
+	} else {
+		enc = zapcore.NewJSONEncoder(encCfg)
+	}
+	if encOpts.truncationEnabled(encoding) {
+		enc = &truncatingEncoder{Encoder: enc, opts: encOpts}
+	}
+	return enc
+}
+
+// newCore opens paths and creates a core writing entries enabled by level
+func newCore(encoding string, paths []string, level zapcore.LevelEnabler, encCfg zapcore.EncoderConfig, encOpts EncoderOptions) (zapcore.Core, func(), error) {
+	sink, closeOut, err := zap.Open(paths...)
+	if err != nil {
+		return nil, nil, err
+	}
+	return zapcore.NewCore(newEncoder(encoding, encCfg, encOpts), sink, level), closeOut, nil
+}
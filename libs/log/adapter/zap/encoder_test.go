@@ -0,0 +1,105 @@
+package zap
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// logPayload logs a long and a short field through a development logger writing to a temp file
+// and returns the captured output
+func logPayload(t *testing.T, opts ...Option) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "out.log")
+
+	logger, err := NewDevelopmentWithOptions(append([]Option{WithOutputPaths(path)}, opts...)...)
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+	logger.Infow("payload", "body", strings.Repeat("x", 100), "short", "ok")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read log output: %v", err)
+	}
+	return string(data)
+}
+
+func TestEncoder_TruncatesLongFields(t *testing.T) {
+	out := logPayload(t, WithMaxFieldLength(10))
+
+	if !strings.Contains(out, "xxxxxxxxxx…(+90 bytes)") {
+		t.Errorf("expected truncation marker, got: %s", out)
+	}
+	if !strings.Contains(out, `"short": "ok"`) {
+		t.Errorf("expected short field untouched, got: %s", out)
+	}
+}
+
+func TestEncoder_TruncatesWithFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.log")
+	logger, err := NewDevelopmentWithOptions(WithOutputPaths(path), WithMaxFieldLength(5))
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+
+	logger.With("ctx", "abcdefghij").Info("message")
+	logger.Sync()
+
+	data, _ := os.ReadFile(path)
+	if !strings.Contains(string(data), "abcde…(+5 bytes)") {
+		t.Errorf("expected With field to be truncated, got: %s", data)
+	}
+}
+
+func TestEncoder_FieldLengthOverride(t *testing.T) {
+	out := logPayload(t, WithMaxFieldLength(10), WithFieldLength("body", 4))
+
+	if !strings.Contains(out, "xxxx…(+96 bytes)") {
+		t.Errorf("expected per-key override, got: %s", out)
+	}
+}
+
+func TestEncoder_TruncateKeysOnly(t *testing.T) {
+	out := logPayload(t, WithMaxFieldLength(1), WithTruncateKeys("body"))
+
+	if !strings.Contains(out, "x…(+99 bytes)") {
+		t.Errorf("expected body to be truncated, got: %s", out)
+	}
+	if !strings.Contains(out, `"short": "ok"`) {
+		t.Errorf("expected keys outside TruncateKeys untouched, got: %s", out)
+	}
+}
+
+func TestEncoder_JSONUnaffectedByDefault(t *testing.T) {
+	out := logPayload(t, WithMaxFieldLength(10), WithJSONEncoding())
+
+	if strings.Contains(out, "…(+") {
+		t.Errorf("expected JSON output untruncated, got: %s", out)
+	}
+}
+
+func TestEncoder_JSONOptIn(t *testing.T) {
+	out := logPayload(t, WithMaxFieldLength(10), WithJSONEncoding(), WithJSONTruncation())
+
+	if !strings.Contains(out, "xxxxxxxxxx…(+90 bytes)") {
+		t.Errorf("expected JSON output truncated, got: %s", out)
+	}
+}
+
+func TestEncoderOptions_TruncateRuneBoundary(t *testing.T) {
+	opts := EncoderOptions{MaxFieldLength: 2}
+
+	// "aé" is 3 bytes; cutting at 2 would split "é" in half
+	got := opts.truncate("k", "aé")
+	if got != "a…(+2 bytes)" {
+		t.Errorf("expected cut on rune boundary, got %q", got)
+	}
+
+	got = opts.truncate("k", "ab")
+	if got != "ab" {
+		t.Errorf("expected value at limit untouched, got %q", got)
+	}
+}
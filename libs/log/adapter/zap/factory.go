@@ -2,10 +2,11 @@ package zap
 
 import (
 	"fmt"
+	"io"
+	"strings"
 
 	"github.com/phongthien99/monorepo-lib/libs/log/core"
 	"go.uber.org/zap"
-	"go.uber.org/zap/zapcore"
 )
 
 // NewDevelopment creates a development logger (human-friendly, colorful)
@@ -44,9 +45,35 @@ type Config struct {
 	Encoding         string // "json" or "console"
 	OutputPaths      []string
 	ErrorOutputPaths []string
+	EncoderOptions   EncoderOptions
+	ServiceInfo      ServiceInfo // Attached as fields on the root logger
+	// LevelRouting, Writer and Syslog each replace OutputPaths; at most one of them may be set.
+	// LevelRouting splits output by level (e.g. Warn+ to stderr)
+	LevelRouting []Route
+	// Writer receives the output, e.g. a bytes.Buffer in tests
+	Writer io.Writer
+	// Syslog sends the output to a syslog daemon
+	Syslog *SyslogConfig
+	// FieldNames overrides the JSON keys; empty names keep the defaults
+	FieldNames FieldNames
 }
 
-// NewWithConfig creates a logger with custom configuration
+// SyslogConfig addresses a syslog daemon (see WithSyslog)
+type SyslogConfig struct {
+	Network string // "udp", "tcp", "unix"; empty with an empty Addr for the local daemon
+	Addr    string // e.g. "localhost:514" or "/dev/log"
+	Tag     string // program name in each message; defaults to the process name
+}
+
+// NewWithConfig creates a logger with custom configuration.
+// It fails if more than one of LevelRouting, Writer and Syslog is set.
+//
+// With LevelRouting, every entry goes to each route whose level range contains it:
+//
+//	cfg.LevelRouting = []zap.Route{
+//	    {MinLevel: core.DebugLevel, MaxLevel: core.InfoLevel, OutputPaths: []string{"stdout"}},
+//	    {MinLevel: core.WarnLevel, MaxLevel: core.FatalLevel, OutputPaths: []string{"stderr", "/var/log/app/errors.log"}},
+//	}
 func NewWithConfig(cfg Config) (core.ISugaredLogger, error) {
 	// Validate and set defaults
 	if cfg.Encoding == "" {
@@ -55,6 +82,9 @@ func NewWithConfig(cfg Config) (core.ISugaredLogger, error) {
 	if cfg.Encoding != "json" && cfg.Encoding != "console" {
 		return nil, fmt.Errorf("invalid encoding: %s (must be 'json' or 'console')", cfg.Encoding)
 	}
+	if outputs := cfg.outputModes(); len(outputs) > 1 {
+		return nil, fmt.Errorf("conflicting outputs: %s (set only one)", strings.Join(outputs, ", "))
+	}
 	if len(cfg.OutputPaths) == 0 {
 		cfg.OutputPaths = []string{"stdout"}
 	}
@@ -62,36 +92,42 @@ func NewWithConfig(cfg Config) (core.ISugaredLogger, error) {
 		cfg.ErrorOutputPaths = []string{"stderr"}
 	}
 
+	names := cfg.FieldNames.withDefaults()
 	zapConfig := zap.Config{
 		Level:            zap.NewAtomicLevelAt(coreToZapLevel(cfg.Level)),
 		Development:      cfg.Development,
 		Encoding:         cfg.Encoding,
 		OutputPaths:      cfg.OutputPaths,
 		ErrorOutputPaths: cfg.ErrorOutputPaths,
-		EncoderConfig: zapcore.EncoderConfig{
-			TimeKey:        "timestamp",
-			LevelKey:       "level",
-			NameKey:        "logger",
-			CallerKey:      "caller",
-			FunctionKey:    zapcore.OmitKey,
-			MessageKey:     "msg",
-			StacktraceKey:  "stacktrace",
-			LineEnding:     zapcore.DefaultLineEnding,
-			EncodeLevel:    zapcore.LowercaseLevelEncoder,
-			EncodeTime:     zapcore.ISO8601TimeEncoder,
-			EncodeDuration: zapcore.SecondsDurationEncoder,
-			EncodeCaller:   zapcore.ShortCallerEncoder,
-		},
+		EncoderConfig:    names.encoderConfig(),
 	}
 
-	logger, err := zapConfig.Build()
+	logger, err := buildLogger(zapConfig, cfg.EncoderOptions, cfg.LevelRouting, cfg.Syslog, cfg.Writer)
 	if err != nil {
 		return nil, err
 	}
+	if fields := cfg.ServiceInfo.fields(names); len(fields) > 0 {
+		logger = logger.With(fields...)
+	}
 
 	return NewZapAdapterFromLogger(logger, cfg.Level), nil
 }
 
+// outputModes returns the names of the set fields that replace OutputPaths
+func (cfg Config) outputModes() []string {
+	var modes []string
+	if len(cfg.LevelRouting) > 0 {
+		modes = append(modes, "LevelRouting")
+	}
+	if cfg.Writer != nil {
+		modes = append(modes, "Writer")
+	}
+	if cfg.Syslog != nil {
+		modes = append(modes, "Syslog")
+	}
+	return modes
+}
+
 // DefaultConfig returns a default configuration
 func DefaultConfig() Config {
 	return Config{
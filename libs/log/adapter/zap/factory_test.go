@@ -1,6 +1,8 @@
 package zap
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	"github.com/phongthien99/monorepo-lib/libs/log/core"
@@ -227,3 +229,33 @@ func BenchmarkNewExample(b *testing.B) {
 		_ = logger.Sync()
 	}
 }
+
+func TestNewWithConfig_ConflictingOutputs(t *testing.T) {
+	route := Route{MinLevel: core.DebugLevel, MaxLevel: core.FatalLevel, OutputPaths: []string{"stdout"}}
+	syslog := &SyslogConfig{Network: "udp", Addr: "localhost:514", Tag: "test"}
+
+	tests := []struct {
+		name    string
+		mutate  func(*Config)
+		wantErr string
+	}{
+		{"routing and writer", func(c *Config) { c.LevelRouting = []Route{route}; c.Writer = &bytes.Buffer{} }, "LevelRouting, Writer"},
+		{"routing and syslog", func(c *Config) { c.LevelRouting = []Route{route}; c.Syslog = syslog }, "LevelRouting, Syslog"},
+		{"writer and syslog", func(c *Config) { c.Writer = &bytes.Buffer{}; c.Syslog = syslog }, "Writer, Syslog"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := DefaultConfig()
+			tt.mutate(&cfg)
+
+			logger, err := NewWithConfig(cfg)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("NewWithConfig() error = %v, want conflicting %s", err, tt.wantErr)
+			}
+			if logger != nil {
+				t.Error("NewWithConfig() returned a logger for conflicting outputs")
+			}
+		})
+	}
+}
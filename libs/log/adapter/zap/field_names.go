@@ -0,0 +1,95 @@
+package zap
+
+import "go.uber.org/zap/zapcore"
+
+// FieldNames holds the JSON keys of the entry fields and service metadata.
+// Empty names fall back to DefaultFieldNames.
+type FieldNames struct {
+	Time       string
+	Level      string
+	Message    string
+	Logger     string
+	Caller     string
+	Stacktrace string
+
+	Service  string
+	Version  string
+	Commit   string
+	Env      string
+	Instance string
+}
+
+// DefaultFieldNames returns the keys this package emits by default
+func DefaultFieldNames() FieldNames {
+	return FieldNames{
+		Time:       "timestamp",
+		Level:      "level",
+		Message:    "msg",
+		Logger:     "logger",
+		Caller:     "caller",
+		Stacktrace: "stacktrace",
+		Service:    "service",
+		Version:    "version",
+		Commit:     "commit",
+		Env:        "env",
+		Instance:   "instance",
+	}
+}
+
+// ECSFieldNames returns keys following the Elastic Common Schema.
+// ECS has no commit field, so the commit goes to labels.commit.
+func ECSFieldNames() FieldNames {
+	return FieldNames{
+		Time:       "@timestamp",
+		Level:      "log.level",
+		Message:    "message",
+		Logger:     "log.logger",
+		Caller:     "log.origin.file.name",
+		Stacktrace: "error.stack_trace",
+		Service:    "service.name",
+		Version:    "service.version",
+		Commit:     "labels.commit",
+		Env:        "service.environment",
+		Instance:   "service.node.name",
+	}
+}
+
+// withDefaults fills empty names from DefaultFieldNames
+func (n FieldNames) withDefaults() FieldNames {
+	d := DefaultFieldNames()
+	fill := func(name *string, def string) {
+		if *name == "" {
+			*name = def
+		}
+	}
+	fill(&n.Time, d.Time)
+	fill(&n.Level, d.Level)
+	fill(&n.Message, d.Message)
+	fill(&n.Logger, d.Logger)
+	fill(&n.Caller, d.Caller)
+	fill(&n.Stacktrace, d.Stacktrace)
+	fill(&n.Service, d.Service)
+	fill(&n.Version, d.Version)
+	fill(&n.Commit, d.Commit)
+	fill(&n.Env, d.Env)
+	fill(&n.Instance, d.Instance)
+	return n
+}
+
+// encoderConfig returns the encoder config using these names
+func (n FieldNames) encoderConfig() zapcore.EncoderConfig {
+	return zapcore.EncoderConfig{
+		TimeKey:        n.Time,
+		LevelKey:       n.Level,
+		NameKey:        n.Logger,
+		CallerKey:      n.Caller,
+		FunctionKey:    zapcore.OmitKey,
+		MessageKey:     n.Message,
+		StacktraceKey:  n.Stacktrace,
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeLevel:    zapcore.LowercaseLevelEncoder,
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeDuration: zapcore.SecondsDurationEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+	}
+}
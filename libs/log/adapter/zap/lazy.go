@@ -0,0 +1,58 @@
+package zap
+
+import (
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// lazyField encodes a core.LazyValue under key when zap encodes the entry,
+// which only happens once the entry passed the level and sampling checks.
+type lazyField struct {
+	key   string
+	value *core.LazyValue
+}
+
+// MarshalLogObject adds the resolved value to the enclosing object, with zap's usual typing.
+func (f lazyField) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	zap.Any(f.key, f.value.Value()).AddTo(enc)
+	return nil
+}
+
+// lazyFields replaces key/core.LazyValue pairs in keysAndValues with inline zap fields.
+// It returns keysAndValues itself when there is no lazy value.
+func lazyFields(keysAndValues []any) []any {
+	var out []any
+	for i := 0; i < len(keysAndValues); i++ {
+		if f, ok := keysAndValues[i].(zap.Field); ok {
+			// Strongly typed fields take one slot, not a pair
+			if out != nil {
+				out = append(out, f)
+			}
+			continue
+		}
+		if i+1 >= len(keysAndValues) {
+			if out != nil {
+				out = append(out, keysAndValues[i])
+			}
+			break
+		}
+
+		key, isKey := keysAndValues[i].(string)
+		lazy, isLazy := keysAndValues[i+1].(*core.LazyValue)
+		if isKey && isLazy {
+			if out == nil {
+				out = append(make([]any, 0, len(keysAndValues)), keysAndValues[:i]...)
+			}
+			out = append(out, zap.Inline(lazyField{key: key, value: lazy}))
+		} else if out != nil {
+			out = append(out, keysAndValues[i], keysAndValues[i+1])
+		}
+		i++
+	}
+
+	if out == nil {
+		return keysAndValues
+	}
+	return out
+}
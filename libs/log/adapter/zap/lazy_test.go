@@ -0,0 +1,76 @@
+package zap
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLazy_EncodedWithZapTypes(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := NewWithOptions(WithWriter(&buf), WithLevel(core.InfoLevel))
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+
+	logger.Infow("stats", "count", core.Lazy(func() any { return 42 }), zap.String("typed", "kept"), "plain", "v")
+	_ = logger.Sync()
+
+	out := buf.String()
+	for _, want := range []string{`"count":42`, `"typed":"kept"`, `"plain":"v"`} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected %s in output, got %s", want, out)
+		}
+	}
+}
+
+func TestLazy_NotCalledWhenSampledAway(t *testing.T) {
+	var buf bytes.Buffer
+	encoder := zapcore.NewJSONEncoder(zap.NewProductionEncoderConfig())
+	sampled := zapcore.NewSamplerWithOptions(zapcore.NewCore(encoder, zapcore.AddSync(&buf), zapcore.InfoLevel), time.Hour, 1, 0)
+	logger := NewZapAdapterFromLogger(zap.New(sampled), core.InfoLevel)
+
+	calls := 0
+	for i := 0; i < 5; i++ {
+		logger.Infow("tick", "state", core.Lazy(func() any {
+			calls++
+			return "ok"
+		}))
+	}
+
+	if calls != 1 {
+		t.Errorf("Expected only the sampled-in entry to evaluate, got %d calls", calls)
+	}
+}
+
+func TestLazy_WithLazyDefersContextFields(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := NewWithOptions(WithWriter(&buf), WithLevel(core.InfoLevel))
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+
+	calls := 0
+	child := logger.WithLazy("snapshot", core.Lazy(func() any {
+		calls++
+		return "s-1"
+	}))
+	if calls != 0 {
+		t.Fatalf("Expected WithLazy not to evaluate, got %d calls", calls)
+	}
+
+	child.Info("first")
+	child.Info("second")
+	_ = child.Sync()
+	if calls != 1 {
+		t.Errorf("Expected one evaluation, got %d", calls)
+	}
+	if strings.Count(buf.String(), `"snapshot":"s-1"`) != 2 {
+		t.Errorf("Expected the value on both entries, got %s", buf.String())
+	}
+}
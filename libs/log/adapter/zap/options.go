@@ -1,6 +1,8 @@
 package zap
 
 import (
+	"io"
+
 	"github.com/phongthien99/monorepo-lib/libs/log/core"
 	"go.uber.org/zap"
 )
@@ -57,6 +59,75 @@ func WithErrorOutputPaths(paths ...string) Option {
 	}
 }
 
+// WithLevelRouting sends each level range to its own outputs (replaces OutputPaths)
+func WithLevelRouting(routes ...Route) Option {
+	return func(c *Config) {
+		c.LevelRouting = append(c.LevelRouting, routes...)
+	}
+}
+
+// WithMaxFieldLength truncates string field values longer than n bytes (console encoding)
+func WithMaxFieldLength(n int) Option {
+	return func(c *Config) {
+		c.EncoderOptions.MaxFieldLength = n
+	}
+}
+
+// WithFieldLength overrides the truncation limit for a specific key
+func WithFieldLength(key string, n int) Option {
+	return func(c *Config) {
+		if c.EncoderOptions.FieldLengths == nil {
+			c.EncoderOptions.FieldLengths = make(map[string]int)
+		}
+		c.EncoderOptions.FieldLengths[key] = n
+	}
+}
+
+// WithTruncateKeys restricts truncation to the given keys only
+func WithTruncateKeys(keys ...string) Option {
+	return func(c *Config) {
+		c.EncoderOptions.TruncateKeys = keys
+	}
+}
+
+// WithJSONTruncation applies field truncation to JSON encoding as well
+func WithJSONTruncation() Option {
+	return func(c *Config) {
+		c.EncoderOptions.TruncateJSON = true
+	}
+}
+
+// WithServiceInfo attaches service metadata (service, version, commit, env, instance)
+// to the root logger and every logger derived from it
+func WithServiceInfo(info ServiceInfo) Option {
+	return func(c *Config) {
+		c.ServiceInfo = info
+	}
+}
+
+// WithWriter sends output to w instead of OutputPaths, e.g. a bytes.Buffer in tests
+func WithWriter(w io.Writer) Option {
+	return func(c *Config) {
+		c.Writer = w
+	}
+}
+
+// WithSyslog sends output to a syslog daemon instead of OutputPaths, with each entry's level
+// mapped to a syslog severity. An empty network and addr use the local daemon (e.g. journald's /dev/log).
+// Building the logger fails if the daemon cannot be reached
+func WithSyslog(network, addr, tag string) Option {
+	return func(c *Config) {
+		c.Syslog = &SyslogConfig{Network: network, Addr: addr, Tag: tag}
+	}
+}
+
+// WithFieldNames overrides the JSON keys, e.g. WithFieldNames(ECSFieldNames())
+func WithFieldNames(names FieldNames) Option {
+	return func(c *Config) {
+		c.FieldNames = names
+	}
+}
+
 // NewWithOptions creates a logger with functional options
 func NewWithOptions(opts ...Option) (core.ISugaredLogger, error) {
 	cfg := DefaultConfig()
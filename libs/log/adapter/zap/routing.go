@@ -0,0 +1,70 @@
+package zap
+
+import (
+	"fmt"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Route sends entries whose level is within [MinLevel, MaxLevel] to its own outputs
+type Route struct {
+	MinLevel    core.Level
+	MaxLevel    core.Level // Use core.FatalLevel for "MinLevel and above"
+	OutputPaths []string
+	Encoding    string // "json" or "console", defaults to Config.Encoding
+}
+
+// newRoutedCore tees one core per route. Each route still honours the logger's level,
+// so raising the level (e.g. via SetLevel) silences routes below it.
+func newRoutedCore(routes []Route, zapConfig zap.Config, encOpts EncoderOptions) (zapcore.Core, func(), error) {
+	cores := make([]zapcore.Core, 0, len(routes))
+	closers := make([]func(), 0, len(routes))
+	closeAll := func() {
+		for _, closer := range closers {
+			closer()
+		}
+	}
+
+	for i, route := range routes {
+		if err := route.validate(); err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("route[%d]: %w", i, err)
+		}
+
+		encoding := route.Encoding
+		if encoding == "" {
+			encoding = zapConfig.Encoding
+		}
+
+		minLevel, maxLevel, base := coreToZapLevel(route.MinLevel), coreToZapLevel(route.MaxLevel), zapConfig.Level
+		enabled := zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+			return l >= minLevel && l <= maxLevel && base.Enabled(l)
+		})
+
+		zcore, closer, err := newCore(encoding, route.OutputPaths, enabled, zapConfig.EncoderConfig, encOpts)
+		if err != nil {
+			closeAll()
+			return nil, nil, fmt.Errorf("route[%d]: %w", i, err)
+		}
+		cores = append(cores, zcore)
+		closers = append(closers, closer)
+	}
+
+	return zapcore.NewTee(cores...), closeAll, nil
+}
+
+// validate checks the route's level range, encoding and outputs
+func (r Route) validate() error {
+	if r.MaxLevel < r.MinLevel {
+		return fmt.Errorf("max level %s is below min level %s", r.MaxLevel, r.MinLevel)
+	}
+	if r.Encoding != "" && r.Encoding != "json" && r.Encoding != "console" {
+		return fmt.Errorf("invalid encoding: %s (must be 'json' or 'console')", r.Encoding)
+	}
+	if len(r.OutputPaths) == 0 {
+		return fmt.Errorf("no output paths")
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package zap
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+func readLines(t *testing.T, path string) []string {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read %s: %v", path, err)
+	}
+	return strings.Split(strings.TrimSpace(string(data)), "\n")
+}
+
+func TestLevelRouting_SegregatesLevels(t *testing.T) {
+	dir := t.TempDir()
+	infoPath := filepath.Join(dir, "info.log")
+	errPath := filepath.Join(dir, "error.log")
+
+	cfg := DefaultConfig()
+	cfg.Level = core.DebugLevel
+	cfg.LevelRouting = []Route{
+		{MinLevel: core.DebugLevel, MaxLevel: core.InfoLevel, OutputPaths: []string{infoPath}},
+		{MinLevel: core.WarnLevel, MaxLevel: core.FatalLevel, OutputPaths: []string{errPath}},
+	}
+
+	logger, err := NewWithConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewWithConfig failed: %v", err)
+	}
+	logger.Debug("debug message")
+	logger.Info("info message")
+	logger.Warn("warn message")
+	logger.Error("error message")
+	if err := logger.Sync(); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+
+	infoLines := readLines(t, infoPath)
+	if len(infoLines) != 2 || !strings.Contains(infoLines[0], "debug message") || !strings.Contains(infoLines[1], "info message") {
+		t.Errorf("expected debug and info only in info log, got: %v", infoLines)
+	}
+
+	errLines := readLines(t, errPath)
+	if len(errLines) != 2 || !strings.Contains(errLines[0], "warn message") || !strings.Contains(errLines[1], "error message") {
+		t.Errorf("expected warn and error only in error log, got: %v", errLines)
+	}
+}
+
+func TestLevelRouting_RespectsLoggerLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "all.log")
+
+	logger, err := NewWithOptions(
+		WithLevel(core.WarnLevel),
+		WithLevelRouting(Route{MinLevel: core.DebugLevel, MaxLevel: core.FatalLevel, OutputPaths: []string{path}, Encoding: "console"}),
+	)
+	if err != nil {
+		t.Fatalf("NewWithOptions failed: %v", err)
+	}
+	logger.Info("dropped")
+	logger.Warn("kept")
+	logger.Sync()
+
+	lines := readLines(t, path)
+	if len(lines) != 1 || !strings.Contains(lines[0], "kept") {
+		t.Errorf("expected only the warn entry, got: %v", lines)
+	}
+}
+
+func TestLevelRouting_InvalidRoute(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.LevelRouting = []Route{{MinLevel: core.ErrorLevel, MaxLevel: core.InfoLevel, OutputPaths: []string{"stdout"}}}
+
+	if _, err := NewWithConfig(cfg); err == nil || !strings.Contains(err.Error(), "route[0]") {
+		t.Errorf("expected route validation error, got: %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package zap
+
+import (
+	"os"
+	"path"
+	"runtime/debug"
+
+	"go.uber.org/zap"
+)
+
+// ServiceInfo holds build/runtime metadata attached to every log entry
+type ServiceInfo struct {
+	Name     string
+	Version  string
+	Commit   string
+	Env      string
+	Instance string
+}
+
+// fields returns the non-empty metadata as zap fields keyed by names
+func (s ServiceInfo) fields(names FieldNames) []zap.Field {
+	var fields []zap.Field
+	add := func(key, value string) {
+		if value != "" {
+			fields = append(fields, zap.String(key, value))
+		}
+	}
+	add(names.Service, s.Name)
+	add(names.Version, s.Version)
+	add(names.Commit, s.Commit)
+	add(names.Env, s.Env)
+	add(names.Instance, s.Instance)
+	return fields
+}
+
+// ServiceInfoFromBuildInfo returns ServiceInfo with defaults read from the binary:
+// Name and Version from the main module, Commit from vcs.revision, Instance from os.Hostname.
+// Env is left empty; set it from your config.
+func ServiceInfoFromBuildInfo() ServiceInfo {
+	var info ServiceInfo
+
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		if bi.Main.Path != "" {
+			info.Name = path.Base(bi.Main.Path)
+		}
+		if bi.Main.Version != "" && bi.Main.Version != "(devel)" {
+			info.Version = bi.Main.Version
+		}
+		for _, s := range bi.Settings {
+			if s.Key == "vcs.revision" {
+				info.Commit = s.Value
+			}
+		}
+	}
+
+	if host, err := os.Hostname(); err == nil {
+		info.Instance = host
+	}
+
+	return info
+}
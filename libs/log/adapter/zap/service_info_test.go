@@ -0,0 +1,95 @@
+package zap
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWithServiceInfo_RootAndDerived(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.log")
+	info := ServiceInfo{
+		Name:     "orders",
+		Version:  "1.2.3",
+		Commit:   "abc123",
+		Env:      "staging",
+		Instance: "orders-0",
+	}
+
+	logger, err := NewWithOptions(WithOutputPaths(path), WithServiceInfo(info))
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+
+	logger.Info("root")
+	logger.With("request_id", "r-1").Info("derived")
+	logger.Named("db").Info("named")
+	logger.Sync()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read log output: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 log lines, got %d: %s", len(lines), data)
+	}
+
+	want := map[string]string{
+		"service":  "orders",
+		"version":  "1.2.3",
+		"commit":   "abc123",
+		"env":      "staging",
+		"instance": "orders-0",
+	}
+	for _, line := range lines {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			t.Fatalf("invalid JSON log line %q: %v", line, err)
+		}
+		for key, value := range want {
+			if entry[key] != value {
+				t.Errorf("%s: expected %s=%s, got %v", entry["msg"], key, value, entry[key])
+			}
+		}
+	}
+}
+
+func TestWithServiceInfo_SkipsEmptyFields(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.log")
+
+	logger, err := NewWithOptions(WithOutputPaths(path), WithServiceInfo(ServiceInfo{Name: "orders"}))
+	if err != nil {
+		t.Fatalf("failed to create logger: %v", err)
+	}
+	logger.Info("root")
+	logger.Sync()
+
+	data, _ := os.ReadFile(path)
+	var entry map[string]any
+	if err := json.Unmarshal(data, &entry); err != nil {
+		t.Fatalf("invalid JSON log line: %v", err)
+	}
+
+	if entry["service"] != "orders" {
+		t.Errorf("expected service=orders, got %v", entry["service"])
+	}
+	if _, ok := entry["version"]; ok {
+		t.Error("expected empty version to be omitted")
+	}
+}
+
+func TestServiceInfoFromBuildInfo(t *testing.T) {
+	info := ServiceInfoFromBuildInfo()
+
+	host, err := os.Hostname()
+	if err == nil && info.Instance != host {
+		t.Errorf("expected Instance=%s, got %s", host, info.Instance)
+	}
+	if info.Env != "" {
+		t.Errorf("expected Env to be left empty, got %s", info.Env)
+	}
+}
@@ -0,0 +1,62 @@
+//go:build !windows && !plan9
+
+package zap
+
+import (
+	"fmt"
+	"log/syslog"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// syslogSeverities maps each zap level range to the syslog.Writer method that sends at its severity
+var syslogSeverities = []struct {
+	min, max zapcore.Level
+	send     func(w *syslog.Writer, m string) error
+}{
+	{zapcore.DebugLevel, zapcore.DebugLevel, (*syslog.Writer).Debug},
+	{zapcore.InfoLevel, zapcore.InfoLevel, (*syslog.Writer).Info},
+	{zapcore.WarnLevel, zapcore.WarnLevel, (*syslog.Writer).Warning},
+	{zapcore.ErrorLevel, zapcore.ErrorLevel, (*syslog.Writer).Err},
+	{zapcore.DPanicLevel, zapcore.FatalLevel, (*syslog.Writer).Crit},
+}
+
+// syslogSink writes each entry as one syslog message at a fixed severity
+type syslogSink struct {
+	w    *syslog.Writer
+	send func(w *syslog.Writer, m string) error
+}
+
+func (s syslogSink) Write(p []byte) (int, error) {
+	if err := s.send(s.w, string(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (s syslogSink) Sync() error {
+	return nil
+}
+
+// newSyslogCore dials the syslog daemon and tees one core per severity, so entries keep their level in syslog.
+// Fails if the daemon cannot be reached.
+func newSyslogCore(sys SyslogConfig, zapConfig zap.Config, encOpts EncoderOptions) (zapcore.Core, func(), error) {
+	w, err := syslog.Dial(sys.Network, sys.Addr, syslog.LOG_INFO|syslog.LOG_USER, sys.Tag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("syslog: %w", err)
+	}
+
+	enc := newEncoder(zapConfig.Encoding, zapConfig.EncoderConfig, encOpts)
+	base := zapConfig.Level
+	cores := make([]zapcore.Core, 0, len(syslogSeverities))
+	for _, s := range syslogSeverities {
+		minLevel, maxLevel := s.min, s.max
+		enabled := zap.LevelEnablerFunc(func(l zapcore.Level) bool {
+			return l >= minLevel && l <= maxLevel && base.Enabled(l)
+		})
+		cores = append(cores, zapcore.NewCore(enc.Clone(), syslogSink{w: w, send: s.send}, enabled))
+	}
+
+	return zapcore.NewTee(cores...), func() { w.Close() }, nil
+}
@@ -0,0 +1,15 @@
+//go:build windows || plan9
+
+package zap
+
+import (
+	"errors"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// newSyslogCore fails: log/syslog is not available on this platform
+func newSyslogCore(SyslogConfig, zap.Config, EncoderOptions) (zapcore.Core, func(), error) {
+	return nil, nil, errors.New("syslog: not supported on this platform")
+}
@@ -0,0 +1,71 @@
+//go:build !windows && !plan9
+
+package zap
+
+import (
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// listenSyslog starts a fake UDP syslog daemon and returns its address and a receive function
+func listenSyslog(t *testing.T) (string, func() string) {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	receive := func() string {
+		t.Helper()
+		buf := make([]byte, 64*1024)
+		conn.SetReadDeadline(time.Now().Add(2 * time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("no syslog message received: %v", err)
+		}
+		return string(buf[:n])
+	}
+	return conn.LocalAddr().String(), receive
+}
+
+func TestSyslog_DeliversEntries(t *testing.T) {
+	addr, receive := listenSyslog(t)
+
+	logger, err := NewWithOptions(WithSyslog("udp", addr, "orders"), WithLevel(core.InfoLevel))
+	if err != nil {
+		t.Fatalf("NewWithOptions failed: %v", err)
+	}
+
+	logger.Debug("not sent")
+	logger.Infow("order placed", "order_id", 42)
+	logger.Error("payment failed")
+
+	// user facility (1) * 8 + severity: info = 6, err = 3
+	info := receive()
+	if !strings.HasPrefix(info, "<14>") || !strings.Contains(info, "orders[") || !strings.Contains(info, `"msg":"order placed"`) || !strings.Contains(info, `"order_id":42`) {
+		t.Errorf("Unexpected info message: %q", info)
+	}
+	errMsg := receive()
+	if !strings.HasPrefix(errMsg, "<11>") || !strings.Contains(errMsg, `"msg":"payment failed"`) {
+		t.Errorf("Unexpected error message: %q", errMsg)
+	}
+}
+
+func TestSyslog_ConnectionFailure(t *testing.T) {
+	// Reserve a port, then close it so nothing listens there
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	if _, err := NewWithOptions(WithSyslog("tcp", addr, "orders")); err == nil || !strings.Contains(err.Error(), "syslog") {
+		t.Errorf("Expected a syslog connection error, got %v", err)
+	}
+}
@@ -0,0 +1,156 @@
+package contract
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// behavior is what a method is allowed to do besides logging
+type behavior int
+
+const (
+	returns  behavior = iota // must return normally
+	mayPanic                 // DPanic: panics in development mode only
+	panics                   // Panic: must panic
+	mayExit                  // Fatal: must not panic; exits through core.Exit if it exits
+)
+
+// call is one method invocation exercised by AssertSugaredLogger
+type call struct {
+	name     string
+	behavior behavior
+	fn       func(core.ISugaredLogger)
+}
+
+// methodGroups lists every ISugaredLogger method by sub-interface
+var methodGroups = []struct {
+	name  string
+	calls []call
+}{
+	{"basic", []call{
+		{"Debug", returns, func(l core.ISugaredLogger) { l.Debug("debug") }},
+		{"Info", returns, func(l core.ISugaredLogger) { l.Info("info") }},
+		{"Warn", returns, func(l core.ISugaredLogger) { l.Warn("warn") }},
+		{"Error", returns, func(l core.ISugaredLogger) { l.Error("error") }},
+		{"DPanic", mayPanic, func(l core.ISugaredLogger) { l.DPanic("dpanic") }},
+		{"Panic", panics, func(l core.ISugaredLogger) { l.Panic("panic") }},
+		{"Fatal", mayExit, func(l core.ISugaredLogger) { l.Fatal("fatal") }},
+	}},
+	{"formatted", []call{
+		{"Debugf", returns, func(l core.ISugaredLogger) { l.Debugf("debug %d", 1) }},
+		{"Infof", returns, func(l core.ISugaredLogger) { l.Infof("info %d", 1) }},
+		{"Warnf", returns, func(l core.ISugaredLogger) { l.Warnf("warn %d", 1) }},
+		{"Errorf", returns, func(l core.ISugaredLogger) { l.Errorf("error %d", 1) }},
+		{"DPanicf", mayPanic, func(l core.ISugaredLogger) { l.DPanicf("dpanic %d", 1) }},
+		{"Panicf", panics, func(l core.ISugaredLogger) { l.Panicf("panic %d", 1) }},
+		{"Fatalf", mayExit, func(l core.ISugaredLogger) { l.Fatalf("fatal %d", 1) }},
+		{"Logf", returns, func(l core.ISugaredLogger) { l.Logf(core.InfoLevel, "log %d", 1) }},
+	}},
+	{"structured", []call{
+		{"Debugw", returns, func(l core.ISugaredLogger) { l.Debugw("debug", "key", 1) }},
+		{"Infow", returns, func(l core.ISugaredLogger) { l.Infow("info", "key", 1) }},
+		{"Warnw", returns, func(l core.ISugaredLogger) { l.Warnw("warn", "key", 1) }},
+		{"Errorw", returns, func(l core.ISugaredLogger) { l.Errorw("error", "key", 1) }},
+		{"DPanicw", mayPanic, func(l core.ISugaredLogger) { l.DPanicw("dpanic", "key", 1) }},
+		{"Panicw", panics, func(l core.ISugaredLogger) { l.Panicw("panic", "key", 1) }},
+		{"Fatalw", mayExit, func(l core.ISugaredLogger) { l.Fatalw("fatal", "key", 1) }},
+		{"Logw", returns, func(l core.ISugaredLogger) { l.Logw(core.InfoLevel, "log", "key", 1) }},
+	}},
+	{"line", []call{
+		{"Debugln", returns, func(l core.ISugaredLogger) { l.Debugln("debug") }},
+		{"Infoln", returns, func(l core.ISugaredLogger) { l.Infoln("info") }},
+		{"Warnln", returns, func(l core.ISugaredLogger) { l.Warnln("warn") }},
+		{"Errorln", returns, func(l core.ISugaredLogger) { l.Errorln("error") }},
+		{"DPanicln", mayPanic, func(l core.ISugaredLogger) { l.DPanicln("dpanic") }},
+		{"Panicln", panics, func(l core.ISugaredLogger) { l.Panicln("panic") }},
+		{"Fatalln", mayExit, func(l core.ISugaredLogger) { l.Fatalln("fatal") }},
+		{"Logln", returns, func(l core.ISugaredLogger) { l.Logln(core.InfoLevel, "log") }},
+	}},
+	{"contextual", []call{
+		{"With", returns, func(l core.ISugaredLogger) { mustDerive(l.With("key", 1)).Info("with") }},
+		{"WithLazy", returns, func(l core.ISugaredLogger) { mustDerive(l.WithLazy("key", 1)).Info("with lazy") }},
+		{"Named", returns, func(l core.ISugaredLogger) { mustDerive(l.Named("child")).Info("named") }},
+		{"WithContext", returns, func(l core.ISugaredLogger) { mustDerive(l.WithContext(context.Background())).Info("with context") }},
+	}},
+	{"control", []call{
+		{"Desugar", returns, func(l core.ISugaredLogger) { _ = l.Desugar() }},
+		{"Level", returns, func(l core.ISugaredLogger) { _ = l.Level().String() }},
+		{"Sync", returns, func(l core.ISugaredLogger) { _ = l.Sync() }}, // syncing stdout may fail; only panics count
+	}},
+}
+
+// errNilDerived is panicked by mustDerive so a nil derived logger is reported like any other panic
+type errNilDerived struct{}
+
+// mustDerive panics if a contextual method returned nil
+func mustDerive(l core.ISugaredLogger) core.ISugaredLogger {
+	if l == nil {
+		panic(errNilDerived{})
+	}
+	return l
+}
+
+// AssertSugaredLogger calls every method of every ISugaredLogger group
+// (basic, formatted, structured, line, contextual, control) and fails t if one misbehaves:
+// Panic variants must panic, DPanic variants may panic, everything else must return.
+// Loggers returned by With/WithLazy/Named/WithContext must be non-nil and usable.
+//
+// Fatal variants run under core.CaptureExit, so the adapter must exit through core.Exit
+// (or not exit at all); an adapter calling os.Exit directly kills the test binary.
+//
+// Example:
+//
+//	func TestAdapter_ImplementsSugaredLogger(t *testing.T) {
+//	    contract.AssertSugaredLogger(t, myadapter.NewNop())
+//	}
+func AssertSugaredLogger(t *testing.T, logger core.ISugaredLogger) {
+	t.Helper()
+	if logger == nil {
+		t.Fatal("contract: logger is nil")
+	}
+
+	for _, group := range methodGroups {
+		for _, c := range group.calls {
+			if problem := exercise(logger, c); problem != "" {
+				t.Errorf("%s: %s %s", group.name, c.name, problem)
+			}
+		}
+	}
+}
+
+// exercise runs one call and describes how it broke its expected behavior ("" if it did not)
+func exercise(logger core.ISugaredLogger, c call) string {
+	var (
+		recovered any
+		panicked  bool
+	)
+	run := func() {
+		defer func() {
+			recovered = recover()
+		}()
+		panicked = true
+		c.fn(logger)
+		panicked = false
+	}
+
+	if c.behavior == mayExit {
+		if _, exited := core.CaptureExit(run); exited {
+			return ""
+		}
+	} else {
+		run()
+	}
+
+	switch {
+	case panicked && recovered == (errNilDerived{}):
+		return "returned a nil logger"
+	case panicked && (c.behavior == returns || c.behavior == mayExit):
+		return fmt.Sprintf("panicked: %v", recovered)
+	case !panicked && c.behavior == panics:
+		return "did not panic"
+	}
+	return ""
+}
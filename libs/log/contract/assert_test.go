@@ -0,0 +1,57 @@
+package contract
+
+import (
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// brokenLogger implements only a few methods; the rest panic on the nil embedded logger
+type brokenLogger struct {
+	core.ISugaredLogger
+}
+
+func (brokenLogger) Panic(args ...any)                     {}
+func (brokenLogger) DPanic(args ...any)                    { panic("dpanic") }
+func (brokenLogger) Named(name string) core.ISugaredLogger { return nil }
+
+func findCall(t *testing.T, name string) call {
+	t.Helper()
+	for _, group := range methodGroups {
+		for _, c := range group.calls {
+			if c.name == name {
+				return c
+			}
+		}
+	}
+	t.Fatalf("no call named %s", name)
+	return call{}
+}
+
+func TestExercise_ReportsMisbehavior(t *testing.T) {
+	tests := []struct {
+		method string
+		want   string
+	}{
+		{"Panic", "did not panic"},
+		{"Named", "returned a nil logger"},
+		{"DPanic", ""},
+		{"Debugw", "panicked: runtime error: invalid memory address or nil pointer dereference"},
+	}
+	for _, tt := range tests {
+		if got := exercise(brokenLogger{}, findCall(t, tt.method)); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.method, tt.want, got)
+		}
+	}
+}
+
+func TestMethodGroups_CoverInterface(t *testing.T) {
+	// ISugaredLogger has 7 basic, 8 formatted, 8 structured, 8 line, 4 contextual and 3 control methods
+	total := 0
+	for _, group := range methodGroups {
+		total += len(group.calls)
+	}
+	if total != 38 {
+		t.Errorf("Expected 38 methods, got %d", total)
+	}
+}
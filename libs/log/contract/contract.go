@@ -0,0 +1,212 @@
+// Package contract checks that a logger emits entries the ingestion pipeline accepts:
+// one JSON object per line, with the required keys present and typed as expected.
+//
+// Run it from adapter tests so encoder changes that drop or retype a key fail CI:
+//
+//	p := contract.ECSProfile()
+//	contract.Verify(t, func(l core.ISugaredLogger) {
+//	    l.Infow("order placed", "order_id", 42)
+//	}, p.Required, p.Format(newLogger))
+package contract
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// Kind is the JSON type expected for a value
+type Kind string
+
+const (
+	String    Kind = "string"
+	Number    Kind = "number"
+	Bool      Kind = "bool"
+	Object    Kind = "object"
+	Array     Kind = "array"
+	Timestamp Kind = "timestamp" // string in RFC 3339 or ISO 8601 (zap) layout
+)
+
+// timestampLayouts are the layouts accepted for Timestamp values
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.000Z0700", // zapcore.ISO8601TimeEncoder
+}
+
+// Format describes the logger under test and the types its keys must have
+type Format struct {
+	// New builds the logger under test, writing JSON lines to w
+	New func(w io.Writer) (core.ISugaredLogger, error)
+	// Types maps keys to their expected kind; keys missing from an entry are not type-checked
+	Types map[string]Kind
+}
+
+// Profile is a canned set of required keys and types for a field-name preset
+type Profile struct {
+	Required []string
+	Types    map[string]Kind
+}
+
+// Format returns a Format checking p's types on loggers built by newLogger
+func (p Profile) Format(newLogger func(w io.Writer) (core.ISugaredLogger, error)) Format {
+	return Format{New: newLogger, Types: p.Types}
+}
+
+// DefaultProfile matches the default field names of the zap adapter
+func DefaultProfile() Profile {
+	return Profile{
+		Required: []string{"timestamp", "level", "msg", "service", "version", "env"},
+		Types: map[string]Kind{
+			"timestamp":  Timestamp,
+			"level":      String,
+			"msg":        String,
+			"logger":     String,
+			"caller":     String,
+			"stacktrace": String,
+			"service":    String,
+			"version":    String,
+			"commit":     String,
+			"env":        String,
+			"instance":   String,
+		},
+	}
+}
+
+// ECSProfile matches the Elastic Common Schema field names of the zap adapter
+func ECSProfile() Profile {
+	return Profile{
+		Required: []string{"@timestamp", "log.level", "message", "service.name", "service.version", "service.environment"},
+		Types: map[string]Kind{
+			"@timestamp":           Timestamp,
+			"log.level":            String,
+			"message":              String,
+			"log.logger":           String,
+			"log.origin.file.name": String,
+			"error.stack_trace":    String,
+			"service.name":         String,
+			"service.version":      String,
+			"service.environment":  String,
+			"service.node.name":    String,
+			"labels.commit":        String,
+		},
+	}
+}
+
+// Verify builds a logger with format.New writing to a buffer, lets produce log through it,
+// and reports every entry that is not a JSON object, misses a required key, or has a key
+// of the wrong type. Dotted keys match both flat ("log.level") and nested ({"log":{"level":...}}) JSON.
+// Fails if produce logs nothing.
+func Verify(t *testing.T, produce func(core.ISugaredLogger), required []string, format Format) {
+	t.Helper()
+	if format.New == nil {
+		t.Fatal("contract: Format.New is required")
+	}
+
+	var buf bytes.Buffer
+	logger, err := format.New(&buf)
+	if err != nil {
+		t.Fatalf("contract: failed to create logger: %v", err)
+	}
+	produce(logger)
+	logger.Sync()
+
+	for _, problem := range check(buf.Bytes(), required, format.Types) {
+		t.Error(problem)
+	}
+}
+
+// check validates every line of output and returns the problems found
+func check(output []byte, required []string, types map[string]Kind) []string {
+	text := strings.TrimSpace(string(output))
+	if text == "" {
+		return []string{"contract: no log entries were written"}
+	}
+
+	keys := make([]string, 0, len(types))
+	for key := range types {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var problems []string
+	for i, line := range strings.Split(text, "\n") {
+		var entry map[string]any
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			problems = append(problems, fmt.Sprintf("line %d: not a JSON object: %v: %s", i+1, err, line))
+			continue
+		}
+		for _, key := range required {
+			if _, ok := lookup(entry, key); !ok {
+				problems = append(problems, fmt.Sprintf("line %d: missing required key %q: %s", i+1, key, line))
+			}
+		}
+		for _, key := range keys {
+			value, ok := lookup(entry, key)
+			if !ok {
+				continue
+			}
+			if got, want := kindOf(value), types[key]; !matches(value, got, want) {
+				problems = append(problems, fmt.Sprintf("line %d: key %q: expected %s, got %s %v", i+1, key, want, got, value))
+			}
+		}
+	}
+	return problems
+}
+
+// lookup finds key as a flat key first, then as a dotted path through nested objects
+func lookup(entry map[string]any, key string) (any, bool) {
+	if value, ok := entry[key]; ok {
+		return value, true
+	}
+	head, rest, found := strings.Cut(key, ".")
+	if !found {
+		return nil, false
+	}
+	nested, ok := entry[head].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+	return lookup(nested, rest)
+}
+
+// kindOf returns the JSON kind of a decoded value
+func kindOf(value any) Kind {
+	switch value.(type) {
+	case string:
+		return String
+	case float64:
+		return Number
+	case bool:
+		return Bool
+	case map[string]any:
+		return Object
+	case []any:
+		return Array
+	default:
+		return "null"
+	}
+}
+
+// matches reports whether a value of kind got satisfies want
+func matches(value any, got, want Kind) bool {
+	if want != Timestamp {
+		return got == want
+	}
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	for _, layout := range timestampLayouts {
+		if _, err := time.Parse(layout, s); err == nil {
+			return true
+		}
+	}
+	return false
+}
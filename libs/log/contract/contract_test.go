@@ -0,0 +1,53 @@
+package contract
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheck_ValidEntries(t *testing.T) {
+	output := `{"timestamp":"2026-10-16T10:00:00.000+0700","level":"info","msg":"ok","service":"orders"}
+{"timestamp":"2026-10-16T03:00:00Z","level":"warn","msg":"slow","service":"orders","took":1.5}
+`
+	problems := check([]byte(output), []string{"timestamp", "level", "msg", "service"}, DefaultProfile().Types)
+	if len(problems) != 0 {
+		t.Errorf("Expected no problems, got %v", problems)
+	}
+}
+
+func TestCheck_ReportsProblems(t *testing.T) {
+	output := `{"timestamp":"yesterday","level":"info","msg":"ok"}
+not json
+{"timestamp":"2026-10-16T03:00:00Z","level":3,"msg":"ok","service":"orders"}
+`
+	problems := check([]byte(output), []string{"service"}, DefaultProfile().Types)
+
+	want := []string{
+		`line 1: missing required key "service"`,
+		`line 1: key "timestamp": expected timestamp`,
+		`line 2: not a JSON object`,
+		`line 3: key "level": expected string, got number`,
+	}
+	if len(problems) != len(want) {
+		t.Fatalf("Expected %d problems, got %v", len(want), problems)
+	}
+	for i, prefix := range want {
+		if !strings.HasPrefix(problems[i], prefix) {
+			t.Errorf("Expected problem %d to start with %q, got %q", i, prefix, problems[i])
+		}
+	}
+}
+
+func TestCheck_NestedDottedKeys(t *testing.T) {
+	output := `{"@timestamp":"2026-10-16T03:00:00Z","log":{"level":"info"},"message":"ok","service":{"name":"orders"}}`
+	problems := check([]byte(output), []string{"@timestamp", "log.level", "message", "service.name"}, ECSProfile().Types)
+	if len(problems) != 0 {
+		t.Errorf("Expected nested keys to match, got %v", problems)
+	}
+}
+
+func TestCheck_NoEntries(t *testing.T) {
+	if problems := check(nil, nil, nil); len(problems) != 1 {
+		t.Errorf("Expected an error for empty output, got %v", problems)
+	}
+}
@@ -0,0 +1,70 @@
+package contract
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+// lazyMarker is the value returned by the core.Lazy functions AssertLazyFields logs
+const lazyMarker = "lazy-value-computed"
+
+// AssertLazyFields checks that the logger honors core.Lazy key-value values:
+//   - the function is not called for entries below the logger's level
+//   - the function is called exactly once when a tee of two loggers writes the entry,
+//     and both outputs contain its result
+//
+// newLogger must build a logger whose level is above Debug, writing to w.
+//
+// Example:
+//
+//	func TestAdapter_LazyFields(t *testing.T) {
+//	    contract.AssertLazyFields(t, func(w io.Writer) (core.ISugaredLogger, error) {
+//	        return myadapter.New(w, core.InfoLevel)
+//	    })
+//	}
+func AssertLazyFields(t *testing.T, newLogger func(w io.Writer) (core.ISugaredLogger, error)) {
+	t.Helper()
+
+	var first, second bytes.Buffer
+	a, err := newLogger(&first)
+	if err != nil {
+		t.Fatalf("contract: failed to create logger: %v", err)
+	}
+	b, err := newLogger(&second)
+	if err != nil {
+		t.Fatalf("contract: failed to create logger: %v", err)
+	}
+	if a.Level() <= core.DebugLevel {
+		t.Fatalf("contract: AssertLazyFields needs a logger above Debug level, got %s", a.Level())
+	}
+	tee := core.NewTee(a, b)
+
+	var calls atomic.Int32
+	lazy := func() any {
+		calls.Add(1)
+		return lazyMarker
+	}
+
+	tee.Debugw("disabled", "value", core.Lazy(lazy))
+	tee.Logw(core.DebugLevel, "disabled", "value", core.Lazy(lazy))
+	_ = tee.Sync()
+	if n := calls.Load(); n != 0 {
+		t.Errorf("lazy: function called %d times for a disabled level, want 0", n)
+	}
+
+	tee.Infow("enabled", "value", core.Lazy(lazy))
+	_ = tee.Sync()
+	if n := calls.Load(); n != 1 {
+		t.Errorf("lazy: function called %d times for a tee'd entry, want 1", n)
+	}
+	for i, out := range []*bytes.Buffer{&first, &second} {
+		if !strings.Contains(out.String(), lazyMarker) {
+			t.Errorf("lazy: output %d does not contain the lazy value: %s", i+1, out.String())
+		}
+	}
+}
@@ -0,0 +1,416 @@
+package core
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// adaptiveBuckets is the number of per-second error buckets, one minute of history.
+const adaptiveBuckets = 60
+
+// AdaptivePolicy configures NewAdaptive.
+type AdaptivePolicy struct {
+	// Level is the minimum level outside escalation. Default InfoLevel.
+	Level Level
+
+	// EscalateTo is the minimum level during escalation, usually DebugLevel.
+	EscalateTo Level
+
+	// ErrorThresholdPerMinute is the number of Error and above entries within the last minute
+	// that escalates verbosity. Values < 1 disable automatic escalation (ForceEscalate still works).
+	ErrorThresholdPerMinute int
+
+	// Window is how long an automatic escalation lasts.
+	Window time.Duration
+
+	// Cooldown is how long after an escalation ends before the error rate can escalate again,
+	// so a steady error stream does not flap between verbose and quiet.
+	Cooldown time.Duration
+}
+
+// AdaptiveOption configures NewAdaptive.
+type AdaptiveOption func(*AdaptiveLogger)
+
+// WithAdaptiveClock replaces time.Now, e.g. with a fake clock in tests.
+func WithAdaptiveClock(now func() time.Time) AdaptiveOption {
+	return func(l *AdaptiveLogger) {
+		l.state.now = now
+	}
+}
+
+// NewAdaptive decorates logger so its minimum level drops from policy.Level to policy.EscalateTo
+// for policy.Window once Error and above entries reach policy.ErrorThresholdPerMinute,
+// capturing debug detail while an incident is happening.
+//
+// Behavior:
+//   - Entries below the effective level (see Level) are dropped by the decorator,
+//     so logger itself must be built at EscalateTo or lower
+//   - Errors are counted in per-second buckets over a sliding minute, without locking
+//   - Escalating writes a Warn "verbosity escalated" entry; the first entry after the window
+//     ends writes "verbosity restored" before it
+//   - After a window ends, the error rate cannot escalate again until Cooldown has passed
+//   - ForceEscalate escalates on demand, ignoring the threshold and Cooldown
+//   - Loggers derived with With, Named and WithContext share the escalation state
+//
+// Example:
+//
+//	base, _ := zapadapter.NewWithConfig(zapadapter.Config{Level: core.DebugLevel})
+//	logger := core.NewAdaptive(base, core.AdaptivePolicy{
+//	    EscalateTo:              core.DebugLevel,
+//	    ErrorThresholdPerMinute: 50,
+//	    Window:                  5 * time.Minute,
+//	    Cooldown:                10 * time.Minute,
+//	})
+//
+//	logger.Debugw("cache miss", "key", k) // dropped until 50 errors within a minute
+func NewAdaptive(logger ISugaredLogger, policy AdaptivePolicy, opts ...AdaptiveOption) *AdaptiveLogger {
+	l := &AdaptiveLogger{
+		ISugaredLogger: logger,
+		state: &adaptiveState{
+			policy: policy,
+			marker: logger,
+			now:    time.Now,
+		},
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// AdaptiveLogger is created with NewAdaptive.
+// Sync and Desugar are served by the embedded logger.
+type AdaptiveLogger struct {
+	ISugaredLogger
+	state *adaptiveState
+}
+
+// ForceEscalate lowers the minimum level to EscalateTo for d from now, replacing any
+// escalation in progress; d <= 0 restores the normal level immediately.
+// Meant for operator control, e.g. from an admin endpoint.
+func (l *AdaptiveLogger) ForceEscalate(d time.Duration) {
+	l.state.forceEscalate(d)
+}
+
+// Escalated reports whether verbosity is currently escalated.
+func (l *AdaptiveLogger) Escalated() bool {
+	return l.state.escalated(l.state.now().UnixNano())
+}
+
+// Level returns the effective minimum level: EscalateTo while escalated, otherwise policy.Level.
+func (l *AdaptiveLogger) Level() Level {
+	if l.Escalated() {
+		return l.state.policy.EscalateTo
+	}
+	return l.state.policy.Level
+}
+
+// adaptiveState is shared by an adaptive logger and every logger derived from it.
+type adaptiveState struct {
+	policy AdaptivePolicy
+	marker ISugaredLogger // root logger, so markers carry no derived fields
+	now    func() time.Time
+	errors errorCounter
+
+	until      atomic.Int64 // unix nanos the escalation ends at; 0 when not escalated
+	blockUntil atomic.Int64 // unix nanos before which the error rate cannot escalate
+
+	mu sync.Mutex // serializes escalate and restore transitions and their markers
+}
+
+// allow reports whether an entry at level is written, counting errors and escalating
+// or restoring first.
+func (s *adaptiveState) allow(level Level) bool {
+	now := s.now()
+	nanos := now.UnixNano()
+
+	if until := s.until.Load(); until != 0 && nanos >= until {
+		s.restore(until)
+	}
+	if level >= ErrorLevel && s.policy.ErrorThresholdPerMinute > 0 {
+		count := s.errors.add(now.Unix())
+		if count >= s.policy.ErrorThresholdPerMinute && !s.escalated(nanos) && nanos >= s.blockUntil.Load() {
+			s.escalate(now, count)
+		}
+	}
+
+	if s.escalated(nanos) {
+		return level >= s.policy.EscalateTo
+	}
+	return level >= s.policy.Level
+}
+
+func (s *adaptiveState) escalated(nanos int64) bool {
+	until := s.until.Load()
+	return until != 0 && nanos < until
+}
+
+// escalate starts an automatic escalation window at now.
+func (s *adaptiveState) escalate(now time.Time, count int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.escalated(now.UnixNano()) || now.UnixNano() < s.blockUntil.Load() {
+		return // another goroutine escalated first
+	}
+	until := now.Add(s.policy.Window)
+	s.until.Store(until.UnixNano())
+	s.blockUntil.Store(until.Add(s.policy.Cooldown).UnixNano())
+	s.marker.Warnw("verbosity escalated",
+		"level", s.policy.EscalateTo.String(),
+		"until", until,
+		"reason", "error_rate",
+		"errors_per_minute", count,
+	)
+}
+
+// forceEscalate starts or replaces an escalation lasting d, or restores when d <= 0.
+func (s *adaptiveState) forceEscalate(d time.Duration) {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if d <= 0 {
+		if until := s.until.Load(); until != 0 {
+			s.restoreLocked("manual")
+		}
+		return
+	}
+	until := now.Add(d)
+	s.until.Store(until.UnixNano())
+	s.marker.Warnw("verbosity escalated",
+		"level", s.policy.EscalateTo.String(),
+		"until", until,
+		"reason", "manual",
+	)
+}
+
+// restore ends the escalation that was due to end at until, unless it was already replaced.
+func (s *adaptiveState) restore(until int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.until.Load() == until {
+		s.restoreLocked("window_elapsed")
+	}
+}
+
+func (s *adaptiveState) restoreLocked(reason string) {
+	s.until.Store(0)
+	s.marker.Warnw("verbosity restored",
+		"level", s.policy.Level.String(),
+		"reason", reason,
+	)
+}
+
+// errorCounter counts entries in per-second buckets over the last minute.
+// Buckets are claimed by swapping in their second, so a bucket reused concurrently
+// may lose a few counts; that is fine for a threshold.
+type errorCounter struct {
+	buckets [adaptiveBuckets]errorBucket
+}
+
+type errorBucket struct {
+	second atomic.Int64
+	count  atomic.Int64
+}
+
+// add counts one entry in second and returns the total over the minute ending at second.
+func (c *errorCounter) add(second int64) int {
+	b := &c.buckets[second%adaptiveBuckets]
+	if old := b.second.Load(); old != second && b.second.CompareAndSwap(old, second) {
+		b.count.Store(0)
+	}
+	b.count.Add(1)
+
+	var total int64
+	for i := range c.buckets {
+		if s := c.buckets[i].second.Load(); s <= second && second-s < adaptiveBuckets {
+			total += c.buckets[i].count.Load()
+		}
+	}
+	return int(total)
+}
+
+// IBasicLogger implementation
+func (l *AdaptiveLogger) Debug(args ...any) {
+	if l.state.allow(DebugLevel) {
+		l.ISugaredLogger.Debug(args...)
+	}
+}
+func (l *AdaptiveLogger) Info(args ...any) {
+	if l.state.allow(InfoLevel) {
+		l.ISugaredLogger.Info(args...)
+	}
+}
+func (l *AdaptiveLogger) Warn(args ...any) {
+	if l.state.allow(WarnLevel) {
+		l.ISugaredLogger.Warn(args...)
+	}
+}
+func (l *AdaptiveLogger) Error(args ...any) {
+	if l.state.allow(ErrorLevel) {
+		l.ISugaredLogger.Error(args...)
+	}
+}
+func (l *AdaptiveLogger) DPanic(args ...any) {
+	if l.state.allow(DPanicLevel) {
+		l.ISugaredLogger.DPanic(args...)
+	}
+}
+func (l *AdaptiveLogger) Panic(args ...any) {
+	if l.state.allow(PanicLevel) {
+		l.ISugaredLogger.Panic(args...)
+	}
+}
+func (l *AdaptiveLogger) Fatal(args ...any) {
+	if l.state.allow(FatalLevel) {
+		l.ISugaredLogger.Fatal(args...)
+	}
+}
+
+// IFormattedLogger implementation
+func (l *AdaptiveLogger) Debugf(template string, args ...any) {
+	if l.state.allow(DebugLevel) {
+		l.ISugaredLogger.Debugf(template, args...)
+	}
+}
+func (l *AdaptiveLogger) Infof(template string, args ...any) {
+	if l.state.allow(InfoLevel) {
+		l.ISugaredLogger.Infof(template, args...)
+	}
+}
+func (l *AdaptiveLogger) Warnf(template string, args ...any) {
+	if l.state.allow(WarnLevel) {
+		l.ISugaredLogger.Warnf(template, args...)
+	}
+}
+func (l *AdaptiveLogger) Errorf(template string, args ...any) {
+	if l.state.allow(ErrorLevel) {
+		l.ISugaredLogger.Errorf(template, args...)
+	}
+}
+func (l *AdaptiveLogger) DPanicf(template string, args ...any) {
+	if l.state.allow(DPanicLevel) {
+		l.ISugaredLogger.DPanicf(template, args...)
+	}
+}
+func (l *AdaptiveLogger) Panicf(template string, args ...any) {
+	if l.state.allow(PanicLevel) {
+		l.ISugaredLogger.Panicf(template, args...)
+	}
+}
+func (l *AdaptiveLogger) Fatalf(template string, args ...any) {
+	if l.state.allow(FatalLevel) {
+		l.ISugaredLogger.Fatalf(template, args...)
+	}
+}
+func (l *AdaptiveLogger) Logf(level Level, template string, args ...any) {
+	if l.state.allow(level) {
+		l.ISugaredLogger.Logf(level, template, args...)
+	}
+}
+
+// IStructuredLogger implementation
+func (l *AdaptiveLogger) Debugw(msg string, keysAndValues ...any) {
+	if l.state.allow(DebugLevel) {
+		l.ISugaredLogger.Debugw(msg, keysAndValues...)
+	}
+}
+func (l *AdaptiveLogger) Infow(msg string, keysAndValues ...any) {
+	if l.state.allow(InfoLevel) {
+		l.ISugaredLogger.Infow(msg, keysAndValues...)
+	}
+}
+func (l *AdaptiveLogger) Warnw(msg string, keysAndValues ...any) {
+	if l.state.allow(WarnLevel) {
+		l.ISugaredLogger.Warnw(msg, keysAndValues...)
+	}
+}
+func (l *AdaptiveLogger) Errorw(msg string, keysAndValues ...any) {
+	if l.state.allow(ErrorLevel) {
+		l.ISugaredLogger.Errorw(msg, keysAndValues...)
+	}
+}
+func (l *AdaptiveLogger) DPanicw(msg string, keysAndValues ...any) {
+	if l.state.allow(DPanicLevel) {
+		l.ISugaredLogger.DPanicw(msg, keysAndValues...)
+	}
+}
+func (l *AdaptiveLogger) Panicw(msg string, keysAndValues ...any) {
+	if l.state.allow(PanicLevel) {
+		l.ISugaredLogger.Panicw(msg, keysAndValues...)
+	}
+}
+func (l *AdaptiveLogger) Fatalw(msg string, keysAndValues ...any) {
+	if l.state.allow(FatalLevel) {
+		l.ISugaredLogger.Fatalw(msg, keysAndValues...)
+	}
+}
+func (l *AdaptiveLogger) Logw(level Level, msg string, keysAndValues ...any) {
+	if l.state.allow(level) {
+		l.ISugaredLogger.Logw(level, msg, keysAndValues...)
+	}
+}
+
+// ILineLogger implementation
+func (l *AdaptiveLogger) Debugln(args ...any) {
+	if l.state.allow(DebugLevel) {
+		l.ISugaredLogger.Debugln(args...)
+	}
+}
+func (l *AdaptiveLogger) Infoln(args ...any) {
+	if l.state.allow(InfoLevel) {
+		l.ISugaredLogger.Infoln(args...)
+	}
+}
+func (l *AdaptiveLogger) Warnln(args ...any) {
+	if l.state.allow(WarnLevel) {
+		l.ISugaredLogger.Warnln(args...)
+	}
+}
+func (l *AdaptiveLogger) Errorln(args ...any) {
+	if l.state.allow(ErrorLevel) {
+		l.ISugaredLogger.Errorln(args...)
+	}
+}
+func (l *AdaptiveLogger) DPanicln(args ...any) {
+	if l.state.allow(DPanicLevel) {
+		l.ISugaredLogger.DPanicln(args...)
+	}
+}
+func (l *AdaptiveLogger) Panicln(args ...any) {
+	if l.state.allow(PanicLevel) {
+		l.ISugaredLogger.Panicln(args...)
+	}
+}
+func (l *AdaptiveLogger) Fatalln(args ...any) {
+	if l.state.allow(FatalLevel) {
+		l.ISugaredLogger.Fatalln(args...)
+	}
+}
+func (l *AdaptiveLogger) Logln(level Level, args ...any) {
+	if l.state.allow(level) {
+		l.ISugaredLogger.Logln(level, args...)
+	}
+}
+
+// IContextualLogger implementation - derived loggers share the escalation state
+func (l *AdaptiveLogger) With(args ...any) ISugaredLogger {
+	return &AdaptiveLogger{ISugaredLogger: l.ISugaredLogger.With(args...), state: l.state}
+}
+
+func (l *AdaptiveLogger) WithLazy(args ...any) ISugaredLogger {
+	return &AdaptiveLogger{ISugaredLogger: l.ISugaredLogger.WithLazy(args...), state: l.state}
+}
+
+func (l *AdaptiveLogger) Named(name string) ISugaredLogger {
+	return &AdaptiveLogger{ISugaredLogger: l.ISugaredLogger.Named(name), state: l.state}
+}
+
+// IContextLogger implementation
+func (l *AdaptiveLogger) WithContext(ctx any) ISugaredLogger {
+	return &AdaptiveLogger{ISugaredLogger: l.ISugaredLogger.WithContext(ctx), state: l.state}
+}
@@ -0,0 +1,225 @@
+package core_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/contract"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// fakeClock is a manually advanced clock for WithAdaptiveClock
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+var testPolicy = core.AdaptivePolicy{
+	Level:                   core.InfoLevel,
+	EscalateTo:              core.DebugLevel,
+	ErrorThresholdPerMinute: 3,
+	Window:                  time.Minute,
+	Cooldown:                5 * time.Minute,
+}
+
+func newAdaptive(policy core.AdaptivePolicy) (*core.AdaptiveLogger, *observer.ObservedLogs, *fakeClock) {
+	base, logs := newObservedLogger()
+	clock := newFakeClock()
+	return core.NewAdaptive(base, policy, core.WithAdaptiveClock(clock.Now)), logs, clock
+}
+
+func observedMessages(logs *observer.ObservedLogs) []string {
+	var out []string
+	for _, entry := range logs.TakeAll() {
+		out = append(out, entry.Message)
+	}
+	return out
+}
+
+func assertMessages(t *testing.T, logs *observer.ObservedLogs, want ...string) {
+	t.Helper()
+	got := observedMessages(logs)
+	if len(got) != len(want) {
+		t.Fatalf("Expected %q, got %q", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Expected %q, got %q", want, got)
+		}
+	}
+}
+
+func TestAdaptive_ImplementsSugaredLogger(t *testing.T) {
+	logger, _, _ := newAdaptive(testPolicy)
+	contract.AssertSugaredLogger(t, logger)
+}
+
+func TestAdaptive_FiltersBelowNormalLevel(t *testing.T) {
+	logger, logs, _ := newAdaptive(testPolicy)
+
+	logger.Debug("hidden")
+	logger.Info("shown")
+
+	assertMessages(t, logs, "shown")
+	if logger.Level() != core.InfoLevel {
+		t.Errorf("Expected Info level, got %s", logger.Level())
+	}
+}
+
+func TestAdaptive_EscalatesOnErrorBurstAndRestores(t *testing.T) {
+	logger, logs, clock := newAdaptive(testPolicy)
+
+	// Burst: 3 errors within a minute crosses the threshold
+	logger.Error("db timeout")
+	clock.Advance(10 * time.Second)
+	logger.Error("db timeout")
+	clock.Advance(10 * time.Second)
+	logger.Error("db timeout")
+
+	entries := logs.All()
+	if len(entries) != 4 || entries[2].Message != "verbosity escalated" {
+		t.Fatalf("Expected marker before the third error, got %d entries", len(entries))
+	}
+	marker := entries[2].ContextMap()
+	if marker["level"] != "DEBUG" || marker["reason"] != "error_rate" || marker["errors_per_minute"] != int64(3) {
+		t.Errorf("Unexpected marker fields: %v", marker)
+	}
+	logs.TakeAll()
+
+	if !logger.Escalated() || logger.Level() != core.DebugLevel {
+		t.Fatalf("Expected escalation to Debug, got escalated=%v level=%s", logger.Escalated(), logger.Level())
+	}
+
+	// Debug entries are written during the window, including from derived loggers
+	clock.Advance(30 * time.Second)
+	logger.Debug("query plan")
+	logger.Named("repo").With("table", "orders").Debugw("row locked")
+	assertMessages(t, logs, "query plan", "row locked")
+
+	// Window elapsed: the next entry restores first
+	clock.Advance(31 * time.Second)
+	if logger.Escalated() {
+		t.Error("Expected window to have elapsed")
+	}
+	logger.Debug("hidden")
+	logger.Info("back to normal")
+	assertMessages(t, logs, "verbosity restored", "back to normal")
+}
+
+func TestAdaptive_BelowThresholdDoesNotEscalate(t *testing.T) {
+	logger, logs, clock := newAdaptive(testPolicy)
+
+	// 3 errors, but spread over more than a minute
+	logger.Error("e1")
+	clock.Advance(40 * time.Second)
+	logger.Error("e2")
+	clock.Advance(40 * time.Second)
+	logger.Error("e3")
+	logger.Debug("hidden")
+
+	assertMessages(t, logs, "e1", "e2", "e3")
+	if logger.Escalated() {
+		t.Error("Expected no escalation")
+	}
+}
+
+func TestAdaptive_CooldownPreventsReescalation(t *testing.T) {
+	logger, logs, clock := newAdaptive(testPolicy)
+	burst := func() {
+		for i := 0; i < 3; i++ {
+			logger.Error("db timeout")
+		}
+	}
+
+	burst()
+	clock.Advance(time.Minute) // window elapsed
+	burst()
+	if logger.Escalated() {
+		t.Fatal("Expected no escalation during cooldown")
+	}
+	logs.TakeAll()
+
+	clock.Advance(5 * time.Minute) // cooldown elapsed
+	burst()
+	if !logger.Escalated() {
+		t.Fatal("Expected escalation after cooldown")
+	}
+	assertMessages(t, logs, "db timeout", "db timeout", "verbosity escalated", "db timeout")
+}
+
+func TestAdaptive_ForceEscalate(t *testing.T) {
+	logger, logs, clock := newAdaptive(testPolicy)
+
+	logger.ForceEscalate(10 * time.Minute)
+	logger.Debug("visible")
+
+	entries := logs.TakeAll()
+	if len(entries) != 2 || entries[0].Message != "verbosity escalated" || entries[0].ContextMap()["reason"] != "manual" {
+		t.Fatalf("Expected manual marker then debug entry, got %v", entries)
+	}
+
+	clock.Advance(9 * time.Minute)
+	logger.Debug("still visible")
+	logger.ForceEscalate(0)
+	logger.Debug("hidden")
+	assertMessages(t, logs, "still visible", "verbosity restored")
+}
+
+func TestAdaptive_ThresholdDisabled(t *testing.T) {
+	policy := testPolicy
+	policy.ErrorThresholdPerMinute = 0
+	logger, _, _ := newAdaptive(policy)
+
+	for i := 0; i < 100; i++ {
+		logger.Error("boom")
+	}
+	if logger.Escalated() {
+		t.Error("Expected no automatic escalation with a zero threshold")
+	}
+}
+
+func TestAdaptive_ConcurrentErrors(t *testing.T) {
+	policy := testPolicy
+	policy.ErrorThresholdPerMinute = 50
+	logger, logs, _ := newAdaptive(policy)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				logger.Errorw("boom", "worker", j)
+			}
+		}()
+	}
+	wg.Wait()
+
+	markers := 0
+	for _, entry := range logs.All() {
+		if entry.Message == "verbosity escalated" {
+			markers++
+		}
+	}
+	if markers != 1 || !logger.Escalated() {
+		t.Errorf("Expected exactly one escalation, got %d markers", markers)
+	}
+}
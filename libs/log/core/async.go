@@ -0,0 +1,258 @@
+package core
+
+import "sync"
+
+// AsyncLogger is an ISugaredLogger that hands entries to a background goroutine,
+// so callers do not wait on slow sinks.
+//
+// Ordering contract:
+//   - A root AsyncLogger and every logger derived from it (With/WithLazy/Named/WithContext)
+//     share one FIFO queue, so entries are written in the order they were submitted,
+//     across all of them, and each goroutine's entries keep their order
+//   - Flush is a barrier: it returns once every entry submitted before it, by any logger
+//     sharing the root, has been written to the wrapped logger
+//   - Sync flushes, then syncs the wrapped logger; SyncAll flushes every barrier before syncing
+//   - DPanic, Panic and Fatal entries flush and then log on the caller's goroutine,
+//     so they panic or exit where they are called, after everything logged before them
+//
+// Arguments are formatted on the background goroutine: values mutated after the call
+// (maps, pointers) may be logged with their new contents.
+// Safe for concurrent use.
+//
+// Example:
+//
+//	logger := core.NewAsync(fileLogger, 1024)
+//	defer logger.Close()
+//	repo := logger.Named("repo") // shares the queue
+//
+//	repo.Info("flushed 42 rows")
+//	logger.Info("shutting down") // always written after "flushed 42 rows"
+type AsyncLogger struct {
+	queue  *asyncQueue
+	logger ISugaredLogger
+}
+
+var _ ISugaredLogger = (*AsyncLogger)(nil)
+
+// asyncQueue is the queue shared by a root AsyncLogger and its derived loggers.
+type asyncQueue struct {
+	mu      sync.RWMutex // held for reading while submitting, for writing while closing
+	closed  bool
+	entries chan asyncEntry
+	done    chan struct{}
+}
+
+// asyncEntry is one queued write, or a flush barrier when barrier is non-nil.
+type asyncEntry struct {
+	write   func()
+	barrier chan struct{}
+}
+
+// NewAsync creates an AsyncLogger writing to logger through a queue of bufferSize entries.
+// Callers block when the queue is full; entries are never dropped. bufferSize < 1 means 1.
+// Call Close to stop the background goroutine.
+func NewAsync(logger ISugaredLogger, bufferSize int) *AsyncLogger {
+	if bufferSize < 1 {
+		bufferSize = 1
+	}
+	q := &asyncQueue{
+		entries: make(chan asyncEntry, bufferSize),
+		done:    make(chan struct{}),
+	}
+	go q.run()
+	return &AsyncLogger{queue: q, logger: logger}
+}
+
+// run writes queued entries in order until the queue is closed.
+func (q *asyncQueue) run() {
+	defer close(q.done)
+	for e := range q.entries {
+		if e.write != nil {
+			e.write()
+		}
+		if e.barrier != nil {
+			close(e.barrier)
+		}
+	}
+}
+
+// submit queues write, or runs it directly once the queue is closed.
+func (q *asyncQueue) submit(write func()) {
+	q.mu.RLock()
+	if q.closed {
+		q.mu.RUnlock()
+		write()
+		return
+	}
+	q.entries <- asyncEntry{write: write}
+	q.mu.RUnlock()
+}
+
+// flush waits until every entry queued before it has been written.
+func (q *asyncQueue) flush() {
+	q.mu.RLock()
+	if q.closed {
+		q.mu.RUnlock()
+		return
+	}
+	barrier := make(chan struct{})
+	q.entries <- asyncEntry{barrier: barrier}
+	q.mu.RUnlock()
+	<-barrier
+}
+
+// close writes the remaining entries and stops the background goroutine.
+func (q *asyncQueue) close() {
+	q.mu.Lock()
+	if !q.closed {
+		q.closed = true
+		close(q.entries)
+	}
+	q.mu.Unlock()
+	<-q.done
+}
+
+// Flush waits until every entry submitted before it, by this logger or any logger sharing its root,
+// has been written to the wrapped logger. It does not sync the wrapped logger (see Sync).
+func (a *AsyncLogger) Flush() {
+	a.queue.flush()
+}
+
+// Close flushes the queue and stops the background goroutine for the root and every derived logger.
+// Entries logged after Close are written synchronously.
+func (a *AsyncLogger) Close() error {
+	a.queue.close()
+	return a.logger.Sync()
+}
+
+// async queues a call on the wrapped logger.
+func (a *AsyncLogger) async(fn func(ISugaredLogger)) {
+	logger := a.logger
+	a.queue.submit(func() { fn(logger) })
+}
+
+// sync flushes, then calls the wrapped logger on the caller's goroutine.
+func (a *AsyncLogger) sync(fn func(ISugaredLogger)) {
+	a.queue.flush()
+	fn(a.logger)
+}
+
+// atLevel queues the call, or runs it synchronously for DPanic and above.
+func (a *AsyncLogger) atLevel(level Level, fn func(ISugaredLogger)) {
+	if level >= DPanicLevel {
+		a.sync(fn)
+		return
+	}
+	a.async(fn)
+}
+
+// derived returns a logger sharing the queue that wraps next.
+func (a *AsyncLogger) derived(next ISugaredLogger) *AsyncLogger {
+	return &AsyncLogger{queue: a.queue, logger: next}
+}
+
+// IBasicLogger implementation
+func (a *AsyncLogger) Debug(args ...any)  { a.async(func(l ISugaredLogger) { l.Debug(args...) }) }
+func (a *AsyncLogger) Info(args ...any)   { a.async(func(l ISugaredLogger) { l.Info(args...) }) }
+func (a *AsyncLogger) Warn(args ...any)   { a.async(func(l ISugaredLogger) { l.Warn(args...) }) }
+func (a *AsyncLogger) Error(args ...any)  { a.async(func(l ISugaredLogger) { l.Error(args...) }) }
+func (a *AsyncLogger) DPanic(args ...any) { a.sync(func(l ISugaredLogger) { l.DPanic(args...) }) }
+func (a *AsyncLogger) Panic(args ...any)  { a.sync(func(l ISugaredLogger) { l.Panic(args...) }) }
+func (a *AsyncLogger) Fatal(args ...any)  { a.sync(func(l ISugaredLogger) { l.Fatal(args...) }) }
+
+// IFormattedLogger implementation
+func (a *AsyncLogger) Debugf(template string, args ...any) {
+	a.async(func(l ISugaredLogger) { l.Debugf(template, args...) })
+}
+func (a *AsyncLogger) Infof(template string, args ...any) {
+	a.async(func(l ISugaredLogger) { l.Infof(template, args...) })
+}
+func (a *AsyncLogger) Warnf(template string, args ...any) {
+	a.async(func(l ISugaredLogger) { l.Warnf(template, args...) })
+}
+func (a *AsyncLogger) Errorf(template string, args ...any) {
+	a.async(func(l ISugaredLogger) { l.Errorf(template, args...) })
+}
+func (a *AsyncLogger) DPanicf(template string, args ...any) {
+	a.sync(func(l ISugaredLogger) { l.DPanicf(template, args...) })
+}
+func (a *AsyncLogger) Panicf(template string, args ...any) {
+	a.sync(func(l ISugaredLogger) { l.Panicf(template, args...) })
+}
+func (a *AsyncLogger) Fatalf(template string, args ...any) {
+	a.sync(func(l ISugaredLogger) { l.Fatalf(template, args...) })
+}
+func (a *AsyncLogger) Logf(level Level, template string, args ...any) {
+	a.atLevel(level, func(l ISugaredLogger) { l.Logf(level, template, args...) })
+}
+
+// IStructuredLogger implementation
+func (a *AsyncLogger) Debugw(msg string, keysAndValues ...any) {
+	a.async(func(l ISugaredLogger) { l.Debugw(msg, keysAndValues...) })
+}
+func (a *AsyncLogger) Infow(msg string, keysAndValues ...any) {
+	a.async(func(l ISugaredLogger) { l.Infow(msg, keysAndValues...) })
+}
+func (a *AsyncLogger) Warnw(msg string, keysAndValues ...any) {
+	a.async(func(l ISugaredLogger) { l.Warnw(msg, keysAndValues...) })
+}
+func (a *AsyncLogger) Errorw(msg string, keysAndValues ...any) {
+	a.async(func(l ISugaredLogger) { l.Errorw(msg, keysAndValues...) })
+}
+func (a *AsyncLogger) DPanicw(msg string, keysAndValues ...any) {
+	a.sync(func(l ISugaredLogger) { l.DPanicw(msg, keysAndValues...) })
+}
+func (a *AsyncLogger) Panicw(msg string, keysAndValues ...any) {
+	a.sync(func(l ISugaredLogger) { l.Panicw(msg, keysAndValues...) })
+}
+func (a *AsyncLogger) Fatalw(msg string, keysAndValues ...any) {
+	a.sync(func(l ISugaredLogger) { l.Fatalw(msg, keysAndValues...) })
+}
+func (a *AsyncLogger) Logw(level Level, msg string, keysAndValues ...any) {
+	a.atLevel(level, func(l ISugaredLogger) { l.Logw(level, msg, keysAndValues...) })
+}
+
+// ILineLogger implementation
+func (a *AsyncLogger) Debugln(args ...any)  { a.async(func(l ISugaredLogger) { l.Debugln(args...) }) }
+func (a *AsyncLogger) Infoln(args ...any)   { a.async(func(l ISugaredLogger) { l.Infoln(args...) }) }
+func (a *AsyncLogger) Warnln(args ...any)   { a.async(func(l ISugaredLogger) { l.Warnln(args...) }) }
+func (a *AsyncLogger) Errorln(args ...any)  { a.async(func(l ISugaredLogger) { l.Errorln(args...) }) }
+func (a *AsyncLogger) DPanicln(args ...any) { a.sync(func(l ISugaredLogger) { l.DPanicln(args...) }) }
+func (a *AsyncLogger) Panicln(args ...any)  { a.sync(func(l ISugaredLogger) { l.Panicln(args...) }) }
+func (a *AsyncLogger) Fatalln(args ...any)  { a.sync(func(l ISugaredLogger) { l.Fatalln(args...) }) }
+func (a *AsyncLogger) Logln(level Level, args ...any) {
+	a.atLevel(level, func(l ISugaredLogger) { l.Logln(level, args...) })
+}
+
+// IContextualLogger implementation - derived loggers share the queue
+func (a *AsyncLogger) With(args ...any) ISugaredLogger {
+	return a.derived(a.logger.With(args...))
+}
+
+func (a *AsyncLogger) WithLazy(args ...any) ISugaredLogger {
+	return a.derived(a.logger.WithLazy(args...))
+}
+
+func (a *AsyncLogger) Named(name string) ISugaredLogger {
+	return a.derived(a.logger.Named(name))
+}
+
+// IContextLogger implementation
+func (a *AsyncLogger) WithContext(ctx any) ISugaredLogger {
+	return a.derived(a.logger.WithContext(ctx))
+}
+
+// ILoggerControl implementation
+func (a *AsyncLogger) Desugar() any {
+	return a.logger.Desugar()
+}
+
+func (a *AsyncLogger) Level() Level {
+	return a.logger.Level()
+}
+
+// Sync flushes the shared queue, then syncs the wrapped logger.
+func (a *AsyncLogger) Sync() error {
+	a.queue.flush()
+	return a.logger.Sync()
+}
@@ -0,0 +1,140 @@
+package core_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/contract"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestAsyncLogger_ImplementsSugaredLogger(t *testing.T) {
+	base, _ := newObservedLogger()
+	logger := core.NewAsync(base, 8)
+	defer logger.Close()
+	contract.AssertSugaredLogger(t, logger)
+}
+
+func TestAsyncLogger_SyncFlushesEntriesFromSharedRoot(t *testing.T) {
+	base, logs := newObservedLogger()
+	root := core.NewAsync(base, 16)
+	defer root.Close()
+	child := root.Named("repo")
+
+	child.Info("flushed rows")
+	root.Info("shutting down")
+	if err := child.Sync(); err != nil {
+		t.Fatalf("Sync failed: %v", err)
+	}
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries after Sync, got %d", len(entries))
+	}
+	if entries[0].Message != "flushed rows" || entries[0].LoggerName != "repo" || entries[1].Message != "shutting down" {
+		t.Errorf("Expected child entry before root entry, got %q then %q", entries[0].Message, entries[1].Message)
+	}
+}
+
+func TestAsyncLogger_PanicFlushesFirst(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.NewAsync(base, 16)
+	defer logger.Close()
+
+	logger.Info("before")
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected Panic to panic on the caller's goroutine")
+			}
+		}()
+		logger.Panic("boom")
+	}()
+
+	entries := logs.All()
+	if len(entries) != 2 || entries[0].Message != "before" || entries[1].Message != "boom" {
+		t.Errorf("Expected [before boom], got %v", entries)
+	}
+}
+
+func TestAsyncLogger_CloseWritesSynchronously(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.NewAsync(base, 16)
+	child := logger.With("k", "v")
+
+	logger.Info("queued")
+	if err := logger.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if logs.Len() != 1 {
+		t.Fatalf("Expected Close to flush, got %d entries", logs.Len())
+	}
+
+	child.Info("after close")
+	if logs.Len() != 2 {
+		t.Errorf("Expected entries after Close to be written immediately, got %d", logs.Len())
+	}
+	logger.Close() // idempotent
+}
+
+// TestSyncAll_OrderingStress stacks async over tee, writes concurrently from a root logger
+// and three Named children, then checks that SyncAll leaves every entry in both sinks
+// exactly once, with each writer's order preserved and the shutdown line last.
+func TestSyncAll_OrderingStress(t *testing.T) {
+	const perWriter = 500
+
+	first, firstLogs := newObservedLogger()
+	second, secondLogs := newObservedLogger()
+	root := core.NewAsync(core.NewTee(first, second), 16)
+	defer root.Close()
+
+	writers := map[string]core.ISugaredLogger{"root": root}
+	for _, name := range []string{"db", "http", "cache"} {
+		writers[name] = root.Named(name)
+	}
+
+	var wg sync.WaitGroup
+	for name, logger := range writers {
+		wg.Add(1)
+		go func(name string, logger core.ISugaredLogger) {
+			defer wg.Done()
+			for seq := 0; seq < perWriter; seq++ {
+				logger.Infow("entry", "writer", name, "seq", seq)
+			}
+		}(name, logger)
+	}
+	wg.Wait()
+	root.Info("shutting down")
+
+	if err := core.SyncAll(root, writers["db"], writers["http"], writers["cache"]); err != nil {
+		t.Fatalf("SyncAll failed: %v", err)
+	}
+
+	for sink, logs := range map[string]*observer.ObservedLogs{"first": firstLogs, "second": secondLogs} {
+		entries := logs.All()
+		if len(entries) != len(writers)*perWriter+1 {
+			t.Fatalf("%s: expected %d entries, got %d", sink, len(writers)*perWriter+1, len(entries))
+		}
+		if last := entries[len(entries)-1]; last.Message != "shutting down" {
+			t.Errorf("%s: expected shutdown line last, got %q", sink, last.Message)
+		}
+
+		next := make(map[string]int64)
+		for _, e := range entries[:len(entries)-1] {
+			fields := e.ContextMap()
+			writer := fmt.Sprint(fields["writer"])
+			seq, _ := fields["seq"].(int64)
+			if seq != next[writer] {
+				t.Fatalf("%s: writer %s: expected seq %d, got %d", sink, writer, next[writer], seq)
+			}
+			next[writer]++
+		}
+		for name := range writers {
+			if next[name] != perWriter {
+				t.Errorf("%s: writer %s: expected %d entries, got %d", sink, name, perWriter, next[name])
+			}
+		}
+	}
+}
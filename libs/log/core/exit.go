@@ -0,0 +1,119 @@
+package core
+
+import (
+	"os"
+	"reflect"
+	"runtime"
+	"sync"
+)
+
+// exitFunc terminates the process after a Fatal entry. Swapped by CaptureExit in tests.
+var (
+	exitMu   sync.RWMutex
+	exitFunc = os.Exit
+
+	// captureMu serializes CaptureExit calls
+	captureMu sync.Mutex
+)
+
+// logPanicName is the function whose Fatal write must not exit (see exitSuppressed)
+var logPanicName = runtime.FuncForPC(reflect.ValueOf(logPanic).Pointer()).Name()
+
+// Exit terminates the process with code via the swappable exit function.
+// Adapters route their Fatal path through Exit so CaptureFatal can intercept it.
+// Exit is a no-op when called from CapturePanics' own Fatal write, which decides termination itself.
+func Exit(code int) {
+	if exitSuppressed() {
+		return
+	}
+
+	exitMu.RLock()
+	exit := exitFunc
+	exitMu.RUnlock()
+
+	exit(code)
+}
+
+// exitSuppressed reports whether Exit is running inside logPanic on the calling goroutine.
+// Scoping by call stack keeps a Fatal on any other goroutine exiting as usual.
+func exitSuppressed() bool {
+	pcs := make([]uintptr, 128)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if frame.Function == logPanicName {
+			return true
+		}
+		if !more {
+			return false
+		}
+	}
+}
+
+// CaptureFatal runs fn and reports whether it tried to exit the process
+// (e.g. by logging at FatalLevel) instead of letting it kill the test binary.
+//
+// Example:
+//
+//	exited := core.CaptureFatal(func() {
+//	    logger.Fatal("cannot continue")
+//	})
+//	if !exited {
+//	    t.Error("expected Fatal to exit")
+//	}
+func CaptureFatal(fn func()) (exited bool) {
+	_, exited = CaptureExit(fn)
+	return exited
+}
+
+// CaptureExit is like CaptureFatal but also returns the exit code.
+//
+// fn runs on its own goroutine; a captured Exit stops the calling goroutine with
+// runtime.Goexit, so code after the Fatal call never runs.
+// CaptureExit returns when fn returns or as soon as any goroutine (including ones fn started) exits.
+// Like os.Exit, it does not wait for the exiting goroutine's deferred calls.
+// Calls are serialized, since the exit function is process-wide.
+func CaptureExit(fn func()) (code int, exited bool) {
+	captureMu.Lock()
+	defer captureMu.Unlock()
+
+	var once sync.Once
+	exitCh := make(chan struct{})
+
+	exitMu.Lock()
+	original := exitFunc
+	exitFunc = func(c int) {
+		once.Do(func() {
+			code = c
+			close(exitCh)
+		})
+		runtime.Goexit()
+	}
+	exitMu.Unlock()
+
+	defer func() {
+		exitMu.Lock()
+		exitFunc = original
+		exitMu.Unlock()
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn()
+	}()
+
+	select {
+	case <-exitCh:
+		return code, true
+	case <-done:
+	}
+
+	// fn may have exited right before returning
+	select {
+	case <-exitCh:
+		return code, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,29 @@
+package core
+
+import "testing"
+
+func TestCaptureExit(t *testing.T) {
+	reached := false
+
+	code, exited := CaptureExit(func() {
+		Exit(3)
+		reached = true
+	})
+
+	if !exited || code != 3 {
+		t.Errorf("Expected exit code 3, got exited=%v code=%d", exited, code)
+	}
+	if reached {
+		t.Error("Expected code after Exit not to run")
+	}
+}
+
+func TestCaptureFatal_NoExit(t *testing.T) {
+	ran := false
+	if CaptureFatal(func() { ran = true }) {
+		t.Error("Expected exited=false when fn returns normally")
+	}
+	if !ran {
+		t.Error("Expected fn to run")
+	}
+}
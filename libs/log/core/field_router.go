@@ -0,0 +1,418 @@
+package core
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+)
+
+// defaultRouteCacheSize is the number of route results NewFieldRouter caches by default.
+const defaultRouteCacheSize = 128
+
+// FieldRouterOption configures NewFieldRouter.
+type FieldRouterOption func(*fieldRouterOptions)
+
+type fieldRouterOptions struct {
+	cacheSize int
+}
+
+// WithRouteCacheSize sets how many route results are cached (least recently used are evicted).
+// Values < 1 are ignored. Default 128.
+func WithRouteCacheSize(size int) FieldRouterOption {
+	return func(o *fieldRouterOptions) {
+		if size > 0 {
+			o.cacheSize = size
+		}
+	}
+}
+
+// NewFieldRouter decorates base so entries tagged with the field key are also mirrored
+// to a per-value logger, e.g. a per-tenant file kept for support escalations.
+//
+// Behavior:
+//   - The value is taken from the w-style call's key-value pairs, or else from fields added with With
+//   - route(value) returns the extra logger for that value; nil means base only
+//   - Entries without the key go to base only
+//   - route results (including nil) are cached per value in a bounded LRU (see WithRouteCacheSize)
+//   - The mirrored entry keeps the logger's With fields and name; each derived logger caches its
+//     derived mirror per value, so With/Named are applied to the mirror once, not on every entry
+//   - DPanic, Panic and Fatal entries are mirrored at Error level before base logs them,
+//     so the mirror never panics or exits on its own
+//
+// Example:
+//
+//	logger := core.NewFieldRouter(baseLogger, "tenant", func(tenant string) core.ISugaredLogger {
+//	    if !escalated[tenant] {
+//	        return nil
+//	    }
+//	    l, _ := zapadapter.NewWithConfig(zapadapter.Config{OutputPaths: []string{"/var/log/tenants/" + tenant + ".log"}})
+//	    return l
+//	})
+//
+//	logger.Infow("invoice created", "tenant", "acme") // base + acme.log
+//	logger.With("tenant", "acme").Error("sync failed") // base + acme.log
+func NewFieldRouter(base ISugaredLogger, key string, route func(value string) ISugaredLogger, opts ...FieldRouterOption) ISugaredLogger {
+	o := fieldRouterOptions{cacheSize: defaultRouteCacheSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return &fieldRouterLogger{
+		ISugaredLogger: base,
+		router: &fieldRouter{
+			key:   key,
+			route: route,
+			cache: newRouteCache(o.cacheSize),
+		},
+	}
+}
+
+// fieldRouter is shared by a router logger and every logger derived from it.
+type fieldRouter struct {
+	key   string
+	route func(value string) ISugaredLogger
+	cache *routeCache
+}
+
+// lookup returns the cached route result for value, calling route on a cache miss.
+// The entry's pointer identifies the result until it is evicted or replaced.
+func (r *fieldRouter) lookup(value string) *routeCacheEntry {
+	if entry, ok := r.cache.get(value); ok {
+		return entry
+	}
+	return r.cache.put(value, r.route(value))
+}
+
+// fieldRouterLogger mirrors entries carrying the router key.
+// Level, Sync and Desugar are served by the embedded base logger.
+type fieldRouterLogger struct {
+	ISugaredLogger
+	router *fieldRouter
+
+	value    string // value of the key from With fields
+	hasValue bool
+	derive   []func(ISugaredLogger) ISugaredLogger // With/Named/WithContext calls replayed on the mirror
+
+	mu      sync.Mutex
+	mirrors map[string]derivedMirror // derive applied to a route result, per value
+}
+
+// derivedMirror is derive applied to the route result of one routeCacheEntry.
+type derivedMirror struct {
+	entry  *routeCacheEntry
+	logger ISugaredLogger
+}
+
+// mirror returns the mirror logger for an entry with the given key-value pairs, or nil.
+func (l *fieldRouterLogger) mirror(keysAndValues []any) ISugaredLogger {
+	value, ok := l.value, l.hasValue
+	if v, found := findField(keysAndValues, l.router.key); found {
+		value, ok = v, true
+	}
+	if !ok {
+		return nil
+	}
+
+	entry := l.router.lookup(value)
+	if entry.target == nil || len(l.derive) == 0 {
+		return entry.target
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if cached, ok := l.mirrors[value]; ok && cached.entry == entry {
+		return cached.logger
+	}
+
+	target := entry.target
+	for _, fn := range l.derive {
+		target = fn(target)
+	}
+	if l.mirrors == nil || len(l.mirrors) >= l.router.cache.size {
+		l.mirrors = make(map[string]derivedMirror)
+	}
+	l.mirrors[value] = derivedMirror{entry: entry, logger: target}
+	return target
+}
+
+// derived returns a router logger over next that also replays fn on the mirror.
+func (l *fieldRouterLogger) derived(next ISugaredLogger, fn func(ISugaredLogger) ISugaredLogger, args []any) *fieldRouterLogger {
+	d := &fieldRouterLogger{
+		ISugaredLogger: next,
+		router:         l.router,
+		value:          l.value,
+		hasValue:       l.hasValue,
+		derive:         append(append([]func(ISugaredLogger) ISugaredLogger(nil), l.derive...), fn),
+	}
+	if v, found := findField(args, l.router.key); found {
+		d.value, d.hasValue = v, true
+	}
+	return d
+}
+
+// findField returns the value of key in alternating key-value pairs.
+// A Lazy value is evaluated, since routing needs it; the result is shared with the entry.
+func findField(keysAndValues []any, key string) (string, bool) {
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		if k, ok := keysAndValues[i].(string); ok && k == key {
+			value := keysAndValues[i+1]
+			if lazy, ok := value.(*LazyValue); ok {
+				value = lazy.Value()
+			}
+			if s, ok := value.(string); ok {
+				return s, true
+			}
+			return fmt.Sprint(value), true
+		}
+	}
+	return "", false
+}
+
+// mirrorPlain mirrors a plain (Sprint-style) entry at level, capped at Error.
+func (l *fieldRouterLogger) mirrorPlain(level Level, args []any) {
+	m := l.mirror(nil)
+	if m == nil {
+		return
+	}
+	switch mirrorLevel(level) {
+	case DebugLevel:
+		m.Debug(args...)
+	case InfoLevel:
+		m.Info(args...)
+	case WarnLevel:
+		m.Warn(args...)
+	default:
+		m.Error(args...)
+	}
+}
+
+func (l *fieldRouterLogger) mirrorf(level Level, template string, args []any) {
+	if m := l.mirror(nil); m != nil {
+		m.Logf(mirrorLevel(level), template, args...)
+	}
+}
+
+func (l *fieldRouterLogger) mirrorw(level Level, msg string, keysAndValues []any) {
+	if m := l.mirror(keysAndValues); m != nil {
+		m.Logw(mirrorLevel(level), msg, keysAndValues...)
+	}
+}
+
+func (l *fieldRouterLogger) mirrorln(level Level, args []any) {
+	if m := l.mirror(nil); m != nil {
+		m.Logln(mirrorLevel(level), args...)
+	}
+}
+
+// IBasicLogger implementation
+func (l *fieldRouterLogger) Debug(args ...any) {
+	l.mirrorPlain(DebugLevel, args)
+	l.ISugaredLogger.Debug(args...)
+}
+func (l *fieldRouterLogger) Info(args ...any) {
+	l.mirrorPlain(InfoLevel, args)
+	l.ISugaredLogger.Info(args...)
+}
+func (l *fieldRouterLogger) Warn(args ...any) {
+	l.mirrorPlain(WarnLevel, args)
+	l.ISugaredLogger.Warn(args...)
+}
+func (l *fieldRouterLogger) Error(args ...any) {
+	l.mirrorPlain(ErrorLevel, args)
+	l.ISugaredLogger.Error(args...)
+}
+func (l *fieldRouterLogger) DPanic(args ...any) {
+	l.mirrorPlain(DPanicLevel, args)
+	l.ISugaredLogger.DPanic(args...)
+}
+func (l *fieldRouterLogger) Panic(args ...any) {
+	l.mirrorPlain(PanicLevel, args)
+	l.ISugaredLogger.Panic(args...)
+}
+func (l *fieldRouterLogger) Fatal(args ...any) {
+	l.mirrorPlain(FatalLevel, args)
+	l.ISugaredLogger.Fatal(args...)
+}
+
+// IFormattedLogger implementation
+func (l *fieldRouterLogger) Debugf(template string, args ...any) {
+	l.mirrorf(DebugLevel, template, args)
+	l.ISugaredLogger.Debugf(template, args...)
+}
+func (l *fieldRouterLogger) Infof(template string, args ...any) {
+	l.mirrorf(InfoLevel, template, args)
+	l.ISugaredLogger.Infof(template, args...)
+}
+func (l *fieldRouterLogger) Warnf(template string, args ...any) {
+	l.mirrorf(WarnLevel, template, args)
+	l.ISugaredLogger.Warnf(template, args...)
+}
+func (l *fieldRouterLogger) Errorf(template string, args ...any) {
+	l.mirrorf(ErrorLevel, template, args)
+	l.ISugaredLogger.Errorf(template, args...)
+}
+func (l *fieldRouterLogger) DPanicf(template string, args ...any) {
+	l.mirrorf(DPanicLevel, template, args)
+	l.ISugaredLogger.DPanicf(template, args...)
+}
+func (l *fieldRouterLogger) Panicf(template string, args ...any) {
+	l.mirrorf(PanicLevel, template, args)
+	l.ISugaredLogger.Panicf(template, args...)
+}
+func (l *fieldRouterLogger) Fatalf(template string, args ...any) {
+	l.mirrorf(FatalLevel, template, args)
+	l.ISugaredLogger.Fatalf(template, args...)
+}
+func (l *fieldRouterLogger) Logf(level Level, template string, args ...any) {
+	l.mirrorf(level, template, args)
+	l.ISugaredLogger.Logf(level, template, args...)
+}
+
+// IStructuredLogger implementation
+func (l *fieldRouterLogger) Debugw(msg string, keysAndValues ...any) {
+	l.mirrorw(DebugLevel, msg, keysAndValues)
+	l.ISugaredLogger.Debugw(msg, keysAndValues...)
+}
+func (l *fieldRouterLogger) Infow(msg string, keysAndValues ...any) {
+	l.mirrorw(InfoLevel, msg, keysAndValues)
+	l.ISugaredLogger.Infow(msg, keysAndValues...)
+}
+func (l *fieldRouterLogger) Warnw(msg string, keysAndValues ...any) {
+	l.mirrorw(WarnLevel, msg, keysAndValues)
+	l.ISugaredLogger.Warnw(msg, keysAndValues...)
+}
+func (l *fieldRouterLogger) Errorw(msg string, keysAndValues ...any) {
+	l.mirrorw(ErrorLevel, msg, keysAndValues)
+	l.ISugaredLogger.Errorw(msg, keysAndValues...)
+}
+func (l *fieldRouterLogger) DPanicw(msg string, keysAndValues ...any) {
+	l.mirrorw(DPanicLevel, msg, keysAndValues)
+	l.ISugaredLogger.DPanicw(msg, keysAndValues...)
+}
+func (l *fieldRouterLogger) Panicw(msg string, keysAndValues ...any) {
+	l.mirrorw(PanicLevel, msg, keysAndValues)
+	l.ISugaredLogger.Panicw(msg, keysAndValues...)
+}
+func (l *fieldRouterLogger) Fatalw(msg string, keysAndValues ...any) {
+	l.mirrorw(FatalLevel, msg, keysAndValues)
+	l.ISugaredLogger.Fatalw(msg, keysAndValues...)
+}
+func (l *fieldRouterLogger) Logw(level Level, msg string, keysAndValues ...any) {
+	l.mirrorw(level, msg, keysAndValues)
+	l.ISugaredLogger.Logw(level, msg, keysAndValues...)
+}
+
+// ILineLogger implementation
+func (l *fieldRouterLogger) Debugln(args ...any) {
+	l.mirrorln(DebugLevel, args)
+	l.ISugaredLogger.Debugln(args...)
+}
+func (l *fieldRouterLogger) Infoln(args ...any) {
+	l.mirrorln(InfoLevel, args)
+	l.ISugaredLogger.Infoln(args...)
+}
+func (l *fieldRouterLogger) Warnln(args ...any) {
+	l.mirrorln(WarnLevel, args)
+	l.ISugaredLogger.Warnln(args...)
+}
+func (l *fieldRouterLogger) Errorln(args ...any) {
+	l.mirrorln(ErrorLevel, args)
+	l.ISugaredLogger.Errorln(args...)
+}
+func (l *fieldRouterLogger) DPanicln(args ...any) {
+	l.mirrorln(DPanicLevel, args)
+	l.ISugaredLogger.DPanicln(args...)
+}
+func (l *fieldRouterLogger) Panicln(args ...any) {
+	l.mirrorln(PanicLevel, args)
+	l.ISugaredLogger.Panicln(args...)
+}
+func (l *fieldRouterLogger) Fatalln(args ...any) {
+	l.mirrorln(FatalLevel, args)
+	l.ISugaredLogger.Fatalln(args...)
+}
+func (l *fieldRouterLogger) Logln(level Level, args ...any) {
+	l.mirrorln(level, args)
+	l.ISugaredLogger.Logln(level, args...)
+}
+
+// IContextualLogger implementation - derived loggers keep routing
+func (l *fieldRouterLogger) With(args ...any) ISugaredLogger {
+	return l.derived(l.ISugaredLogger.With(args...), func(m ISugaredLogger) ISugaredLogger { return m.With(args...) }, args)
+}
+
+func (l *fieldRouterLogger) WithLazy(args ...any) ISugaredLogger {
+	return l.derived(l.ISugaredLogger.WithLazy(args...), func(m ISugaredLogger) ISugaredLogger { return m.WithLazy(args...) }, args)
+}
+
+func (l *fieldRouterLogger) Named(name string) ISugaredLogger {
+	return l.derived(l.ISugaredLogger.Named(name), func(m ISugaredLogger) ISugaredLogger { return m.Named(name) }, nil)
+}
+
+// IContextLogger implementation
+func (l *fieldRouterLogger) WithContext(ctx any) ISugaredLogger {
+	return l.derived(l.ISugaredLogger.WithContext(ctx), func(m ISugaredLogger) ISugaredLogger { return m.WithContext(ctx) }, nil)
+}
+
+// mirrorLevel caps the mirrored level at Error so the mirror never panics or exits.
+func mirrorLevel(level Level) Level {
+	if level > ErrorLevel {
+		return ErrorLevel
+	}
+	return level
+}
+
+// routeCache is a bounded LRU of route results. Safe for concurrent use.
+type routeCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List // front = most recently used
+	entries map[string]*list.Element
+}
+
+type routeCacheEntry struct {
+	value  string
+	target ISugaredLogger
+}
+
+func newRouteCache(size int) *routeCache {
+	return &routeCache{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (c *routeCache) get(value string) (*routeCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[value]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	return elem.Value.(*routeCacheEntry), true
+}
+
+// put stores a new entry for value and returns it. Entries are never modified,
+// so a derived mirror cached against an entry stays valid while the entry is cached.
+func (c *routeCache) put(value string, target ISugaredLogger) *routeCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &routeCacheEntry{value: value, target: target}
+	if elem, ok := c.entries[value]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return entry
+	}
+
+	c.entries[value] = c.order.PushFront(entry)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*routeCacheEntry).value)
+	}
+	return entry
+}
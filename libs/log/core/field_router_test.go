@@ -0,0 +1,146 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// tenantRecorders builds a route func backed by one observed logger per tenant in tenants.
+// Unknown tenants route to nil. calls counts route invocations per tenant.
+func tenantRecorders(tenants ...string) (func(string) core.ISugaredLogger, map[string]*observer.ObservedLogs, map[string]int) {
+	loggers := make(map[string]core.ISugaredLogger)
+	logs := make(map[string]*observer.ObservedLogs)
+	calls := make(map[string]int)
+	for _, tenant := range tenants {
+		loggers[tenant], logs[tenant] = newObservedLogger()
+	}
+	route := func(tenant string) core.ISugaredLogger {
+		calls[tenant]++
+		return loggers[tenant]
+	}
+	return route, logs, calls
+}
+
+func TestFieldRouter_MirrorsTaggedEntries(t *testing.T) {
+	base, baseLogs := newObservedLogger()
+	route, tenantLogs, _ := tenantRecorders("acme", "globex")
+	logger := core.NewFieldRouter(base, "tenant", route)
+
+	logger.Infow("invoice created", "tenant", "acme", "invoice", "inv-1")
+	logger.With("tenant", "globex").Named("billing").Error("sync failed")
+	logger.Info("startup") // no tenant
+
+	if baseLogs.Len() != 3 {
+		t.Errorf("Expected base to get all 3 entries, got %d", baseLogs.Len())
+	}
+
+	acme := tenantLogs["acme"].All()
+	if len(acme) != 1 || acme[0].Message != "invoice created" {
+		t.Fatalf("Expected acme to get 'invoice created', got %+v", acme)
+	}
+	if fields := acme[0].ContextMap(); fields["invoice"] != "inv-1" || fields["tenant"] != "acme" {
+		t.Errorf("Expected entry fields to be kept, got %v", fields)
+	}
+
+	globex := tenantLogs["globex"].All()
+	if len(globex) != 1 || globex[0].Message != "sync failed" {
+		t.Fatalf("Expected globex to get 'sync failed', got %+v", globex)
+	}
+	if globex[0].LoggerName != "billing" || globex[0].ContextMap()["tenant"] != "globex" {
+		t.Errorf("Expected With fields and name on the mirror, got %q %v", globex[0].LoggerName, globex[0].ContextMap())
+	}
+}
+
+func TestFieldRouter_UnroutedTenantGoesToBaseOnly(t *testing.T) {
+	base, baseLogs := newObservedLogger()
+	route, tenantLogs, _ := tenantRecorders("acme")
+	logger := core.NewFieldRouter(base, "tenant", route)
+
+	logger.Warnw("quota low", "tenant", "initech")
+	logger.Infof("tenant %s", "acme") // key only in the message
+
+	if baseLogs.Len() != 2 {
+		t.Errorf("Expected base to get both entries, got %d", baseLogs.Len())
+	}
+	if tenantLogs["acme"].Len() != 0 {
+		t.Errorf("Expected no mirrored entries, got %+v", tenantLogs["acme"].All())
+	}
+}
+
+func TestFieldRouter_PanicMirroredAtErrorLevel(t *testing.T) {
+	base, baseLogs := newObservedLogger()
+	route, tenantLogs, _ := tenantRecorders("acme")
+	logger := core.NewFieldRouter(base, "tenant", route)
+
+	func() {
+		defer func() { _ = recover() }()
+		logger.Panicw("corrupt ledger", "tenant", "acme")
+	}()
+
+	acme := tenantLogs["acme"].All()
+	if len(acme) != 1 || acme[0].Level != zapcore.ErrorLevel {
+		t.Fatalf("Expected 1 mirrored entry at error level, got %+v", acme)
+	}
+	if base := baseLogs.All(); len(base) != 1 || base[0].Level != zapcore.PanicLevel {
+		t.Errorf("Expected base entry at panic level, got %+v", base)
+	}
+}
+
+func TestFieldRouter_RouteCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	base, _ := newObservedLogger()
+	route, _, calls := tenantRecorders("a", "b", "c")
+	logger := core.NewFieldRouter(base, "tenant", route, core.WithRouteCacheSize(2))
+
+	for _, tenant := range []string{"a", "b", "a", "c", "a", "b"} {
+		logger.Infow("tick", "tenant", tenant)
+	}
+
+	// c evicts b (a was used more recently), so only b is routed twice
+	want := map[string]int{"a": 1, "b": 2, "c": 1}
+	for tenant, n := range want {
+		if calls[tenant] != n {
+			t.Errorf("Expected route(%q) to be called %d times, got %d", tenant, n, calls[tenant])
+		}
+	}
+}
+
+// countingLogger counts With and Named calls made on it
+type countingLogger struct {
+	core.ISugaredLogger
+	derivations *int
+}
+
+func (c countingLogger) With(args ...any) core.ISugaredLogger {
+	*c.derivations++
+	return countingLogger{c.ISugaredLogger.With(args...), c.derivations}
+}
+
+func (c countingLogger) Named(name string) core.ISugaredLogger {
+	*c.derivations++
+	return countingLogger{c.ISugaredLogger.Named(name), c.derivations}
+}
+
+func TestFieldRouter_DerivedMirrorIsCached(t *testing.T) {
+	base, _ := newObservedLogger()
+	acme, acmeLogs := newObservedLogger()
+	derivations := 0
+	logger := core.NewFieldRouter(base, "tenant", func(tenant string) core.ISugaredLogger {
+		return countingLogger{acme, &derivations}
+	})
+
+	child := logger.With("tenant", "acme").Named("billing")
+	for i := 0; i < 10; i++ {
+		child.Infow("tick", "i", i)
+	}
+
+	if derivations != 2 {
+		t.Errorf("Expected With and Named to be applied to the mirror once, got %d derivations", derivations)
+	}
+	entries := acmeLogs.All()
+	if len(entries) != 10 || entries[9].LoggerName != "billing" || entries[9].ContextMap()["tenant"] != "acme" {
+		t.Errorf("Expected 10 mirrored entries keeping With fields and name, got %d", len(entries))
+	}
+}
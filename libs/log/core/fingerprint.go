@@ -0,0 +1,179 @@
+package core
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+	"strings"
+)
+
+// FieldFingerprint is the field WithFingerprint adds to Error and higher entries.
+const FieldFingerprint = "fingerprint"
+
+// Fingerprint returns a stable hash for grouping errors in an error tracker.
+// It hashes msg, which should be the message template rather than the formatted text,
+// and the type names of every error in err's chain (errors.Unwrap and errors.Join).
+// Error values are ignored, so IDs inside error messages do not change the fingerprint.
+//
+// Example:
+//
+//	core.Fingerprint("user %d not found", err) // same value for every user ID
+//	core.Fingerprint("user %d not found", nil) // different value: no error type
+func Fingerprint(msg string, err error) string {
+	h := fnv.New64a()
+	h.Write([]byte(msg))
+	writeErrorTypes(h, err)
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// writeErrorTypes writes the type name of err and of every error it wraps, depth first.
+func writeErrorTypes(w io.Writer, err error) {
+	for err != nil {
+		fmt.Fprintf(w, "\x00%T", err)
+
+		switch e := err.(type) {
+		case interface{ Unwrap() []error }:
+			for _, inner := range e.Unwrap() {
+				writeErrorTypes(w, inner)
+			}
+			return
+		case interface{ Unwrap() error }:
+			err = e.Unwrap()
+		default:
+			return
+		}
+	}
+}
+
+// WithFingerprint decorates logger so every Error, DPanic, Panic and Fatal entry
+// carries a "fingerprint" field (see Fingerprint). Lower levels are logged unchanged.
+//
+// The template is taken before formatting so arguments do not affect the fingerprint:
+//   - f-style calls use the template as passed ("user %d not found")
+//   - w-style calls use msg
+//   - plain and ln-style calls keep string arguments and replace other values by their type
+//
+// The error is the first argument (or key-value value) that implements error.
+//
+// Example:
+//
+//	logger := core.WithFingerprint(baseLogger)
+//	logger.Errorf("user %d not found: %v", id, err) // {"msg":"user 42 not found: ...","fingerprint":"..."}
+func WithFingerprint(logger ISugaredLogger) ISugaredLogger {
+	if _, ok := logger.(*fingerprintLogger); ok {
+		return logger
+	}
+	return &fingerprintLogger{ISugaredLogger: logger}
+}
+
+// fingerprintLogger adds a fingerprint field to Error and higher entries.
+// Level, Sync and Desugar, and every lower-level method, are served by the embedded logger.
+type fingerprintLogger struct {
+	ISugaredLogger
+}
+
+// with returns the wrapped logger enriched with the fingerprint of template and args.
+func (l *fingerprintLogger) with(template string, args []any) ISugaredLogger {
+	return l.ISugaredLogger.With(FieldFingerprint, Fingerprint(template, firstError(args)))
+}
+
+// argsTemplate builds a template for plain calls: strings are kept, other values become their type.
+func argsTemplate(args []any) string {
+	parts := make([]string, len(args))
+	for i, arg := range args {
+		if s, ok := arg.(string); ok {
+			parts[i] = s
+		} else {
+			parts[i] = fmt.Sprintf("<%T>", arg)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+func firstError(args []any) error {
+	for _, arg := range args {
+		if err, ok := arg.(error); ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// IBasicLogger implementation
+func (l *fingerprintLogger) Error(args ...any)  { l.with(argsTemplate(args), args).Error(args...) }
+func (l *fingerprintLogger) DPanic(args ...any) { l.with(argsTemplate(args), args).DPanic(args...) }
+func (l *fingerprintLogger) Panic(args ...any)  { l.with(argsTemplate(args), args).Panic(args...) }
+func (l *fingerprintLogger) Fatal(args ...any)  { l.with(argsTemplate(args), args).Fatal(args...) }
+
+// IFormattedLogger implementation
+func (l *fingerprintLogger) Errorf(template string, args ...any) {
+	l.with(template, args).Errorf(template, args...)
+}
+func (l *fingerprintLogger) DPanicf(template string, args ...any) {
+	l.with(template, args).DPanicf(template, args...)
+}
+func (l *fingerprintLogger) Panicf(template string, args ...any) {
+	l.with(template, args).Panicf(template, args...)
+}
+func (l *fingerprintLogger) Fatalf(template string, args ...any) {
+	l.with(template, args).Fatalf(template, args...)
+}
+func (l *fingerprintLogger) Logf(level Level, template string, args ...any) {
+	if level < ErrorLevel {
+		l.ISugaredLogger.Logf(level, template, args...)
+		return
+	}
+	l.with(template, args).Logf(level, template, args...)
+}
+
+// IStructuredLogger implementation
+func (l *fingerprintLogger) Errorw(msg string, keysAndValues ...any) {
+	l.with(msg, keysAndValues).Errorw(msg, keysAndValues...)
+}
+func (l *fingerprintLogger) DPanicw(msg string, keysAndValues ...any) {
+	l.with(msg, keysAndValues).DPanicw(msg, keysAndValues...)
+}
+func (l *fingerprintLogger) Panicw(msg string, keysAndValues ...any) {
+	l.with(msg, keysAndValues).Panicw(msg, keysAndValues...)
+}
+func (l *fingerprintLogger) Fatalw(msg string, keysAndValues ...any) {
+	l.with(msg, keysAndValues).Fatalw(msg, keysAndValues...)
+}
+func (l *fingerprintLogger) Logw(level Level, msg string, keysAndValues ...any) {
+	if level < ErrorLevel {
+		l.ISugaredLogger.Logw(level, msg, keysAndValues...)
+		return
+	}
+	l.with(msg, keysAndValues).Logw(level, msg, keysAndValues...)
+}
+
+// ILineLogger implementation
+func (l *fingerprintLogger) Errorln(args ...any)  { l.with(argsTemplate(args), args).Errorln(args...) }
+func (l *fingerprintLogger) DPanicln(args ...any) { l.with(argsTemplate(args), args).DPanicln(args...) }
+func (l *fingerprintLogger) Panicln(args ...any)  { l.with(argsTemplate(args), args).Panicln(args...) }
+func (l *fingerprintLogger) Fatalln(args ...any)  { l.with(argsTemplate(args), args).Fatalln(args...) }
+func (l *fingerprintLogger) Logln(level Level, args ...any) {
+	if level < ErrorLevel {
+		l.ISugaredLogger.Logln(level, args...)
+		return
+	}
+	l.with(argsTemplate(args), args).Logln(level, args...)
+}
+
+// IContextualLogger implementation - derived loggers keep the decorator
+func (l *fingerprintLogger) With(args ...any) ISugaredLogger {
+	return WithFingerprint(l.ISugaredLogger.With(args...))
+}
+
+func (l *fingerprintLogger) WithLazy(args ...any) ISugaredLogger {
+	return WithFingerprint(l.ISugaredLogger.WithLazy(args...))
+}
+
+func (l *fingerprintLogger) Named(name string) ISugaredLogger {
+	return WithFingerprint(l.ISugaredLogger.Named(name))
+}
+
+// IContextLogger implementation
+func (l *fingerprintLogger) WithContext(ctx any) ISugaredLogger {
+	return WithFingerprint(l.ISugaredLogger.WithContext(ctx))
+}
@@ -0,0 +1,83 @@
+package core_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+type notFoundError struct{ id int }
+
+func (e *notFoundError) Error() string { return fmt.Sprintf("user %d not found", e.id) }
+
+func TestFingerprint_SameTemplateDifferentValues(t *testing.T) {
+	a := core.Fingerprint("load user %d", fmt.Errorf("query: %w", &notFoundError{id: 1}))
+	b := core.Fingerprint("load user %d", fmt.Errorf("query: %w", &notFoundError{id: 2}))
+	if a != b {
+		t.Errorf("Expected identical fingerprints for different error values, got %s and %s", a, b)
+	}
+}
+
+func TestFingerprint_DifferentErrorTypes(t *testing.T) {
+	notFound := core.Fingerprint("load user %d", fmt.Errorf("query: %w", &notFoundError{id: 1}))
+	permission := core.Fingerprint("load user %d", fmt.Errorf("query: %w", fs.ErrPermission))
+	joined := core.Fingerprint("load user %d", errors.Join(&notFoundError{id: 1}, fs.ErrPermission))
+	template := core.Fingerprint("save user %d", fmt.Errorf("query: %w", &notFoundError{id: 1}))
+
+	seen := map[string]string{}
+	for name, fp := range map[string]string{"notFound": notFound, "permission": permission, "joined": joined, "template": template} {
+		if other, ok := seen[fp]; ok {
+			t.Errorf("Expected distinct fingerprints, %s and %s collide", name, other)
+		}
+		seen[fp] = name
+	}
+}
+
+func TestWithFingerprint_FormattedUsesTemplate(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.WithFingerprint(base)
+
+	logger.Errorf("load user %d: %v", 1, &notFoundError{id: 1})
+	logger.Errorf("load user %d: %v", 2, &notFoundError{id: 2})
+	logger.Infof("load user %d", 3)
+
+	entries := logs.All()
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+
+	first, second := entries[0].ContextMap()[core.FieldFingerprint], entries[1].ContextMap()[core.FieldFingerprint]
+	if first == nil || first != second {
+		t.Errorf("Expected identical fingerprints for the same template, got %v and %v", first, second)
+	}
+	if entries[0].Message != "load user 1: user 1 not found" {
+		t.Errorf("Expected message to be formatted as usual, got %q", entries[0].Message)
+	}
+	if _, ok := entries[2].ContextMap()[core.FieldFingerprint]; ok {
+		t.Error("Expected no fingerprint below Error level")
+	}
+}
+
+func TestWithFingerprint_StructuredAndDerived(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.WithFingerprint(base).Named("repo").With("component", "users")
+
+	logger.Errorw("load user", "id", 1, "error", &notFoundError{id: 1})
+	logger.Errorw("load user", "id", 2, "error", fs.ErrPermission)
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+
+	want := core.Fingerprint("load user", &notFoundError{})
+	if got := entries[0].ContextMap()[core.FieldFingerprint]; got != want {
+		t.Errorf("Expected fingerprint %s, got %v", want, got)
+	}
+	if entries[0].ContextMap()[core.FieldFingerprint] == entries[1].ContextMap()[core.FieldFingerprint] {
+		t.Error("Expected different fingerprints for different error types")
+	}
+}
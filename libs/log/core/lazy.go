@@ -0,0 +1,58 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// LazyValue is a field value computed only when an entry carrying it is written.
+// Create with Lazy.
+type LazyValue struct {
+	once  sync.Once
+	fn    func() any
+	value any
+}
+
+// Lazy wraps fn as a key-value value that is evaluated only if the entry is actually emitted:
+// adapters skip it for disabled levels and sampled-away entries.
+// fn runs at most once per LazyValue, so a tee writing to several sinks shares the result.
+//
+// Behavior:
+//   - Adapters resolve it at encode time (the zap adapter encodes the result with zap's own rules)
+//   - Backends that do not know LazyValue still defer it: it implements json.Marshaler and fmt.Stringer
+//   - NewFieldRouter evaluates it when it is the routing key's value, to pick the mirror
+//   - With encodes fields immediately on zap; use WithLazy to defer context fields too
+//
+// Example:
+//
+//	logger.Debugw("cache state", "entries", core.Lazy(func() any {
+//	    return cache.Snapshot() // only computed when Debug is enabled
+//	}))
+func Lazy(fn func() any) any {
+	return &LazyValue{fn: fn}
+}
+
+// Value evaluates the wrapped function on first use and returns its result.
+// Safe for concurrent use.
+func (l *LazyValue) Value() any {
+	l.once.Do(func() {
+		l.value = l.fn()
+		l.fn = nil
+	})
+	return l.value
+}
+
+// MarshalJSON encodes the result; errors are encoded as their message.
+func (l *LazyValue) MarshalJSON() ([]byte, error) {
+	v := l.Value()
+	if err, ok := v.(error); ok {
+		return json.Marshal(err.Error())
+	}
+	return json.Marshal(v)
+}
+
+// String formats the result with fmt.Sprint, so plain and f-style calls defer it too.
+func (l *LazyValue) String() string {
+	return fmt.Sprint(l.Value())
+}
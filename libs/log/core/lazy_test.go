@@ -0,0 +1,64 @@
+package core_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+func TestLazy_EvaluatesOnce(t *testing.T) {
+	calls := 0
+	lazy := core.Lazy(func() any {
+		calls++
+		return 7
+	}).(*core.LazyValue)
+
+	if calls != 0 {
+		t.Fatalf("Expected no evaluation before use, got %d", calls)
+	}
+	for i := 0; i < 3; i++ {
+		if v := lazy.Value(); v != 7 {
+			t.Fatalf("Expected 7, got %v", v)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected one evaluation, got %d", calls)
+	}
+}
+
+func TestLazy_FallbackEncodings(t *testing.T) {
+	data, err := json.Marshal(map[string]any{"n": core.Lazy(func() any { return 3 })})
+	if err != nil || string(data) != `{"n":3}` {
+		t.Errorf("Expected {\"n\":3}, got %s (%v)", data, err)
+	}
+
+	data, _ = json.Marshal(core.Lazy(func() any { return errors.New("boom") }))
+	if string(data) != `"boom"` {
+		t.Errorf("Expected error message, got %s", data)
+	}
+
+	if s := fmt.Sprintf("%v", core.Lazy(func() any { return "text" })); s != "text" {
+		t.Errorf("Expected text, got %q", s)
+	}
+}
+
+func TestFieldRouter_RoutesOnLazyValue(t *testing.T) {
+	base, _ := newObservedLogger()
+	mirror, mirrorLogs := newObservedLogger()
+
+	logger := core.NewFieldRouter(base, "tenant", func(tenant string) core.ISugaredLogger {
+		if tenant == "acme" {
+			return mirror
+		}
+		return nil
+	})
+
+	logger.Infow("invoice created", "tenant", core.Lazy(func() any { return "acme" }))
+
+	if mirrorLogs.Len() != 1 {
+		t.Errorf("Expected the entry mirrored for the lazy tenant, got %d", mirrorLogs.Len())
+	}
+}
@@ -1,5 +1,10 @@
 package core
 
+import (
+	"fmt"
+	"strings"
+)
+
 // Level represents the log level
 type Level int
 
@@ -41,3 +46,67 @@ func (l Level) String() string {
 		return "UNKNOWN"
 	}
 }
+
+// ParseLevel returns the Level named by text, case-insensitively ("warn", "WARN", ...).
+// "warning" is accepted for WarnLevel.
+func ParseLevel(text string) (Level, error) {
+	switch strings.ToUpper(strings.TrimSpace(text)) {
+	case "DEBUG":
+		return DebugLevel, nil
+	case "INFO":
+		return InfoLevel, nil
+	case "WARN", "WARNING":
+		return WarnLevel, nil
+	case "ERROR":
+		return ErrorLevel, nil
+	case "DPANIC":
+		return DPanicLevel, nil
+	case "PANIC":
+		return PanicLevel, nil
+	case "FATAL":
+		return FatalLevel, nil
+	default:
+		return 0, fmt.Errorf("invalid log level: %q", text)
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler, using the lowercase level name.
+func (l Level) MarshalText() ([]byte, error) {
+	return []byte(strings.ToLower(l.String())), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler (see ParseLevel),
+// so config decoders fill Level fields from "warn" etc.
+func (l *Level) UnmarshalText(text []byte) error {
+	level, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+	*l = level
+	return nil
+}
+
+// LevelValue is a command-line flag value for a Level.
+// It implements pflag.Value (and flag.Value), so a Level can be set with --log-level=warn.
+//
+// Example:
+//
+//	level := core.InfoLevel
+//	pflag.Var((*core.LevelValue)(&level), "log-level", "log level: debug, info, warn, error")
+type LevelValue Level
+
+// String implements pflag.Value.
+func (v *LevelValue) String() string {
+	text, _ := Level(*v).MarshalText()
+	return string(text)
+}
+
+// Set implements pflag.Value.
+func (v *LevelValue) Set(text string) error {
+	return (*Level)(v).UnmarshalText([]byte(text))
+}
+
+// Type implements pflag.Value.
+func (v *LevelValue) Type() string {
+	return "level"
+}
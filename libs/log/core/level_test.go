@@ -0,0 +1,72 @@
+package core_test
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		text string
+		want core.Level
+	}{
+		{"debug", core.DebugLevel},
+		{"INFO", core.InfoLevel},
+		{" Warn ", core.WarnLevel},
+		{"warning", core.WarnLevel},
+		{"error", core.ErrorLevel},
+		{"dpanic", core.DPanicLevel},
+		{"panic", core.PanicLevel},
+		{"fatal", core.FatalLevel},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			got, err := core.ParseLevel(tt.text)
+			if err != nil || got != tt.want {
+				t.Errorf("ParseLevel(%q) = %v, %v; want %v", tt.text, got, err, tt.want)
+			}
+		})
+	}
+
+	if _, err := core.ParseLevel("verbose"); err == nil {
+		t.Error("Expected an error for an unknown level")
+	}
+}
+
+func TestLevel_TextRoundTrip(t *testing.T) {
+	for level := core.DebugLevel; level <= core.FatalLevel; level++ {
+		text, err := level.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText(%v) error = %v", level, err)
+		}
+
+		var got core.Level
+		if err := got.UnmarshalText(text); err != nil || got != level {
+			t.Errorf("Round trip of %v via %q = %v, %v", level, text, got, err)
+		}
+	}
+}
+
+func TestLevelValue_Flag(t *testing.T) {
+	level := core.InfoLevel
+	flags := flag.NewFlagSet("app", flag.ContinueOnError)
+	flags.Var((*core.LevelValue)(&level), "log-level", "")
+
+	if err := flags.Parse([]string{"--log-level=warn"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if level != core.WarnLevel {
+		t.Errorf("Expected WarnLevel, got %v", level)
+	}
+
+	value := (*core.LevelValue)(&level)
+	if value.String() != "warn" || value.Type() != "level" {
+		t.Errorf("Expected String() warn and Type() level, got %q %q", value.String(), value.Type())
+	}
+
+	if err := flags.Parse([]string{"--log-level=loud"}); err == nil {
+		t.Error("Expected an invalid level to fail parsing")
+	}
+}
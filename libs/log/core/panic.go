@@ -0,0 +1,103 @@
+package core
+
+import (
+	"fmt"
+	"runtime/debug"
+	"strings"
+)
+
+// PanicOption configures CapturePanics and Go.
+type PanicOption func(*panicOptions)
+
+type panicOptions struct {
+	exitCode int
+	exit     bool
+	ring     *RingBuffer
+}
+
+// WithPanicExitCode makes CapturePanics exit the process with code instead of re-panicking.
+func WithPanicExitCode(code int) PanicOption {
+	return func(o *panicOptions) {
+		o.exitCode = code
+		o.exit = true
+	}
+}
+
+// WithPanicRingBuffer adds the entries recorded in ring to the Fatal entry, as a "recent_logs" field
+// rendered in FormatText, so a crash report shows what led up to the panic.
+func WithPanicRingBuffer(ring *RingBuffer) PanicOption {
+	return func(o *panicOptions) {
+		o.ring = ring
+	}
+}
+
+// CapturePanics returns a handler for `defer core.CapturePanics(logger)()` at the top of main
+// and goroutine entry points. On an unrecovered panic it:
+//  1. writes a Fatal-level entry with the panic value and stack
+//     (service info attached to logger via With is included),
+//  2. calls Sync so the entry reaches its sink,
+//  3. re-panics with the original value, or exits with WithPanicExitCode's code.
+//
+// The adapter's own exit on this Fatal entry is suppressed, so termination is always
+// decided by step 3; Fatal calls on other goroutines still exit.
+//
+// Example:
+//
+//	func main() {
+//	    logger, _ := zap.NewProductionWithOptions(zap.WithServiceInfo(info))
+//	    defer core.CapturePanics(logger)()
+//	    run()
+//	}
+func CapturePanics(logger ISugaredLogger, opts ...PanicOption) (handler func()) {
+	var o panicOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		logPanic(logger, r, debug.Stack(), o.ring)
+
+		if o.exit {
+			Exit(o.exitCode)
+			return
+		}
+		panic(r)
+	}
+}
+
+// Go runs fn on a new goroutine guarded by CapturePanics.
+//
+// Example:
+//
+//	core.Go(logger, func() {
+//	    consumeEvents(ctx)
+//	}, core.WithPanicExitCode(2))
+func Go(logger ISugaredLogger, fn func(), opts ...PanicOption) {
+	go func() {
+		defer CapturePanics(logger, opts...)()
+		fn()
+	}()
+}
+
+// logPanic writes the Fatal-level entry, then syncs. Exit is a no-op for calls made
+// from inside logPanic, so only this write is kept from exiting.
+// With a ring, its entries are rendered before the Fatal entry is recorded into it.
+func logPanic(logger ISugaredLogger, value any, stack []byte, ring *RingBuffer) {
+	fields := []any{
+		"panic", fmt.Sprint(value),
+		"stack", string(stack),
+	}
+	if ring != nil {
+		var recent strings.Builder
+		_ = ring.WriteTo(&recent, FormatText)
+		fields = append(fields, "recent_logs", recent.String())
+	}
+
+	logger.Fatalw("unrecovered panic", fields...)
+	_ = logger.Sync()
+}
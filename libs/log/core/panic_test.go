@@ -0,0 +1,141 @@
+package core_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestCapturePanics_LogsThenExits(t *testing.T) {
+	logger, logs := newObservedLogger()
+
+	code, exited := core.CaptureExit(func() {
+		defer core.CapturePanics(logger.With("service", "orders"), core.WithPanicExitCode(3))()
+		panic("boom")
+	})
+
+	if !exited || code != 3 {
+		t.Errorf("Expected exit with code 3, got exited=%v code=%d", exited, code)
+	}
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected the entry to be recorded before termination, got %d entries", len(entries))
+	}
+	entry := entries[0]
+	fields := entry.ContextMap()
+	if entry.Level != zapcore.FatalLevel {
+		t.Errorf("Expected FatalLevel entry, got %v", entry.Level)
+	}
+	if fields["panic"] != "boom" {
+		t.Errorf("Expected panic field 'boom', got %v", fields["panic"])
+	}
+	if stack, _ := fields["stack"].(string); !strings.Contains(stack, "TestCapturePanics_LogsThenExits") {
+		t.Errorf("Expected stack to include the panicking function, got %q", stack)
+	}
+	if fields["service"] != "orders" {
+		t.Errorf("Expected service info fields to be kept, got %v", fields)
+	}
+}
+
+func TestCapturePanics_RePanics(t *testing.T) {
+	logger, logs := newObservedLogger()
+	var recovered any
+
+	_, exited := core.CaptureExit(func() {
+		defer func() { recovered = recover() }()
+		func() {
+			defer core.CapturePanics(logger)()
+			panic("boom")
+		}()
+	})
+
+	if exited {
+		t.Error("Expected re-panic instead of exit")
+	}
+	if recovered != "boom" {
+		t.Errorf("Expected original panic value to propagate, got %v", recovered)
+	}
+	if logs.Len() != 1 {
+		t.Errorf("Expected entry to be recorded, got %d", logs.Len())
+	}
+}
+
+func TestCapturePanics_NoPanic(t *testing.T) {
+	logger, logs := newObservedLogger()
+
+	func() {
+		defer core.CapturePanics(logger)()
+	}()
+
+	if logs.Len() != 0 {
+		t.Errorf("Expected no entries without a panic, got %d", logs.Len())
+	}
+}
+
+func TestGo_CapturesGoroutinePanic(t *testing.T) {
+	logger, logs := newObservedLogger()
+	block := make(chan struct{})
+	defer close(block)
+
+	code, exited := core.CaptureExit(func() {
+		core.Go(logger, func() {
+			panic("worker crashed")
+		}, core.WithPanicExitCode(2))
+		<-block
+	})
+
+	if !exited || code != 2 {
+		t.Errorf("Expected exit with code 2, got exited=%v code=%d", exited, code)
+	}
+	if logs.Len() != 1 || logs.All()[0].ContextMap()["panic"] != "worker crashed" {
+		t.Errorf("Expected goroutine panic to be logged, got %v", logs.All())
+	}
+}
+
+// blockingFatalLogger holds its Fatalw calls until release is closed
+type blockingFatalLogger struct {
+	core.ISugaredLogger
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (l *blockingFatalLogger) Fatalw(msg string, keysAndValues ...any) {
+	close(l.entered)
+	<-l.release
+	l.ISugaredLogger.Fatalw(msg, keysAndValues...)
+}
+
+func TestCapturePanics_ConcurrentFatalStillExits(t *testing.T) {
+	logger, logs := newObservedLogger()
+	blocking := &blockingFatalLogger{ISugaredLogger: logger, entered: make(chan struct{}), release: make(chan struct{})}
+	panicked := make(chan any)
+
+	code, exited := core.CaptureExit(func() {
+		go func() {
+			defer func() { panicked <- recover() }()
+			defer core.CapturePanics(blocking)()
+			panic("boom")
+		}()
+		<-blocking.entered
+
+		// The panic entry is being written; a Fatal elsewhere must still exit
+		logger.Fatal("cannot continue")
+		t.Error("Expected Fatal to stop the goroutine")
+	})
+
+	if !exited || code != 1 {
+		t.Errorf("Expected concurrent Fatal to exit with code 1, got exited=%v code=%d", exited, code)
+	}
+
+	// Verify: The panic entry itself still does not exit; CapturePanics re-panics
+	close(blocking.release)
+	if recovered := <-panicked; recovered != "boom" {
+		t.Errorf("Expected re-panic with the original value, got %v", recovered)
+	}
+	if n := len(logs.FilterMessage("unrecovered panic").All()); n != 1 {
+		t.Errorf("Expected the panic entry to be recorded, got %d", n)
+	}
+}
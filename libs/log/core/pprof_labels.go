@@ -0,0 +1,128 @@
+package core
+
+// Well-known pprof label keys set by the interceptor.PprofLabels interceptor.
+const (
+	LabelRequestID = "request_id"
+	LabelMethod    = "method"
+)
+
+// WithPprofLabels decorates logger so every entry carries the current goroutine's
+// runtime/pprof labels (e.g. request_id, method) as fields.
+// This gives deep library code request correlation without threading a context through it.
+//
+// Labels are set with pprof.Do (see interceptor.PprofLabels) and are inherited by
+// goroutines started inside it. When the goroutine has no labels the decorator
+// logs through the wrapped logger unchanged.
+//
+// Performance: each log call reads the goroutine's label set and, when it is non-empty,
+// derives a child logger with those fields (roughly the cost of logger.With per entry).
+// Reading labels relies on a runtime hook that is only used on Go 1.24+;
+// on older toolchains the decorator is a no-op.
+//
+// Example:
+//
+//	logger := core.WithPprofLabels(baseLogger)
+//
+//	pprof.Do(ctx, pprof.Labels(core.LabelRequestID, "req-42"), func(ctx context.Context) {
+//	    logger.Info("cache miss") // {"msg":"cache miss","request_id":"req-42"}
+//	})
+func WithPprofLabels(logger ISugaredLogger) ISugaredLogger {
+	if _, ok := logger.(*pprofLabelLogger); ok {
+		return logger
+	}
+	return &pprofLabelLogger{ISugaredLogger: logger}
+}
+
+// pprofLabelLogger appends goroutine pprof labels to every entry.
+// Level, Sync and Desugar are served by the embedded logger.
+type pprofLabelLogger struct {
+	ISugaredLogger
+}
+
+// logger returns the wrapped logger, enriched with the current goroutine's labels if any.
+func (l *pprofLabelLogger) logger() ISugaredLogger {
+	fields := goroutineLabels()
+	if len(fields) == 0 {
+		return l.ISugaredLogger
+	}
+	return l.ISugaredLogger.With(fields...)
+}
+
+// IBasicLogger implementation
+func (l *pprofLabelLogger) Debug(args ...any)  { l.logger().Debug(args...) }
+func (l *pprofLabelLogger) Info(args ...any)   { l.logger().Info(args...) }
+func (l *pprofLabelLogger) Warn(args ...any)   { l.logger().Warn(args...) }
+func (l *pprofLabelLogger) Error(args ...any)  { l.logger().Error(args...) }
+func (l *pprofLabelLogger) DPanic(args ...any) { l.logger().DPanic(args...) }
+func (l *pprofLabelLogger) Panic(args ...any)  { l.logger().Panic(args...) }
+func (l *pprofLabelLogger) Fatal(args ...any)  { l.logger().Fatal(args...) }
+
+// IFormattedLogger implementation
+func (l *pprofLabelLogger) Debugf(template string, args ...any) { l.logger().Debugf(template, args...) }
+func (l *pprofLabelLogger) Infof(template string, args ...any)  { l.logger().Infof(template, args...) }
+func (l *pprofLabelLogger) Warnf(template string, args ...any)  { l.logger().Warnf(template, args...) }
+func (l *pprofLabelLogger) Errorf(template string, args ...any) { l.logger().Errorf(template, args...) }
+func (l *pprofLabelLogger) DPanicf(template string, args ...any) {
+	l.logger().DPanicf(template, args...)
+}
+func (l *pprofLabelLogger) Panicf(template string, args ...any) { l.logger().Panicf(template, args...) }
+func (l *pprofLabelLogger) Fatalf(template string, args ...any) { l.logger().Fatalf(template, args...) }
+func (l *pprofLabelLogger) Logf(level Level, template string, args ...any) {
+	l.logger().Logf(level, template, args...)
+}
+
+// IStructuredLogger implementation
+func (l *pprofLabelLogger) Debugw(msg string, keysAndValues ...any) {
+	l.logger().Debugw(msg, keysAndValues...)
+}
+func (l *pprofLabelLogger) Infow(msg string, keysAndValues ...any) {
+	l.logger().Infow(msg, keysAndValues...)
+}
+func (l *pprofLabelLogger) Warnw(msg string, keysAndValues ...any) {
+	l.logger().Warnw(msg, keysAndValues...)
+}
+func (l *pprofLabelLogger) Errorw(msg string, keysAndValues ...any) {
+	l.logger().Errorw(msg, keysAndValues...)
+}
+func (l *pprofLabelLogger) DPanicw(msg string, keysAndValues ...any) {
+	l.logger().DPanicw(msg, keysAndValues...)
+}
+func (l *pprofLabelLogger) Panicw(msg string, keysAndValues ...any) {
+	l.logger().Panicw(msg, keysAndValues...)
+}
+func (l *pprofLabelLogger) Fatalw(msg string, keysAndValues ...any) {
+	l.logger().Fatalw(msg, keysAndValues...)
+}
+func (l *pprofLabelLogger) Logw(level Level, msg string, keysAndValues ...any) {
+	l.logger().Logw(level, msg, keysAndValues...)
+}
+
+// ILineLogger implementation
+func (l *pprofLabelLogger) Debugln(args ...any)  { l.logger().Debugln(args...) }
+func (l *pprofLabelLogger) Infoln(args ...any)   { l.logger().Infoln(args...) }
+func (l *pprofLabelLogger) Warnln(args ...any)   { l.logger().Warnln(args...) }
+func (l *pprofLabelLogger) Errorln(args ...any)  { l.logger().Errorln(args...) }
+func (l *pprofLabelLogger) DPanicln(args ...any) { l.logger().DPanicln(args...) }
+func (l *pprofLabelLogger) Panicln(args ...any)  { l.logger().Panicln(args...) }
+func (l *pprofLabelLogger) Fatalln(args ...any)  { l.logger().Fatalln(args...) }
+func (l *pprofLabelLogger) Logln(level Level, args ...any) {
+	l.logger().Logln(level, args...)
+}
+
+// IContextualLogger implementation - derived loggers keep the decorator
+func (l *pprofLabelLogger) With(args ...any) ISugaredLogger {
+	return WithPprofLabels(l.ISugaredLogger.With(args...))
+}
+
+func (l *pprofLabelLogger) WithLazy(args ...any) ISugaredLogger {
+	return WithPprofLabels(l.ISugaredLogger.WithLazy(args...))
+}
+
+func (l *pprofLabelLogger) Named(name string) ISugaredLogger {
+	return WithPprofLabels(l.ISugaredLogger.Named(name))
+}
+
+// IContextLogger implementation
+func (l *pprofLabelLogger) WithContext(ctx any) ISugaredLogger {
+	return WithPprofLabels(l.ISugaredLogger.WithContext(ctx))
+}
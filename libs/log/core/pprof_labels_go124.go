@@ -0,0 +1,42 @@
+//go:build go1.24
+
+package core
+
+import (
+	_ "runtime/pprof" // provides runtime_getProfLabel
+	"unsafe"
+)
+
+// runtime_getProfLabel returns the current goroutine's pprof label set.
+// The runtime keeps this hook stable for external linkname use (go.dev/issue/67401).
+//
+//go:linkname runtime_getProfLabel runtime/pprof.runtime_getProfLabel
+func runtime_getProfLabel() unsafe.Pointer
+
+// profLabelSet mirrors the runtime/pprof goroutine label layout since Go 1.24:
+// a struct holding a key-sorted slice of key/value pairs.
+type profLabelSet struct {
+	list []struct {
+		key   string
+		value string
+	}
+}
+
+// goroutineLabels returns the current goroutine's pprof labels as alternating key/value pairs.
+func goroutineLabels() []any {
+	ptr := runtime_getProfLabel()
+	if ptr == nil {
+		return nil
+	}
+
+	labels := (*profLabelSet)(ptr).list
+	if len(labels) == 0 {
+		return nil
+	}
+
+	fields := make([]any, 0, len(labels)*2)
+	for _, label := range labels {
+		fields = append(fields, label.key, label.value)
+	}
+	return fields
+}
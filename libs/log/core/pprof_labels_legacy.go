@@ -0,0 +1,8 @@
+//go:build !go1.24
+
+package core
+
+// goroutineLabels is a no-op before Go 1.24, where the runtime label layout differs.
+func goroutineLabels() []any {
+	return nil
+}
@@ -0,0 +1,86 @@
+package core_test
+
+import (
+	"context"
+	"runtime/pprof"
+	"testing"
+
+	zapadapter "github.com/phongthien99/monorepo-lib/libs/log/adapter/zap"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func newObservedLogger() (core.ISugaredLogger, *observer.ObservedLogs) {
+	obsCore, logs := observer.New(zapcore.DebugLevel)
+	return zapadapter.NewZapAdapterFromLogger(zap.New(obsCore), core.DebugLevel), logs
+}
+
+func TestWithPprofLabels_AddsLabelsAsFields(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.WithPprofLabels(base)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		labels := pprof.Labels(core.LabelRequestID, "req-42", core.LabelMethod, "GET /users")
+		pprof.Do(context.Background(), labels, func(ctx context.Context) {
+			logger.Infow("cache miss", "key", "user:1")
+		})
+	}()
+	<-done
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+
+	fields := entries[0].ContextMap()
+	if fields[core.LabelRequestID] != "req-42" {
+		t.Errorf("Expected request_id=req-42, got %v", fields[core.LabelRequestID])
+	}
+	if fields[core.LabelMethod] != "GET /users" {
+		t.Errorf("Expected method=GET /users, got %v", fields[core.LabelMethod])
+	}
+	if fields["key"] != "user:1" {
+		t.Errorf("Expected explicit fields to be kept, got %v", fields)
+	}
+}
+
+func TestWithPprofLabels_InheritedByChildGoroutines(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.WithPprofLabels(base).Named("repo")
+
+	pprof.Do(context.Background(), pprof.Labels(core.LabelRequestID, "req-7"), func(ctx context.Context) {
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			logger.Info("deep call") // no ctx plumbing
+		}()
+		<-done
+	})
+
+	entries := logs.All()
+	if len(entries) != 1 || entries[0].ContextMap()[core.LabelRequestID] != "req-7" {
+		t.Errorf("Expected child goroutine entry with request_id=req-7, got %+v", entries)
+	}
+}
+
+func TestWithPprofLabels_NoLabelsIsNoop(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.WithPprofLabels(base)
+
+	logger.Info("plain")
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected 1 entry, got %d", len(entries))
+	}
+	if len(entries[0].Context) != 0 {
+		t.Errorf("Expected no extra fields without labels, got %v", entries[0].ContextMap())
+	}
+	if core.WithPprofLabels(logger) != logger {
+		t.Error("Expected decorating twice to return the same logger")
+	}
+}
@@ -0,0 +1,404 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Format selects how RingBuffer.WriteTo renders entries.
+type Format string
+
+const (
+	// FormatText renders one line per entry: time, level, logger name, message and key=value fields.
+	FormatText Format = "text"
+	// FormatJSON renders the entries as a JSON array.
+	FormatJSON Format = "json"
+)
+
+// Field is a key-value pair recorded with an Entry. Values are rendered when recorded,
+// so an entry never holds a reference into the caller's data.
+type Field struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Entry is a log entry recorded by RingBuffer.
+type Entry struct {
+	Time    time.Time `json:"time"`
+	Level   Level     `json:"level"`
+	Logger  string    `json:"logger,omitempty"` // name set with Named, dot-separated
+	Message string    `json:"msg"`
+	Fields  []Field   `json:"fields,omitempty"` // With fields first, then the entry's own
+}
+
+// RingBufferOption configures NewRingBuffer.
+type RingBufferOption func(*RingBuffer)
+
+// WithRingMaxAge drops entries older than age from Entries and WriteTo,
+// so a dump only shows what happened recently. By default entries are kept until overwritten.
+func WithRingMaxAge(age time.Duration) RingBufferOption {
+	return func(r *RingBuffer) {
+		r.maxAge = age
+	}
+}
+
+// RingBuffer keeps the last capacity log entries at or above minLevel in memory,
+// for crash dumps and debug endpoints. Entries are recorded by loggers returned from Wrap,
+// regardless of the wrapped logger's level, so Debug entries a sink drops are still available.
+//
+// Slots are preallocated and entries are stored by value, so memory stays bounded by capacity
+// however many goroutines write. Safe for concurrent use.
+//
+// Example:
+//
+//	ring := core.NewRingBuffer(500, core.DebugLevel, core.WithRingMaxAge(10*time.Minute))
+//	logger = ring.Wrap(logger) // sink still at Info
+//	defer core.CapturePanics(logger, core.WithPanicRingBuffer(ring))()
+//
+//	logger.Debugw("cache miss", "key", k) // not written by the sink, but in ring.Entries()
+func NewRingBuffer(capacity int, minLevel Level, opts ...RingBufferOption) *RingBuffer {
+	if capacity < 1 {
+		capacity = 1
+	}
+	r := &RingBuffer{
+		slots:    make([]Entry, capacity),
+		minLevel: minLevel,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+// RingBuffer is created with NewRingBuffer.
+type RingBuffer struct {
+	minLevel Level
+	maxAge   time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	slots []Entry
+	next  int // slot the next entry is written to
+	count int // number of filled slots
+}
+
+// Wrap returns a logger that records every entry at or above the buffer's level into the buffer,
+// then passes it on to logger. Loggers derived with With, Named and WithContext keep recording.
+func (r *RingBuffer) Wrap(logger ISugaredLogger) ISugaredLogger {
+	return &ringLogger{ISugaredLogger: logger, ring: r}
+}
+
+// Entries returns the recorded entries, oldest first.
+func (r *RingBuffer) Entries() []Entry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var cutoff time.Time
+	if r.maxAge > 0 {
+		cutoff = r.now().Add(-r.maxAge)
+	}
+
+	entries := make([]Entry, 0, r.count)
+	start := r.next - r.count + len(r.slots)
+	for i := 0; i < r.count; i++ {
+		entry := r.slots[(start+i)%len(r.slots)]
+		if entry.Time.Before(cutoff) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// WriteTo renders Entries to w in the given format.
+func (r *RingBuffer) WriteTo(w io.Writer, format Format) error {
+	entries := r.Entries()
+
+	switch format {
+	case FormatJSON:
+		return json.NewEncoder(w).Encode(entries)
+	case FormatText:
+		var b strings.Builder
+		for _, entry := range entries {
+			writeTextEntry(&b, entry)
+		}
+		_, err := io.WriteString(w, b.String())
+		return err
+	default:
+		return fmt.Errorf("unknown ring buffer format %q", format)
+	}
+}
+
+// writeTextEntry renders entry as one line.
+func writeTextEntry(b *strings.Builder, entry Entry) {
+	b.WriteString(entry.Time.Format(time.RFC3339Nano))
+	b.WriteByte('\t')
+	b.WriteString(entry.Level.String())
+	b.WriteByte('\t')
+	if entry.Logger != "" {
+		b.WriteString(entry.Logger)
+		b.WriteByte('\t')
+	}
+	b.WriteString(entry.Message)
+	for _, field := range entry.Fields {
+		fmt.Fprintf(b, " %s=%q", field.Key, field.Value)
+	}
+	b.WriteByte('\n')
+}
+
+// enabled reports whether entries at level are recorded.
+func (r *RingBuffer) enabled(level Level) bool {
+	return level >= r.minLevel
+}
+
+// record stores entry in the next slot, overwriting the oldest when full.
+func (r *RingBuffer) record(entry Entry) {
+	entry.Time = r.now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.slots[r.next] = entry
+	r.next = (r.next + 1) % len(r.slots)
+	if r.count < len(r.slots) {
+		r.count++
+	}
+}
+
+// ringFields renders alternating key-value pairs into fields appended to dst.
+// Lazy values are evaluated; the result is shared with the entry written by the sink.
+// A struct in key position is a strongly typed field (e.g. zap.Field): it takes one slot,
+// like in the adapter, and is not recorded.
+func ringFields(dst []Field, keysAndValues []any) []Field {
+	for i := 0; i < len(keysAndValues); {
+		if isTypedField(keysAndValues[i]) {
+			i++
+			continue
+		}
+		key := fmt.Sprint(keysAndValues[i])
+		if i+1 == len(keysAndValues) {
+			dst = append(dst, Field{Key: "ignored", Value: key}) // dangling key, like zap
+			break
+		}
+		value := keysAndValues[i+1]
+		if lazy, ok := value.(*LazyValue); ok {
+			value = lazy.Value()
+		}
+		dst = append(dst, Field{Key: key, Value: fmt.Sprint(value)})
+		i += 2
+	}
+	return dst
+}
+
+// isTypedField reports whether v is an adapter's strongly typed field rather than a key.
+func isTypedField(v any) bool {
+	return v != nil && reflect.TypeOf(v).Kind() == reflect.Struct
+}
+
+// ringLogger records entries into a RingBuffer before passing them on.
+// Level, Sync and Desugar are served by the embedded logger.
+type ringLogger struct {
+	ISugaredLogger
+	ring   *RingBuffer
+	name   string
+	fields []Field // from With/WithLazy
+}
+
+// recordMessage records an entry built lazily by msg, only at enabled levels.
+func (l *ringLogger) recordMessage(level Level, msg func() string, keysAndValues []any) {
+	if !l.ring.enabled(level) {
+		return
+	}
+	var fields []Field
+	if len(l.fields) > 0 || len(keysAndValues) > 0 {
+		fields = ringFields(append(make([]Field, 0, len(l.fields)+len(keysAndValues)/2), l.fields...), keysAndValues)
+	}
+	l.ring.record(Entry{Level: level, Logger: l.name, Message: msg(), Fields: fields})
+}
+
+func (l *ringLogger) recordPlain(level Level, args []any) {
+	l.recordMessage(level, func() string { return fmt.Sprint(args...) }, nil)
+}
+
+func (l *ringLogger) recordf(level Level, template string, args []any) {
+	l.recordMessage(level, func() string { return fmt.Sprintf(template, args...) }, nil)
+}
+
+func (l *ringLogger) recordw(level Level, msg string, keysAndValues []any) {
+	l.recordMessage(level, func() string { return msg }, keysAndValues)
+}
+
+func (l *ringLogger) recordln(level Level, args []any) {
+	l.recordMessage(level, func() string { return strings.TrimSuffix(fmt.Sprintln(args...), "\n") }, nil)
+}
+
+// derived returns a ring logger over next with the same name and fields.
+func (l *ringLogger) derived(next ISugaredLogger) *ringLogger {
+	return &ringLogger{ISugaredLogger: next, ring: l.ring, name: l.name, fields: l.fields}
+}
+
+// IBasicLogger implementation
+func (l *ringLogger) Debug(args ...any) {
+	l.recordPlain(DebugLevel, args)
+	l.ISugaredLogger.Debug(args...)
+}
+func (l *ringLogger) Info(args ...any) {
+	l.recordPlain(InfoLevel, args)
+	l.ISugaredLogger.Info(args...)
+}
+func (l *ringLogger) Warn(args ...any) {
+	l.recordPlain(WarnLevel, args)
+	l.ISugaredLogger.Warn(args...)
+}
+func (l *ringLogger) Error(args ...any) {
+	l.recordPlain(ErrorLevel, args)
+	l.ISugaredLogger.Error(args...)
+}
+func (l *ringLogger) DPanic(args ...any) {
+	l.recordPlain(DPanicLevel, args)
+	l.ISugaredLogger.DPanic(args...)
+}
+func (l *ringLogger) Panic(args ...any) {
+	l.recordPlain(PanicLevel, args)
+	l.ISugaredLogger.Panic(args...)
+}
+func (l *ringLogger) Fatal(args ...any) {
+	l.recordPlain(FatalLevel, args)
+	l.ISugaredLogger.Fatal(args...)
+}
+
+// IFormattedLogger implementation
+func (l *ringLogger) Debugf(template string, args ...any) {
+	l.recordf(DebugLevel, template, args)
+	l.ISugaredLogger.Debugf(template, args...)
+}
+func (l *ringLogger) Infof(template string, args ...any) {
+	l.recordf(InfoLevel, template, args)
+	l.ISugaredLogger.Infof(template, args...)
+}
+func (l *ringLogger) Warnf(template string, args ...any) {
+	l.recordf(WarnLevel, template, args)
+	l.ISugaredLogger.Warnf(template, args...)
+}
+func (l *ringLogger) Errorf(template string, args ...any) {
+	l.recordf(ErrorLevel, template, args)
+	l.ISugaredLogger.Errorf(template, args...)
+}
+func (l *ringLogger) DPanicf(template string, args ...any) {
+	l.recordf(DPanicLevel, template, args)
+	l.ISugaredLogger.DPanicf(template, args...)
+}
+func (l *ringLogger) Panicf(template string, args ...any) {
+	l.recordf(PanicLevel, template, args)
+	l.ISugaredLogger.Panicf(template, args...)
+}
+func (l *ringLogger) Fatalf(template string, args ...any) {
+	l.recordf(FatalLevel, template, args)
+	l.ISugaredLogger.Fatalf(template, args...)
+}
+func (l *ringLogger) Logf(level Level, template string, args ...any) {
+	l.recordf(level, template, args)
+	l.ISugaredLogger.Logf(level, template, args...)
+}
+
+// IStructuredLogger implementation
+func (l *ringLogger) Debugw(msg string, keysAndValues ...any) {
+	l.recordw(DebugLevel, msg, keysAndValues)
+	l.ISugaredLogger.Debugw(msg, keysAndValues...)
+}
+func (l *ringLogger) Infow(msg string, keysAndValues ...any) {
+	l.recordw(InfoLevel, msg, keysAndValues)
+	l.ISugaredLogger.Infow(msg, keysAndValues...)
+}
+func (l *ringLogger) Warnw(msg string, keysAndValues ...any) {
+	l.recordw(WarnLevel, msg, keysAndValues)
+	l.ISugaredLogger.Warnw(msg, keysAndValues...)
+}
+func (l *ringLogger) Errorw(msg string, keysAndValues ...any) {
+	l.recordw(ErrorLevel, msg, keysAndValues)
+	l.ISugaredLogger.Errorw(msg, keysAndValues...)
+}
+func (l *ringLogger) DPanicw(msg string, keysAndValues ...any) {
+	l.recordw(DPanicLevel, msg, keysAndValues)
+	l.ISugaredLogger.DPanicw(msg, keysAndValues...)
+}
+func (l *ringLogger) Panicw(msg string, keysAndValues ...any) {
+	l.recordw(PanicLevel, msg, keysAndValues)
+	l.ISugaredLogger.Panicw(msg, keysAndValues...)
+}
+func (l *ringLogger) Fatalw(msg string, keysAndValues ...any) {
+	l.recordw(FatalLevel, msg, keysAndValues)
+	l.ISugaredLogger.Fatalw(msg, keysAndValues...)
+}
+func (l *ringLogger) Logw(level Level, msg string, keysAndValues ...any) {
+	l.recordw(level, msg, keysAndValues)
+	l.ISugaredLogger.Logw(level, msg, keysAndValues...)
+}
+
+// ILineLogger implementation
+func (l *ringLogger) Debugln(args ...any) {
+	l.recordln(DebugLevel, args)
+	l.ISugaredLogger.Debugln(args...)
+}
+func (l *ringLogger) Infoln(args ...any) {
+	l.recordln(InfoLevel, args)
+	l.ISugaredLogger.Infoln(args...)
+}
+func (l *ringLogger) Warnln(args ...any) {
+	l.recordln(WarnLevel, args)
+	l.ISugaredLogger.Warnln(args...)
+}
+func (l *ringLogger) Errorln(args ...any) {
+	l.recordln(ErrorLevel, args)
+	l.ISugaredLogger.Errorln(args...)
+}
+func (l *ringLogger) DPanicln(args ...any) {
+	l.recordln(DPanicLevel, args)
+	l.ISugaredLogger.DPanicln(args...)
+}
+func (l *ringLogger) Panicln(args ...any) {
+	l.recordln(PanicLevel, args)
+	l.ISugaredLogger.Panicln(args...)
+}
+func (l *ringLogger) Fatalln(args ...any) {
+	l.recordln(FatalLevel, args)
+	l.ISugaredLogger.Fatalln(args...)
+}
+func (l *ringLogger) Logln(level Level, args ...any) {
+	l.recordln(level, args)
+	l.ISugaredLogger.Logln(level, args...)
+}
+
+// IContextualLogger implementation - derived loggers keep recording
+func (l *ringLogger) With(args ...any) ISugaredLogger {
+	d := l.derived(l.ISugaredLogger.With(args...))
+	d.fields = ringFields(append([]Field(nil), l.fields...), args)
+	return d
+}
+
+func (l *ringLogger) WithLazy(args ...any) ISugaredLogger {
+	d := l.derived(l.ISugaredLogger.WithLazy(args...))
+	d.fields = ringFields(append([]Field(nil), l.fields...), args)
+	return d
+}
+
+func (l *ringLogger) Named(name string) ISugaredLogger {
+	d := l.derived(l.ISugaredLogger.Named(name))
+	if l.name != "" {
+		d.name = l.name + "." + name
+	} else {
+		d.name = name
+	}
+	return d
+}
+
+// IContextLogger implementation
+func (l *ringLogger) WithContext(ctx any) ISugaredLogger {
+	return l.derived(l.ISugaredLogger.WithContext(ctx))
+}
@@ -0,0 +1,225 @@
+package core_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	zapadapter "github.com/phongthien99/monorepo-lib/libs/log/adapter/zap"
+	"github.com/phongthien99/monorepo-lib/libs/log/contract"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+// newInfoObservedLogger is newObservedLogger with the sink at Info
+func newInfoObservedLogger() (core.ISugaredLogger, *observer.ObservedLogs) {
+	obsCore, logs := observer.New(zapcore.InfoLevel)
+	return zapadapter.NewZapAdapterFromLogger(zap.New(obsCore), core.InfoLevel), logs
+}
+
+func messages(entries []core.Entry) []string {
+	out := make([]string, len(entries))
+	for i, entry := range entries {
+		out[i] = entry.Message
+	}
+	return out
+}
+
+func TestRingBuffer_ImplementsSugaredLogger(t *testing.T) {
+	base, _ := newObservedLogger()
+	contract.AssertSugaredLogger(t, core.NewRingBuffer(10, core.DebugLevel).Wrap(base))
+}
+
+func TestRingBuffer_RecordsBelowSinkLevel(t *testing.T) {
+	base, logs := newInfoObservedLogger()
+	ring := core.NewRingBuffer(10, core.DebugLevel)
+	logger := ring.Wrap(base)
+
+	logger.Debugw("cache miss", "key", "user:1")
+	logger.Info("served")
+
+	if logs.Len() != 1 {
+		t.Errorf("Expected the sink to keep its own level, got %d entries", logs.Len())
+	}
+	if got := messages(ring.Entries()); strings.Join(got, ",") != "cache miss,served" {
+		t.Errorf("Expected the debug entry in the ring, got %v", got)
+	}
+}
+
+func TestRingBuffer_LevelFilter(t *testing.T) {
+	base, _ := newObservedLogger()
+	ring := core.NewRingBuffer(10, core.WarnLevel)
+	logger := ring.Wrap(base)
+
+	logger.Debug("debug")
+	logger.Infof("info %d", 1)
+	logger.Warnln("warn", 2)
+	logger.Logw(core.ErrorLevel, "error")
+
+	entries := ring.Entries()
+	if got := messages(entries); strings.Join(got, ",") != "warn 2,error" {
+		t.Errorf("Expected only Warn and above, got %v", got)
+	}
+	if entries[0].Level != core.WarnLevel || entries[1].Level != core.ErrorLevel {
+		t.Errorf("Expected levels to be recorded, got %v and %v", entries[0].Level, entries[1].Level)
+	}
+}
+
+func TestRingBuffer_Wraparound(t *testing.T) {
+	base, _ := newObservedLogger()
+	ring := core.NewRingBuffer(3, core.DebugLevel)
+	logger := ring.Wrap(base)
+
+	for i := 1; i <= 5; i++ {
+		logger.Infof("entry %d", i)
+	}
+
+	if got := messages(ring.Entries()); strings.Join(got, ",") != "entry 3,entry 4,entry 5" {
+		t.Errorf("Expected the last 3 entries oldest first, got %v", got)
+	}
+}
+
+func TestRingBuffer_FieldsAndName(t *testing.T) {
+	base, _ := newObservedLogger()
+	ring := core.NewRingBuffer(10, core.DebugLevel)
+	logger := ring.Wrap(base).Named("http").With("service", "orders").Named("users")
+
+	tags := map[string]string{"a": "1"}
+	logger.Infow("request", "tags", tags, "lazy", core.Lazy(func() any { return 42 }))
+	tags["a"] = "changed" // the ring holds a rendered copy
+
+	entry := ring.Entries()[0]
+	if entry.Logger != "http.users" {
+		t.Errorf("Expected logger name http.users, got %q", entry.Logger)
+	}
+	want := []core.Field{{Key: "service", Value: "orders"}, {Key: "tags", Value: "map[a:1]"}, {Key: "lazy", Value: "42"}}
+	if fmt.Sprint(entry.Fields) != fmt.Sprint(want) {
+		t.Errorf("Expected fields %v, got %v", want, entry.Fields)
+	}
+}
+
+func TestRingBuffer_TypedFieldsTakeOneSlot(t *testing.T) {
+	base, logs := newObservedLogger()
+	ring := core.NewRingBuffer(10, core.DebugLevel)
+	logger := ring.Wrap(base)
+
+	logger.Infow("request", zap.String("a", "b"), "k", "v", zap.Int("n", 1))
+
+	want := []core.Field{{Key: "k", Value: "v"}}
+	if got := ring.Entries()[0].Fields; fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("Expected fields %v, got %v", want, got)
+	}
+	// Verify: The sink still receives the typed fields
+	if fields := logs.All()[0].ContextMap(); fields["a"] != "b" || fields["k"] != "v" || fields["n"] != int64(1) {
+		t.Errorf("Expected sink to get all fields, got %v", fields)
+	}
+}
+
+func TestRingBuffer_MaxAge(t *testing.T) {
+	base, _ := newObservedLogger()
+	ring := core.NewRingBuffer(10, core.DebugLevel, core.WithRingMaxAge(30*time.Millisecond))
+	logger := ring.Wrap(base)
+
+	logger.Info("old")
+	time.Sleep(50 * time.Millisecond)
+	logger.Info("new")
+
+	if got := messages(ring.Entries()); strings.Join(got, ",") != "new" {
+		t.Errorf("Expected entries older than the max age to be dropped, got %v", got)
+	}
+}
+
+func TestRingBuffer_ConcurrentWriters(t *testing.T) {
+	base, _ := newObservedLogger()
+	ring := core.NewRingBuffer(50, core.DebugLevel)
+	logger := ring.Wrap(base)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			child := logger.With("writer", w)
+			for i := 0; i < 200; i++ {
+				child.Debugw("tick", "i", i)
+				if i%50 == 0 {
+					ring.Entries()
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if n := len(ring.Entries()); n != 50 {
+		t.Errorf("Expected the ring to stay at capacity 50, got %d", n)
+	}
+}
+
+func TestRingBuffer_WriteTo(t *testing.T) {
+	base, _ := newObservedLogger()
+	ring := core.NewRingBuffer(10, core.DebugLevel)
+	logger := ring.Wrap(base)
+
+	logger.Named("db").Warnw("slow query", "ms", 250)
+	logger.Error("failed")
+
+	var text bytes.Buffer
+	if err := ring.WriteTo(&text, core.FormatText); err != nil {
+		t.Fatalf("WriteTo(text) error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(text.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("Expected one line per entry, got %q", text.String())
+	}
+	if !strings.HasSuffix(lines[0], "\tWARN\tdb\tslow query ms=\"250\"") || !strings.HasSuffix(lines[1], "\tERROR\tfailed") {
+		t.Errorf("Unexpected text rendering: %q", text.String())
+	}
+
+	var jsonOut bytes.Buffer
+	if err := ring.WriteTo(&jsonOut, core.FormatJSON); err != nil {
+		t.Fatalf("WriteTo(json) error = %v", err)
+	}
+	var decoded []map[string]any
+	if err := json.Unmarshal(jsonOut.Bytes(), &decoded); err != nil {
+		t.Fatalf("Expected a JSON array, got %q: %v", jsonOut.String(), err)
+	}
+	if len(decoded) != 2 || decoded[0]["msg"] != "slow query" || decoded[0]["level"] != "warn" || decoded[0]["logger"] != "db" {
+		t.Errorf("Unexpected JSON rendering: %s", jsonOut.String())
+	}
+
+	if err := ring.WriteTo(&text, core.Format("xml")); err == nil {
+		t.Error("Expected an error for an unknown format")
+	}
+}
+
+func TestCapturePanics_WithRingBuffer(t *testing.T) {
+	base, logs := newInfoObservedLogger()
+	ring := core.NewRingBuffer(10, core.DebugLevel)
+	logger := ring.Wrap(base)
+
+	core.CaptureExit(func() {
+		defer core.CapturePanics(logger, core.WithPanicExitCode(1), core.WithPanicRingBuffer(ring))()
+		logger.Debugw("loading order", "id", 7)
+		panic("boom")
+	})
+
+	entries := logs.FilterLevelExact(zapcore.FatalLevel).All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected one Fatal entry, got %d", len(entries))
+	}
+	recent, _ := entries[0].ContextMap()["recent_logs"].(string)
+	if !strings.Contains(recent, "loading order id=\"7\"") {
+		t.Errorf("Expected the ring contents in the Fatal entry, got %q", recent)
+	}
+
+	// The Fatal entry itself is recorded too
+	if got := messages(ring.Entries()); got[len(got)-1] != "unrecovered panic" {
+		t.Errorf("Expected the panic entry in the ring, got %v", got)
+	}
+}
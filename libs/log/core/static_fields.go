@@ -0,0 +1,37 @@
+package core
+
+// WithStaticFields returns logger pre-populated with fields (alternating keys and values)
+// through With, e.g. service name, version and environment taken from config.
+// Pairs whose value is an empty string are skipped, so unset config values add no fields.
+// When nothing is left to add, logger is returned unchanged.
+//
+// Static fields compose with other decorators: wrap in any order, and loggers derived
+// with With or Named keep them.
+//
+// Example:
+//
+//	logger := core.WithStaticFields(baseLogger,
+//	    "service", cfg.Service.Name,
+//	    "version", cfg.Service.Version,
+//	    "env", cfg.Service.Env,
+//	)
+//	logger.Info("started") // {"msg":"started","service":"orders","version":"v1.4.0","env":"production"}
+func WithStaticFields(logger ISugaredLogger, fields ...any) ISugaredLogger {
+	kept := make([]any, 0, len(fields))
+	for i := 0; i < len(fields); i += 2 {
+		if i+1 == len(fields) {
+			// Dangling key: let With report it the way it reports any odd field list
+			kept = append(kept, fields[i])
+			break
+		}
+		if s, ok := fields[i+1].(string); ok && s == "" {
+			continue
+		}
+		kept = append(kept, fields[i], fields[i+1])
+	}
+
+	if len(kept) == 0 {
+		return logger
+	}
+	return logger.With(kept...)
+}
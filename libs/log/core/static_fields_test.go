@@ -0,0 +1,56 @@
+package core_test
+
+import (
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+func TestWithStaticFields_AddsFieldsToEveryEntry(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.WithStaticFields(base, "service", "foo", "version", "v1.2.0")
+
+	logger.Info("started")
+	logger.Warnw("slow query", "ms", 250)
+	logger.Named("repo").With("table", "users").Errorf("failed: %s", "timeout")
+
+	entries := logs.All()
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+	for _, entry := range entries {
+		fields := entry.ContextMap()
+		if fields["service"] != "foo" || fields["version"] != "v1.2.0" {
+			t.Errorf("Expected service=foo version=v1.2.0 on %q, got %v", entry.Message, fields)
+		}
+	}
+	if entries[1].ContextMap()["ms"] != int64(250) {
+		t.Errorf("Expected call fields to be kept, got %v", entries[1].ContextMap())
+	}
+}
+
+func TestWithStaticFields_SkipsEmptyValues(t *testing.T) {
+	base, logs := newObservedLogger()
+	logger := core.WithStaticFields(base, "service", "foo", "version", "", "env", "prod")
+
+	logger.Info("started")
+
+	fields := logs.All()[0].ContextMap()
+	if _, ok := fields["version"]; ok {
+		t.Errorf("Expected empty version to be skipped, got %v", fields)
+	}
+	if fields["service"] != "foo" || fields["env"] != "prod" {
+		t.Errorf("Expected service=foo env=prod, got %v", fields)
+	}
+}
+
+func TestWithStaticFields_NoFieldsReturnsLogger(t *testing.T) {
+	base, _ := newObservedLogger()
+
+	if got := core.WithStaticFields(base); got != base {
+		t.Error("Expected logger to be returned unchanged without fields")
+	}
+	if got := core.WithStaticFields(base, "version", ""); got != base {
+		t.Error("Expected logger to be returned unchanged when every value is empty")
+	}
+}
@@ -0,0 +1,171 @@
+package core
+
+import "sync/atomic"
+
+// SwappableLogger is an ISugaredLogger whose underlying logger can be replaced at runtime,
+// e.g. after a config reload builds a new logger. Callers keep one reference and every
+// call goes to the current logger; loggers derived with With/WithLazy/Named/WithContext
+// follow swaps too. Safe for concurrent use.
+//
+// Example:
+//
+//	logger := core.NewSwappableLogger(initial)
+//	svc := NewService(logger) // keeps the same reference forever
+//
+//	// on config reload
+//	next, _ := zap.NewWithConfig(newCfg)
+//	old := logger.Swap(next)
+//	old.Sync()
+type SwappableLogger struct {
+	root   *atomic.Pointer[swapGeneration]
+	derive func(ISugaredLogger) ISugaredLogger // nil for the root logger
+	cache  atomic.Pointer[derivedLogger]
+}
+
+var _ ISugaredLogger = (*SwappableLogger)(nil)
+
+// swapGeneration holds the logger installed by one Swap; its pointer identifies the generation.
+type swapGeneration struct {
+	logger ISugaredLogger
+}
+
+// derivedLogger caches a derived logger for one generation.
+type derivedLogger struct {
+	generation *swapGeneration
+	logger     ISugaredLogger
+}
+
+// NewSwappableLogger creates a SwappableLogger that initially delegates to logger.
+func NewSwappableLogger(logger ISugaredLogger) *SwappableLogger {
+	root := &atomic.Pointer[swapGeneration]{}
+	root.Store(&swapGeneration{logger: logger})
+	return &SwappableLogger{root: root}
+}
+
+// Swap installs next as the underlying logger and returns the previous one.
+// It affects this logger and every logger derived from the same root.
+func (s *SwappableLogger) Swap(next ISugaredLogger) ISugaredLogger {
+	return s.root.Swap(&swapGeneration{logger: next}).logger
+}
+
+// Current returns the logger calls are currently delegated to
+// (with this logger's With/Named derivations applied).
+func (s *SwappableLogger) Current() ISugaredLogger {
+	generation := s.root.Load()
+	if s.derive == nil {
+		return generation.logger
+	}
+
+	if cached := s.cache.Load(); cached != nil && cached.generation == generation {
+		return cached.logger
+	}
+	logger := s.derive(generation.logger)
+	s.cache.Store(&derivedLogger{generation: generation, logger: logger})
+	return logger
+}
+
+// derived returns a logger sharing the root that applies fn on top of this logger's derivations.
+func (s *SwappableLogger) derived(fn func(ISugaredLogger) ISugaredLogger) *SwappableLogger {
+	parent := s.derive
+	return &SwappableLogger{
+		root: s.root,
+		derive: func(l ISugaredLogger) ISugaredLogger {
+			if parent != nil {
+				l = parent(l)
+			}
+			return fn(l)
+		},
+	}
+}
+
+// IBasicLogger implementation
+func (s *SwappableLogger) Debug(args ...any)  { s.Current().Debug(args...) }
+func (s *SwappableLogger) Info(args ...any)   { s.Current().Info(args...) }
+func (s *SwappableLogger) Warn(args ...any)   { s.Current().Warn(args...) }
+func (s *SwappableLogger) Error(args ...any)  { s.Current().Error(args...) }
+func (s *SwappableLogger) DPanic(args ...any) { s.Current().DPanic(args...) }
+func (s *SwappableLogger) Panic(args ...any)  { s.Current().Panic(args...) }
+func (s *SwappableLogger) Fatal(args ...any)  { s.Current().Fatal(args...) }
+
+// IFormattedLogger implementation
+func (s *SwappableLogger) Debugf(template string, args ...any) { s.Current().Debugf(template, args...) }
+func (s *SwappableLogger) Infof(template string, args ...any)  { s.Current().Infof(template, args...) }
+func (s *SwappableLogger) Warnf(template string, args ...any)  { s.Current().Warnf(template, args...) }
+func (s *SwappableLogger) Errorf(template string, args ...any) { s.Current().Errorf(template, args...) }
+func (s *SwappableLogger) DPanicf(template string, args ...any) {
+	s.Current().DPanicf(template, args...)
+}
+func (s *SwappableLogger) Panicf(template string, args ...any) { s.Current().Panicf(template, args...) }
+func (s *SwappableLogger) Fatalf(template string, args ...any) { s.Current().Fatalf(template, args...) }
+func (s *SwappableLogger) Logf(level Level, template string, args ...any) {
+	s.Current().Logf(level, template, args...)
+}
+
+// IStructuredLogger implementation
+func (s *SwappableLogger) Debugw(msg string, keysAndValues ...any) {
+	s.Current().Debugw(msg, keysAndValues...)
+}
+func (s *SwappableLogger) Infow(msg string, keysAndValues ...any) {
+	s.Current().Infow(msg, keysAndValues...)
+}
+func (s *SwappableLogger) Warnw(msg string, keysAndValues ...any) {
+	s.Current().Warnw(msg, keysAndValues...)
+}
+func (s *SwappableLogger) Errorw(msg string, keysAndValues ...any) {
+	s.Current().Errorw(msg, keysAndValues...)
+}
+func (s *SwappableLogger) DPanicw(msg string, keysAndValues ...any) {
+	s.Current().DPanicw(msg, keysAndValues...)
+}
+func (s *SwappableLogger) Panicw(msg string, keysAndValues ...any) {
+	s.Current().Panicw(msg, keysAndValues...)
+}
+func (s *SwappableLogger) Fatalw(msg string, keysAndValues ...any) {
+	s.Current().Fatalw(msg, keysAndValues...)
+}
+func (s *SwappableLogger) Logw(level Level, msg string, keysAndValues ...any) {
+	s.Current().Logw(level, msg, keysAndValues...)
+}
+
+// ILineLogger implementation
+func (s *SwappableLogger) Debugln(args ...any)  { s.Current().Debugln(args...) }
+func (s *SwappableLogger) Infoln(args ...any)   { s.Current().Infoln(args...) }
+func (s *SwappableLogger) Warnln(args ...any)   { s.Current().Warnln(args...) }
+func (s *SwappableLogger) Errorln(args ...any)  { s.Current().Errorln(args...) }
+func (s *SwappableLogger) DPanicln(args ...any) { s.Current().DPanicln(args...) }
+func (s *SwappableLogger) Panicln(args ...any)  { s.Current().Panicln(args...) }
+func (s *SwappableLogger) Fatalln(args ...any)  { s.Current().Fatalln(args...) }
+func (s *SwappableLogger) Logln(level Level, args ...any) {
+	s.Current().Logln(level, args...)
+}
+
+// IContextualLogger implementation - derived loggers follow swaps
+func (s *SwappableLogger) With(args ...any) ISugaredLogger {
+	return s.derived(func(l ISugaredLogger) ISugaredLogger { return l.With(args...) })
+}
+
+func (s *SwappableLogger) WithLazy(args ...any) ISugaredLogger {
+	return s.derived(func(l ISugaredLogger) ISugaredLogger { return l.WithLazy(args...) })
+}
+
+func (s *SwappableLogger) Named(name string) ISugaredLogger {
+	return s.derived(func(l ISugaredLogger) ISugaredLogger { return l.Named(name) })
+}
+
+// IContextLogger implementation
+func (s *SwappableLogger) WithContext(ctx any) ISugaredLogger {
+	return s.derived(func(l ISugaredLogger) ISugaredLogger { return l.WithContext(ctx) })
+}
+
+// ILoggerControl implementation
+func (s *SwappableLogger) Desugar() any {
+	return s.Current().Desugar()
+}
+
+func (s *SwappableLogger) Level() Level {
+	return s.Current().Level()
+}
+
+func (s *SwappableLogger) Sync() error {
+	return s.Current().Sync()
+}
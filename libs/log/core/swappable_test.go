@@ -0,0 +1,78 @@
+package core_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+)
+
+func TestSwappableLogger_RoutesToCurrent(t *testing.T) {
+	first, firstLogs := newObservedLogger()
+	second, secondLogs := newObservedLogger()
+
+	logger := core.NewSwappableLogger(first)
+	logger.Info("before")
+
+	if old := logger.Swap(second); old != first {
+		t.Error("Expected Swap to return the previous logger")
+	}
+	logger.Infow("after", "key", "value")
+
+	if firstLogs.Len() != 1 || firstLogs.All()[0].Message != "before" {
+		t.Errorf("Expected first logger to record only 'before', got %v", firstLogs.All())
+	}
+	if secondLogs.Len() != 1 || secondLogs.All()[0].Message != "after" {
+		t.Errorf("Expected second logger to record only 'after', got %v", secondLogs.All())
+	}
+}
+
+func TestSwappableLogger_DerivedLoggersFollowSwap(t *testing.T) {
+	first, firstLogs := newObservedLogger()
+	second, secondLogs := newObservedLogger()
+
+	logger := core.NewSwappableLogger(first)
+	child := logger.Named("repo").With("component", "cache")
+
+	child.Info("before")
+	logger.Swap(second)
+	child.Info("after")
+
+	if firstLogs.Len() != 1 {
+		t.Errorf("Expected 1 entry on first logger, got %d", firstLogs.Len())
+	}
+	entries := secondLogs.All()
+	if len(entries) != 1 {
+		t.Fatalf("Expected derived logger to route to the new logger, got %d entries", len(entries))
+	}
+	if entries[0].LoggerName != "repo" || entries[0].ContextMap()["component"] != "cache" {
+		t.Errorf("Expected derivations to be re-applied, got name=%q fields=%v",
+			entries[0].LoggerName, entries[0].ContextMap())
+	}
+}
+
+func TestSwappableLogger_ConcurrentSwap(t *testing.T) {
+	first, _ := newObservedLogger()
+	second, _ := newObservedLogger()
+	logger := core.NewSwappableLogger(first)
+	child := logger.With("k", "v")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if i == 0 && j%10 == 0 {
+					if j%20 == 0 {
+						logger.Swap(second)
+					} else {
+						logger.Swap(first)
+					}
+				}
+				child.Info("msg")
+			}
+		}(i)
+	}
+	wg.Wait()
+}
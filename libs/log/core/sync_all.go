@@ -0,0 +1,34 @@
+package core
+
+import "errors"
+
+// Flusher is implemented by decorators that buffer entries (see AsyncLogger).
+// Flush returns once every entry submitted before it has been handed to the wrapped logger.
+type Flusher interface {
+	Flush()
+}
+
+// SyncAll flushes and syncs loggers at shutdown, so no entry is lost or reordered.
+// It first flushes every Flusher, then syncs every logger, joining the errors.
+// Flushing all barriers before any Sync ensures entries still queued behind one logger
+// reach a sink shared with another logger before that sink is synced.
+//
+// Example:
+//
+//	logger.Info("shutting down")
+//	_ = core.SyncAll(logger, dbLogger, httpLogger)
+func SyncAll(loggers ...ISugaredLogger) error {
+	for _, l := range loggers {
+		if f, ok := l.(Flusher); ok {
+			f.Flush()
+		}
+	}
+
+	var errs []error
+	for _, l := range loggers {
+		if err := l.Sync(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
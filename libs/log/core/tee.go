@@ -0,0 +1,199 @@
+package core
+
+import "errors"
+
+// NewTee returns a logger writing every entry to each of loggers, in order.
+//
+// Behavior:
+//   - Loggers derived with With/WithLazy/Named/WithContext apply the call to every logger
+//   - DPanic, Panic and Fatal entries are written at Error level to every logger but the first,
+//     then to the first at their own level, so only the first panics or exits
+//   - Level is the most verbose level among loggers
+//   - Sync syncs every logger and joins their errors; Flush flushes every logger that is a Flusher
+//   - Desugar returns the first logger's Desugar
+//
+// Example:
+//
+//	logger := core.NewTee(stdoutLogger, fileLogger)
+//	logger.Named("db").Info("connected") // written to both
+func NewTee(loggers ...ISugaredLogger) ISugaredLogger {
+	if len(loggers) == 0 {
+		panic("core: NewTee requires at least one logger")
+	}
+	return &teeLogger{loggers: loggers}
+}
+
+// teeLogger writes every entry to each of its loggers.
+type teeLogger struct {
+	loggers []ISugaredLogger
+}
+
+// each calls fn on every logger.
+func (t *teeLogger) each(fn func(ISugaredLogger)) {
+	for _, l := range t.loggers {
+		fn(l)
+	}
+}
+
+// escalate calls mirror on every logger but the first, then fn on the first.
+func (t *teeLogger) escalate(mirror, fn func(ISugaredLogger)) {
+	for _, l := range t.loggers[1:] {
+		mirror(l)
+	}
+	fn(t.loggers[0])
+}
+
+// atLevel writes at level, escalating DPanic and above.
+func (t *teeLogger) atLevel(level Level, fn func(ISugaredLogger, Level)) {
+	if level < DPanicLevel {
+		t.each(func(l ISugaredLogger) { fn(l, level) })
+		return
+	}
+	t.escalate(func(l ISugaredLogger) { fn(l, mirrorLevel(level)) }, func(l ISugaredLogger) { fn(l, level) })
+}
+
+// derived applies fn to every logger.
+func (t *teeLogger) derived(fn func(ISugaredLogger) ISugaredLogger) ISugaredLogger {
+	loggers := make([]ISugaredLogger, len(t.loggers))
+	for i, l := range t.loggers {
+		loggers[i] = fn(l)
+	}
+	return &teeLogger{loggers: loggers}
+}
+
+// IBasicLogger implementation
+func (t *teeLogger) Debug(args ...any) { t.each(func(l ISugaredLogger) { l.Debug(args...) }) }
+func (t *teeLogger) Info(args ...any)  { t.each(func(l ISugaredLogger) { l.Info(args...) }) }
+func (t *teeLogger) Warn(args ...any)  { t.each(func(l ISugaredLogger) { l.Warn(args...) }) }
+func (t *teeLogger) Error(args ...any) { t.each(func(l ISugaredLogger) { l.Error(args...) }) }
+func (t *teeLogger) DPanic(args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Error(args...) }, func(l ISugaredLogger) { l.DPanic(args...) })
+}
+func (t *teeLogger) Panic(args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Error(args...) }, func(l ISugaredLogger) { l.Panic(args...) })
+}
+func (t *teeLogger) Fatal(args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Error(args...) }, func(l ISugaredLogger) { l.Fatal(args...) })
+}
+
+// IFormattedLogger implementation
+func (t *teeLogger) Debugf(template string, args ...any) {
+	t.each(func(l ISugaredLogger) { l.Debugf(template, args...) })
+}
+func (t *teeLogger) Infof(template string, args ...any) {
+	t.each(func(l ISugaredLogger) { l.Infof(template, args...) })
+}
+func (t *teeLogger) Warnf(template string, args ...any) {
+	t.each(func(l ISugaredLogger) { l.Warnf(template, args...) })
+}
+func (t *teeLogger) Errorf(template string, args ...any) {
+	t.each(func(l ISugaredLogger) { l.Errorf(template, args...) })
+}
+func (t *teeLogger) DPanicf(template string, args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorf(template, args...) }, func(l ISugaredLogger) { l.DPanicf(template, args...) })
+}
+func (t *teeLogger) Panicf(template string, args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorf(template, args...) }, func(l ISugaredLogger) { l.Panicf(template, args...) })
+}
+func (t *teeLogger) Fatalf(template string, args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorf(template, args...) }, func(l ISugaredLogger) { l.Fatalf(template, args...) })
+}
+func (t *teeLogger) Logf(level Level, template string, args ...any) {
+	t.atLevel(level, func(l ISugaredLogger, lvl Level) { l.Logf(lvl, template, args...) })
+}
+
+// IStructuredLogger implementation
+func (t *teeLogger) Debugw(msg string, keysAndValues ...any) {
+	t.each(func(l ISugaredLogger) { l.Debugw(msg, keysAndValues...) })
+}
+func (t *teeLogger) Infow(msg string, keysAndValues ...any) {
+	t.each(func(l ISugaredLogger) { l.Infow(msg, keysAndValues...) })
+}
+func (t *teeLogger) Warnw(msg string, keysAndValues ...any) {
+	t.each(func(l ISugaredLogger) { l.Warnw(msg, keysAndValues...) })
+}
+func (t *teeLogger) Errorw(msg string, keysAndValues ...any) {
+	t.each(func(l ISugaredLogger) { l.Errorw(msg, keysAndValues...) })
+}
+func (t *teeLogger) DPanicw(msg string, keysAndValues ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorw(msg, keysAndValues...) }, func(l ISugaredLogger) { l.DPanicw(msg, keysAndValues...) })
+}
+func (t *teeLogger) Panicw(msg string, keysAndValues ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorw(msg, keysAndValues...) }, func(l ISugaredLogger) { l.Panicw(msg, keysAndValues...) })
+}
+func (t *teeLogger) Fatalw(msg string, keysAndValues ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorw(msg, keysAndValues...) }, func(l ISugaredLogger) { l.Fatalw(msg, keysAndValues...) })
+}
+func (t *teeLogger) Logw(level Level, msg string, keysAndValues ...any) {
+	t.atLevel(level, func(l ISugaredLogger, lvl Level) { l.Logw(lvl, msg, keysAndValues...) })
+}
+
+// ILineLogger implementation
+func (t *teeLogger) Debugln(args ...any) { t.each(func(l ISugaredLogger) { l.Debugln(args...) }) }
+func (t *teeLogger) Infoln(args ...any)  { t.each(func(l ISugaredLogger) { l.Infoln(args...) }) }
+func (t *teeLogger) Warnln(args ...any)  { t.each(func(l ISugaredLogger) { l.Warnln(args...) }) }
+func (t *teeLogger) Errorln(args ...any) { t.each(func(l ISugaredLogger) { l.Errorln(args...) }) }
+func (t *teeLogger) DPanicln(args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorln(args...) }, func(l ISugaredLogger) { l.DPanicln(args...) })
+}
+func (t *teeLogger) Panicln(args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorln(args...) }, func(l ISugaredLogger) { l.Panicln(args...) })
+}
+func (t *teeLogger) Fatalln(args ...any) {
+	t.escalate(func(l ISugaredLogger) { l.Errorln(args...) }, func(l ISugaredLogger) { l.Fatalln(args...) })
+}
+func (t *teeLogger) Logln(level Level, args ...any) {
+	t.atLevel(level, func(l ISugaredLogger, lvl Level) { l.Logln(lvl, args...) })
+}
+
+// IContextualLogger implementation
+func (t *teeLogger) With(args ...any) ISugaredLogger {
+	return t.derived(func(l ISugaredLogger) ISugaredLogger { return l.With(args...) })
+}
+
+func (t *teeLogger) WithLazy(args ...any) ISugaredLogger {
+	return t.derived(func(l ISugaredLogger) ISugaredLogger { return l.WithLazy(args...) })
+}
+
+func (t *teeLogger) Named(name string) ISugaredLogger {
+	return t.derived(func(l ISugaredLogger) ISugaredLogger { return l.Named(name) })
+}
+
+// IContextLogger implementation
+func (t *teeLogger) WithContext(ctx any) ISugaredLogger {
+	return t.derived(func(l ISugaredLogger) ISugaredLogger { return l.WithContext(ctx) })
+}
+
+// ILoggerControl implementation
+func (t *teeLogger) Desugar() any {
+	return t.loggers[0].Desugar()
+}
+
+func (t *teeLogger) Level() Level {
+	level := t.loggers[0].Level()
+	for _, l := range t.loggers[1:] {
+		if l.Level() < level {
+			level = l.Level()
+		}
+	}
+	return level
+}
+
+func (t *teeLogger) Sync() error {
+	var errs []error
+	for _, l := range t.loggers {
+		if err := l.Sync(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Flush flushes every logger that is a Flusher, so SyncAll reaches async loggers behind the tee.
+func (t *teeLogger) Flush() {
+	for _, l := range t.loggers {
+		if f, ok := l.(Flusher); ok {
+			f.Flush()
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package core_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/phongthien99/monorepo-lib/libs/log/contract"
+	"github.com/phongthien99/monorepo-lib/libs/log/core"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+func TestTee_ImplementsSugaredLogger(t *testing.T) {
+	first, _ := newObservedLogger()
+	second, _ := newObservedLogger()
+	contract.AssertSugaredLogger(t, core.NewTee(first, second))
+}
+
+func TestTee_WritesToEveryLogger(t *testing.T) {
+	first, firstLogs := newObservedLogger()
+	second, secondLogs := newObservedLogger()
+	logger := core.NewTee(first, second)
+
+	logger.Named("db").With("shard", 1).Infow("connected", "host", "db-1")
+
+	for _, logs := range []*observer.ObservedLogs{firstLogs, secondLogs} {
+		if logs.Len() != 1 {
+			t.Fatalf("Expected every logger to get the entry, got %d", logs.Len())
+		}
+	}
+	entry := secondLogs.All()[0]
+	if entry.LoggerName != "db" || entry.ContextMap()["shard"] != int64(1) || entry.ContextMap()["host"] != "db-1" {
+		t.Errorf("Expected derivations on every logger, got name=%q fields=%v", entry.LoggerName, entry.ContextMap())
+	}
+}
+
+func TestTee_PanicEscalatesOnFirstOnly(t *testing.T) {
+	first, firstLogs := newObservedLogger()
+	second, secondLogs := newObservedLogger()
+	logger := core.NewTee(first, second)
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected Panicw to panic")
+			}
+		}()
+		logger.Panicw("boom", "key", 1)
+	}()
+
+	if e := firstLogs.All(); len(e) != 1 || e[0].Level != zapcore.PanicLevel {
+		t.Errorf("Expected a panic entry on the first logger, got %v", e)
+	}
+	if e := secondLogs.All(); len(e) != 1 || e[0].Level != zapcore.ErrorLevel {
+		t.Errorf("Expected an error entry on the second logger, got %v", e)
+	}
+}
+
+// failingSyncLogger is an observed logger whose Sync fails
+type failingSyncLogger struct {
+	core.ISugaredLogger
+}
+
+func (failingSyncLogger) Sync() error { return errors.New("sync failed") }
+
+func TestTee_SyncJoinsErrorsAndFlushesAsync(t *testing.T) {
+	base, logs := newObservedLogger()
+	async := core.NewAsync(base, 16)
+	defer async.Close()
+	other, _ := newObservedLogger()
+	logger := core.NewTee(async, failingSyncLogger{other})
+
+	logger.Info("queued")
+	err := core.SyncAll(logger)
+	if err == nil || err.Error() != "sync failed" {
+		t.Errorf("Expected the failing Sync error, got: %v", err)
+	}
+	if logs.Len() != 1 {
+		t.Errorf("Expected SyncAll to flush the async logger behind the tee, got %d entries", logs.Len())
+	}
+}
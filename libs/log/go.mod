@@ -2,7 +2,6 @@ module github.com/phongthien99/monorepo-lib/libs/log
 
 go 1.21
 
-require (
-	go.uber.org/multierr v1.10.0 // indirect
-	go.uber.org/zap v1.27.0 // indirect
-)
+require go.uber.org/zap v1.27.0
+
+require go.uber.org/multierr v1.10.0 // indirect
@@ -1,5 +1,20 @@
 package math
 
+import (
+	"cmp"
+	"errors"
+)
+
+// ErrEmpty is returned by MaxOf and MinOf when called with no values
+var ErrEmpty = errors.New("math: no values")
+
+// Number is satisfied by the integer and floating-point types
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
 // Add returns the sum of two integers
 func Add(a, b int) int {
 	return a + b
@@ -39,3 +54,45 @@ func Min(a, b int) int {
 	}
 	return b
 }
+
+// MaxOf returns the maximum of vals
+// Returns ErrEmpty if vals is empty
+func MaxOf[T cmp.Ordered](vals ...T) (T, error) {
+	if len(vals) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v > m {
+			m = v
+		}
+	}
+	return m, nil
+}
+
+// MinOf returns the minimum of vals
+// Returns ErrEmpty if vals is empty
+func MinOf[T cmp.Ordered](vals ...T) (T, error) {
+	if len(vals) == 0 {
+		var zero T
+		return zero, ErrEmpty
+	}
+	m := vals[0]
+	for _, v := range vals[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m, nil
+}
+
+// SumOf returns the sum of vals
+// Returns 0 if vals is empty
+func SumOf[T Number](vals ...T) T {
+	var sum T
+	for _, v := range vals {
+		sum += v
+	}
+	return sum
+}
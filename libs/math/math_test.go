@@ -0,0 +1,51 @@
+package math
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMaxOf(t *testing.T) {
+	if got, err := MaxOf(3, 7, -2); err != nil || got != 7 {
+		t.Errorf("MaxOf ints = %v, %v; want 7, nil", got, err)
+	}
+	if got, err := MaxOf(1.5, -0.5, 2.25); err != nil || got != 2.25 {
+		t.Errorf("MaxOf floats = %v, %v; want 2.25, nil", got, err)
+	}
+	if got, err := MaxOf("pear", "apple", "zucchini"); err != nil || got != "zucchini" {
+		t.Errorf("MaxOf strings = %q, %v; want zucchini, nil", got, err)
+	}
+}
+
+func TestMinOf(t *testing.T) {
+	if got, err := MinOf(3, 7, -2); err != nil || got != -2 {
+		t.Errorf("MinOf ints = %v, %v; want -2, nil", got, err)
+	}
+	if got, err := MinOf(1.5, -0.5, 2.25); err != nil || got != -0.5 {
+		t.Errorf("MinOf floats = %v, %v; want -0.5, nil", got, err)
+	}
+	if got, err := MinOf("pear", "apple", "zucchini"); err != nil || got != "apple" {
+		t.Errorf("MinOf strings = %q, %v; want apple, nil", got, err)
+	}
+}
+
+func TestMaxOfMinOf_Empty(t *testing.T) {
+	if got, err := MaxOf[int](); !errors.Is(err, ErrEmpty) || got != 0 {
+		t.Errorf("MaxOf() = %v, %v; want 0, ErrEmpty", got, err)
+	}
+	if got, err := MinOf[string](); !errors.Is(err, ErrEmpty) || got != "" {
+		t.Errorf("MinOf() = %q, %v; want \"\", ErrEmpty", got, err)
+	}
+}
+
+func TestSumOf(t *testing.T) {
+	if got := SumOf(1, 2, 3); got != 6 {
+		t.Errorf("SumOf ints = %v; want 6", got)
+	}
+	if got := SumOf(0.5, 0.25); got != 0.75 {
+		t.Errorf("SumOf floats = %v; want 0.75", got)
+	}
+	if got := SumOf[int](); got != 0 {
+		t.Errorf("SumOf() = %v; want 0", got)
+	}
+}